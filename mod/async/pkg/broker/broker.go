@@ -40,6 +40,10 @@ type Broker[T async.BaseEvent] struct {
 	msgs chan T
 	// timeout is the timeout for sending a msg to a client.
 	timeout time.Duration
+	// dropOldest determines whether a client which does not keep up has its
+	// oldest undelivered msg dropped, rather than the msg being published
+	// discarded after the timeout.
+	dropOldest bool
 }
 
 // New creates a new broker publishing events of type T for the
@@ -53,6 +57,15 @@ func New[T async.BaseEvent](eventID string) *Broker[T] {
 	}
 }
 
+// NewDropOldest creates a new broker publishing events of type T for the
+// provided eventID, which never waits for a client: the oldest msg buffered
+// by a client whose channel is full is dropped to make room for the new one.
+func NewDropOldest[T async.BaseEvent](eventID string) *Broker[T] {
+	b := New[T](eventID)
+	b.dropOldest = true
+	return b
+}
+
 // EventID returns the event ID that the broker is responsible for.
 func (b *Broker[T]) EventID() async.EventID {
 	return b.eventID
@@ -124,6 +137,11 @@ func (b *Broker[T]) Unsubscribe(ch any) error {
 }
 
 func (b *Broker[T]) broadcast(msg T) {
+	if b.dropOldest {
+		b.broadcastDropOldest(msg)
+		return
+	}
+
 	for client := range b.subscriptions {
 		// send msg to client (or discard msg after timeout)
 		// we could consider using a go routine for each client to allow
@@ -136,6 +154,30 @@ func (b *Broker[T]) broadcast(msg T) {
 	}
 }
 
+// broadcastDropOldest sends msg to all clients, dropping the oldest msg
+// buffered by a client whose channel is full.
+func (b *Broker[T]) broadcastDropOldest(msg T) {
+	for client := range b.subscriptions {
+		select {
+		case client <- msg:
+			continue
+		default:
+		}
+
+		// The client is not keeping up, so its oldest msg is dropped, unless
+		// it was received meanwhile. The broker is the only sender, hence
+		// there is room for msg afterwards, unless the channel is unbuffered.
+		select {
+		case <-client:
+		default:
+		}
+		select {
+		case client <- msg:
+		default:
+		}
+	}
+}
+
 // shutdown closes all leftover clients.
 func (b *Broker[T]) shutdown() {
 	for client := range b.subscriptions {
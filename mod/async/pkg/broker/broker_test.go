@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+)
+
+func TestBroadcastDropOldest(t *testing.T) {
+	b := NewDropOldest[async.Event[int]]("test")
+	client := make(chan async.Event[int], 2)
+	if err := b.Subscribe(client); err != nil {
+		t.Fatal(err)
+	}
+
+	// A client which does not keep up only sees the latest msgs, and the
+	// broker never waits for it.
+	for i := range 4 {
+		b.broadcast(async.NewEvent(context.Background(), "test", i))
+	}
+	for _, want := range []int{2, 3} {
+		if got := (<-client).Data(); got != want {
+			t.Fatalf("expected msg %d, got %d", want, got)
+		}
+	}
+}
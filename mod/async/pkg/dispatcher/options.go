@@ -36,3 +36,14 @@ func WithEvent[
 		return dispatcher.RegisterBrokers(broker.New[EventT](eventID))
 	}
 }
+
+// WithDropOldestEvent registers a broker for the given eventID which drops the
+// oldest event buffered by a subscriber that does not keep up, rather than
+// waiting for it.
+func WithDropOldestEvent[
+	EventT async.BaseEvent,
+](eventID string) Option {
+	return func(dispatcher types.Dispatcher) error {
+		return dispatcher.RegisterBrokers(broker.NewDropOldest[EventT](eventID))
+	}
+}
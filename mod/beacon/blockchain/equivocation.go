@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// maxTrackedProposals is the maximum number of proposals tracked for
+// equivocation at once. Proposals seen beyond it are not tracked.
+const maxTrackedProposals = 1024
+
+// Equivocation is the data of an EquivocationDetected event, holding the
+// headers of two distinct proposals for the same slot by the same proposer.
+type Equivocation[BeaconBlockHeaderT any] struct {
+	// Slot is the slot both proposals are for.
+	Slot math.Slot
+	// ProposerIndex is the index of the equivocating proposer.
+	ProposerIndex math.ValidatorIndex
+	// Previous is the header of the proposal seen first.
+	Previous BeaconBlockHeaderT
+	// Conflicting is the header of the proposal conflicting with it.
+	Conflicting BeaconBlockHeaderT
+}
+
+// proposalKey identifies the proposals of a proposer for a slot.
+type proposalKey struct {
+	slot          math.Slot
+	proposerIndex math.ValidatorIndex
+}
+
+// seenProposal is a proposal seen for a proposalKey.
+type seenProposal[BeaconBlockHeaderT any] struct {
+	root   common.Root
+	header BeaconBlockHeaderT
+}
+
+// equivocationTracker tracks the valid proposals seen for each slot and
+// proposer, in order to detect proposers equivocating.
+type equivocationTracker[BeaconBlockHeaderT any] struct {
+	// logger is used for logging equivocations.
+	logger log.Logger
+	// dispatcher is used to publish EquivocationDetected events.
+	dispatcher asynctypes.EventDispatcher
+
+	// mu protects the proposals below.
+	mu sync.Mutex
+	// proposals are the proposals seen for slots which have not been
+	// finalized yet.
+	proposals map[proposalKey]seenProposal[BeaconBlockHeaderT]
+	// finalizedSlot is the slot of the last finalized block.
+	finalizedSlot math.Slot
+}
+
+// newEquivocationTracker creates a new equivocationTracker.
+func newEquivocationTracker[BeaconBlockHeaderT any](
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+) *equivocationTracker[BeaconBlockHeaderT] {
+	return &equivocationTracker[BeaconBlockHeaderT]{
+		logger:     logger,
+		dispatcher: dispatcher,
+		proposals:  make(map[proposalKey]seenProposal[BeaconBlockHeaderT]),
+	}
+}
+
+// onProposal records a valid proposal with the given root and header. If the
+// proposer was already seen proposing a different block for the slot, an
+// EquivocationDetected event is published with both headers.
+func (et *equivocationTracker[BeaconBlockHeaderT]) onProposal(
+	ctx context.Context,
+	slot math.Slot,
+	proposerIndex math.ValidatorIndex,
+	root common.Root,
+	header BeaconBlockHeaderT,
+) {
+	key := proposalKey{slot: slot, proposerIndex: proposerIndex}
+	et.mu.Lock()
+	seen, ok := et.proposals[key]
+	if !ok {
+		if slot > et.finalizedSlot &&
+			len(et.proposals) < maxTrackedProposals {
+			et.proposals[key] = seenProposal[BeaconBlockHeaderT]{
+				root:   root,
+				header: header,
+			}
+		}
+		et.mu.Unlock()
+		return
+	}
+	et.mu.Unlock()
+
+	if seen.root == root {
+		return
+	}
+
+	et.logger.Warn(
+		"Proposer equivocation detected ⚠️",
+		"slot", slot,
+		"proposer_index", proposerIndex,
+		"previous_root", seen.root,
+		"conflicting_root", root,
+	)
+	if err := et.dispatcher.Publish(
+		async.NewEvent(
+			ctx, async.EquivocationDetected,
+			Equivocation[BeaconBlockHeaderT]{
+				Slot:          slot,
+				ProposerIndex: proposerIndex,
+				Previous:      seen.header,
+				Conflicting:   header,
+			},
+		),
+	); err != nil {
+		et.logger.Error(
+			"Failed to publish equivocation detected event", "error", err,
+		)
+	}
+}
+
+// onFinalized records that the block of the given slot has been finalized,
+// clearing the proposals of the slots up to it.
+func (et *equivocationTracker[_]) onFinalized(slot math.Slot) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	et.finalizedSlot = max(et.finalizedSlot, slot)
+	for key := range et.proposals {
+		if key.slot <= et.finalizedSlot {
+			delete(et.proposals, key)
+		}
+	}
+}
+
+// numTracked returns the number of proposals tracked.
+func (et *equivocationTracker[_]) numTracked() int {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	return len(et.proposals)
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testHeader is a block header identified by its root.
+type testHeader struct {
+	root common.Root
+}
+
+func TestEquivocationTracker(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		dispatcher = &testDispatcher{}
+		et         = newEquivocationTracker[testHeader](
+			noop.NewLogger[any](), dispatcher,
+		)
+		first       = testHeader{root: common.Root{0x01}}
+		conflicting = testHeader{root: common.Root{0x02}}
+	)
+
+	// Seeing the same proposal again, or proposals by other proposers or
+	// for other slots, is not an equivocation.
+	et.onProposal(ctx, 5, 1, first.root, first)
+	et.onProposal(ctx, 5, 1, first.root, first)
+	et.onProposal(ctx, 5, 2, conflicting.root, conflicting)
+	et.onProposal(ctx, 6, 1, conflicting.root, conflicting)
+	require.Empty(t, dispatcher.published)
+	require.Equal(t, 3, et.numTracked())
+
+	// A conflicting proposal by the same proposer for the same slot is.
+	et.onProposal(ctx, 5, 1, conflicting.root, conflicting)
+	require.Len(t, dispatcher.published, 1)
+	event, ok := dispatcher.published[0].(async.Event[Equivocation[testHeader]])
+	require.True(t, ok)
+	require.True(t, event.Is(async.EquivocationDetected))
+	require.Equal(t, Equivocation[testHeader]{
+		Slot:          5,
+		ProposerIndex: 1,
+		Previous:      first,
+		Conflicting:   conflicting,
+	}, event.Data())
+
+	// Proposals are cleared once their slot is finalized, and proposals for
+	// finalized slots are no longer tracked.
+	et.onFinalized(5)
+	require.Equal(t, 1, et.numTracked())
+	et.onProposal(ctx, 5, 3, first.root, first)
+	require.Equal(t, 1, et.numTracked())
+	et.onFinalized(6)
+	require.Zero(t, et.numTracked())
+}
+
+func TestEquivocationTrackerBounded(t *testing.T) {
+	et := newEquivocationTracker[testHeader](
+		noop.NewLogger[any](), &testDispatcher{},
+	)
+	for i := range maxTrackedProposals + 10 {
+		et.onProposal(
+			context.Background(), 1, math.ValidatorIndex(i),
+			common.Root{}, testHeader{},
+		)
+	}
+	require.Equal(t, maxTrackedProposals, et.numTracked())
+}
@@ -27,4 +27,10 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable indicates that the required data is not available.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrUnknownParent indicates that the parent of a block is not the block
+	// finalized last.
+	ErrUnknownParent = errors.New("unknown parent block")
+	// ErrKnownBadParent indicates that the parent of a block is a block that
+	// was rejected.
+	ErrKnownBadParent = errors.New("parent block is known to be bad")
 )
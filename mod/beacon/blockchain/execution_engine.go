@@ -23,7 +23,6 @@ package blockchain
 import (
 	"context"
 
-	payloadtime "github.com/berachain/beacon-kit/mod/beacon/payload-time"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 )
 
@@ -74,14 +73,10 @@ func (s *Service[
 		return
 	}
 
-	prevBlockRoot := blk.HashTreeRoot()
-	payloadTime := blk.GetBody().GetExecutionPayload().GetTimestamp()
 	if _, err = s.localBuilder.RequestPayloadAsync(
 		ctx,
 		stCopy,
 		blk.GetSlot()+1,
-		payloadtime.Next(s.chainSpec, payloadTime),
-		prevBlockRoot,
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
 	); err != nil {
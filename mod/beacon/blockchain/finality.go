@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// finalityTracker tracks the epoch of the last finalized block against the
+// epoch of the latest block seen, in order to detect stalled finalization.
+type finalityTracker struct {
+	// logger is used for logging stalled finality.
+	logger log.Logger
+	// dispatcher is used to publish FinalityStalled events.
+	dispatcher asynctypes.EventDispatcher
+	// metrics is the metrics for the service.
+	metrics *chainMetrics
+	// threshold is the number of epochs without finality after which
+	// finality is considered stalled. A value of 0 disables reporting.
+	threshold uint64
+
+	// mu protects the epochs below.
+	mu sync.RWMutex
+	// finalizedEpoch is the epoch of the last finalized block.
+	finalizedEpoch math.Epoch
+	// headEpoch is the epoch of the latest block seen.
+	headEpoch math.Epoch
+}
+
+// newFinalityTracker creates a new finalityTracker.
+func newFinalityTracker(
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	metrics *chainMetrics,
+	threshold uint64,
+) *finalityTracker {
+	return &finalityTracker{
+		logger:     logger,
+		dispatcher: dispatcher,
+		metrics:    metrics,
+		threshold:  threshold,
+	}
+}
+
+// epochsSinceFinality returns the number of epochs between the latest block
+// seen and the last finalized block.
+func (ft *finalityTracker) epochsSinceFinality() uint64 {
+	ft.mu.RLock()
+	defer ft.mu.RUnlock()
+	return ft.sinceFinality()
+}
+
+// onFinalized records that a block of the given epoch has been finalized.
+func (ft *finalityTracker) onFinalized(epoch math.Epoch) {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	ft.finalizedEpoch = max(ft.finalizedEpoch, epoch)
+	ft.headEpoch = max(ft.headEpoch, epoch)
+}
+
+// onSeen records that a block of the given epoch has been seen. If this
+// advances the head past the stall threshold, a FinalityStalled event is
+// published with the number of epochs since finality.
+func (ft *finalityTracker) onSeen(ctx context.Context, epoch math.Epoch) {
+	ft.mu.Lock()
+	if epoch <= ft.headEpoch {
+		ft.mu.Unlock()
+		return
+	}
+	ft.headEpoch = epoch
+	epochsSinceFinality := ft.sinceFinality()
+	ft.mu.Unlock()
+
+	if ft.threshold == 0 || epochsSinceFinality <= ft.threshold {
+		return
+	}
+
+	ft.logger.Warn(
+		"Finality has stalled ⚠️",
+		"epochs_since_finality", epochsSinceFinality,
+		"threshold", ft.threshold,
+	)
+	ft.metrics.markFinalityStalled(epochsSinceFinality)
+	if err := ft.dispatcher.Publish(
+		async.NewEvent(
+			ctx, async.FinalityStalled, math.Epoch(epochsSinceFinality),
+		),
+	); err != nil {
+		ft.logger.Error(
+			"Failed to publish finality stalled event", "error", err,
+		)
+	}
+}
+
+// sinceFinality returns the number of epochs since finality. The caller must
+// hold the lock.
+func (ft *finalityTracker) sinceFinality() uint64 {
+	return ft.headEpoch.Unwrap() - ft.finalizedEpoch.Unwrap()
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testDispatcher records the events published to it.
+type testDispatcher struct {
+	published []async.BaseEvent
+}
+
+func (d *testDispatcher) Publish(event async.BaseEvent) error {
+	d.published = append(d.published, event)
+	return nil
+}
+
+func (d *testDispatcher) Subscribe(async.EventID, any) error {
+	return nil
+}
+
+func (d *testDispatcher) Unsubscribe(async.EventID, any) error {
+	return nil
+}
+
+// testTelemetrySink records the counters incremented on it.
+type testTelemetrySink struct {
+	counters map[string]int
+}
+
+func (s *testTelemetrySink) IncrementCounter(key string, _ ...string) {
+	s.counters[key]++
+}
+
+func (s *testTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+func (s *testTelemetrySink) SetGauge(string, int64, ...string) {}
+
+func TestFinalityTrackerStalled(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		dispatcher = &testDispatcher{}
+		sink       = &testTelemetrySink{counters: make(map[string]int)}
+		ft         = newFinalityTracker(
+			noop.NewLogger[any](), dispatcher, newChainMetrics(sink), 2,
+		)
+	)
+
+	ft.onFinalized(1)
+	require.Zero(t, ft.epochsSinceFinality())
+
+	// Advance epochs without finality, up to the threshold.
+	ft.onSeen(ctx, 2)
+	ft.onSeen(ctx, 3)
+	require.Equal(t, uint64(2), ft.epochsSinceFinality())
+	require.Empty(t, dispatcher.published)
+
+	// Seeing an old epoch again does not change anything.
+	ft.onSeen(ctx, 2)
+	require.Equal(t, uint64(2), ft.epochsSinceFinality())
+
+	// Exceeding the threshold reports stalled finality.
+	ft.onSeen(ctx, 4)
+	ft.onSeen(ctx, 5)
+	require.Equal(t, uint64(4), ft.epochsSinceFinality())
+	require.Len(t, dispatcher.published, 2)
+	require.Equal(
+		t, 2, sink.counters["beacon_kit.blockchain.finality_stalled"],
+	)
+	event, ok := dispatcher.published[1].(async.Event[math.Epoch])
+	require.True(t, ok)
+	require.True(t, event.Is(async.FinalityStalled))
+	require.Equal(t, math.Epoch(4), event.Data())
+
+	// Finality resumes.
+	ft.onFinalized(5)
+	require.Zero(t, ft.epochsSinceFinality())
+}
+
+func TestFinalityTrackerDisabled(t *testing.T) {
+	var (
+		dispatcher = &testDispatcher{}
+		sink       = &testTelemetrySink{counters: make(map[string]int)}
+		ft         = newFinalityTracker(
+			noop.NewLogger[any](), dispatcher, newChainMetrics(sink), 0,
+		)
+	)
+
+	ft.onSeen(context.Background(), 10)
+	require.Equal(t, uint64(10), ft.epochsSinceFinality())
+	require.Empty(t, dispatcher.published)
+	require.Empty(t, sink.counters)
+}
@@ -21,6 +21,7 @@
 package blockchain
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -116,3 +117,43 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markFinalityStalled increments the counter for the number of times
+// finality was detected to be stalled.
+func (cm *chainMetrics) markFinalityStalled(epochsSinceFinality uint64) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.finality_stalled",
+		"epochs_since_finality",
+		strconv.FormatUint(epochsSinceFinality, 10),
+	)
+}
+
+// setBackpressure sets the gauge of the number of execution engine calls
+// queued.
+func (cm *chainMetrics) setBackpressure(depth uint64) {
+	cm.sink.SetGauge(
+		"beacon_kit.blockchain.backpressure",
+		//#nosec:G115 // the depth does not overflow in practice.
+		int64(depth),
+	)
+}
+
+// setUnsweptWithdrawableValidators sets the gauge of the number of
+// validators eligible for a withdrawal which the next payload does not sweep.
+func (cm *chainMetrics) setUnsweptWithdrawableValidators(count uint64) {
+	cm.sink.SetGauge(
+		"beacon_kit.blockchain.unswept_withdrawable_validators",
+		//#nosec:G115 // the count does not overflow in practice.
+		int64(count),
+	)
+}
+
+// markEmptyValidatorSet increments the counter for the number of times no
+// validator was found active in an epoch.
+func (cm *chainMetrics) markEmptyValidatorSet(epoch math.Epoch) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.empty_validator_set",
+		"epoch",
+		epoch.Base10(),
+	)
+}
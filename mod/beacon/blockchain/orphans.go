@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// maxTrackedBadBlocks is the maximum number of rejected blocks remembered as
+// known-bad at once. The oldest ones are forgotten beyond it.
+const maxTrackedBadBlocks = 1024
+
+// orphanage tracks the root of the block finalized last and the roots of the
+// blocks known to be bad, telling the proposals building on a parent other
+// than the former apart from those building on one of the latter.
+//
+// A proposal is never held awaiting its parent: CometBFT only asks to verify
+// a proposal once its parent is finalized, hence a parent which is not known
+// by then never arrives.
+type orphanage struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// head is the root of the block finalized last. It is zero until a block
+	// is finalized after the service starts.
+	head common.Root
+	// bad is the set of the roots of the blocks known to be bad.
+	bad map[common.Root]struct{}
+	// badOrder holds the roots in bad in the order they were added.
+	badOrder []common.Root
+}
+
+// newOrphanage creates a new orphanage.
+func newOrphanage() *orphanage {
+	return &orphanage{bad: make(map[common.Root]struct{})}
+}
+
+// markBad records the block with the given root as known-bad.
+func (o *orphanage) markBad(root common.Root) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.bad[root]; ok {
+		return
+	}
+	if len(o.badOrder) == maxTrackedBadBlocks {
+		delete(o.bad, o.badOrder[0])
+		o.badOrder = o.badOrder[1:]
+	}
+	o.bad[root] = struct{}{}
+	o.badOrder = append(o.badOrder, root)
+}
+
+// isBad returns whether the block with the given root is known to be bad.
+func (o *orphanage) isBad(root common.Root) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, ok := o.bad[root]
+	return ok
+}
+
+// onArrived records that the block with the given root has been finalized.
+// The blocks known to be bad are forgotten: they were proposed for the
+// height of the block, hence no proposal may build on them any longer, and
+// the block itself was bad only to this node if it was among them.
+func (o *orphanage) onArrived(root common.Root) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.head = root
+	clear(o.bad)
+	o.badOrder = o.badOrder[:0]
+}
+
+// verifyParent rejects a proposal whose parent is known to be bad with
+// ErrKnownBadParent, and one whose parent is not the block finalized last
+// with ErrUnknownParent. Until a block is finalized, the parent of a
+// proposal is left to the state transition to verify.
+func (o *orphanage) verifyParent(parentRoot common.Root) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.bad[parentRoot]; ok {
+		return ErrKnownBadParent
+	}
+	if o.head != (common.Root{}) && parentRoot != o.head {
+		return ErrUnknownParent
+	}
+	return nil
+}
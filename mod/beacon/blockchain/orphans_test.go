@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphanageRejectsUnknownParent(t *testing.T) {
+	var (
+		o      = newOrphanage()
+		parent = common.Root{0x01}
+	)
+
+	// Until a block is finalized, the parent is left to the transition.
+	require.NoError(t, o.verifyParent(parent))
+
+	// Afterwards, only the block finalized last is a known parent.
+	o.onArrived(parent)
+	require.NoError(t, o.verifyParent(parent))
+	require.ErrorIs(t, o.verifyParent(common.Root{0x02}), ErrUnknownParent)
+}
+
+func TestOrphanageRejectsKnownBadParent(t *testing.T) {
+	var (
+		o      = newOrphanage()
+		parent = common.Root{0x01}
+	)
+	o.markBad(parent)
+	require.ErrorIs(t, o.verifyParent(parent), ErrKnownBadParent)
+}
+
+func TestOrphanageForgetsBadBlocksOnArrival(t *testing.T) {
+	var (
+		o     = newOrphanage()
+		blk   = common.Root{0x01}
+		other = common.Root{0x02}
+	)
+	o.markBad(blk)
+	o.markBad(other)
+
+	// A block which was bad only to this node may still be finalized, after
+	// which no proposal may build on the other blocks of its height.
+	o.onArrived(blk)
+	require.False(t, o.isBad(blk))
+	require.False(t, o.isBad(other))
+	require.NoError(t, o.verifyParent(blk))
+	require.ErrorIs(t, o.verifyParent(other), ErrUnknownParent)
+}
+
+func TestOrphanageBadBlocksBounded(t *testing.T) {
+	var (
+		o    = newOrphanage()
+		root = func(i int) common.Root {
+			return common.Root{byte(i), byte(i >> 8)}
+		}
+	)
+	for i := range maxTrackedBadBlocks + 1 {
+		o.markBad(root(i))
+	}
+
+	// The oldest bad block is forgotten beyond the bound.
+	require.False(t, o.isBad(root(0)))
+	require.True(t, o.isBad(root(1)))
+	require.True(t, o.isBad(root(maxTrackedBadBlocks)))
+}
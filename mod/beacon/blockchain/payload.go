@@ -22,8 +22,6 @@ package blockchain
 
 import (
 	"context"
-
-	payloadtime "github.com/berachain/beacon-kit/mod/beacon/payload-time"
 )
 
 // forceStartupHead sends a force head FCU to the execution client.
@@ -69,8 +67,8 @@ func (s *Service[
 	}
 }
 
-// rebuildPayloadForRejectedBlock rebuilds a payload for the current
-// slot, if the incoming block was rejected.
+// rebuildPayloadForRejectedBlock rebuilds a payload for the slot of the
+// incoming block, if it was rejected.
 //
 // NOTE: We cannot use any data off the incoming block and must recompute
 // any required information from our local state. We do this since we have
@@ -84,23 +82,11 @@ func (s *Service[
 ) error {
 	s.logger.Info("Rebuilding payload for rejected block ⏳ ")
 
-	// In order to rebuild a payload for the current slot, we need to know the
-	// previous block root, since we know that this is an unmodified state.
-	// We can safely get the latest block header and then rebuild the
-	// previous block and it's root.
-	latestHeader, err := st.GetLatestBlockHeader()
-	if err != nil {
-		return err
-	}
-
 	stateSlot, err := st.GetSlot()
 	if err != nil {
 		return err
 	}
 
-	// Set the previous state root on the header.
-	latestHeader.SetStateRoot(st.HashTreeRoot())
-
 	// We need to get the *last* finalized execution payload, thus
 	// the BeaconState that was passed in must be `unmodified`.
 	lph, err := st.GetLatestExecutionPayloadHeader()
@@ -108,15 +94,20 @@ func (s *Service[
 		return err
 	}
 
+	// The rejected block was proposed for the slot following the latest
+	// finalized block, the state is processed up to it such that the root of
+	// the latest block is present in its block roots.
+	slot := stateSlot + 1
+	stCopy := st.Copy()
+	if _, err = s.stateProcessor.ProcessSlots(stCopy, slot); err != nil {
+		return err
+	}
+
 	// Submit a request for a new payload.
 	if _, err = s.localBuilder.RequestPayloadAsync(
 		ctx,
-		st,
-		// We are rebuilding for the current slot.
-		stateSlot,
-		payloadtime.Next(s.chainSpec, lph.GetTimestamp()),
-		// We set the parent root to the previous block root.
-		latestHeader.HashTreeRoot(),
+		stCopy,
+		slot,
 		// We set the head of our chain to the previous finalized block.
 		lph.GetBlockHash(),
 		// We can say that the payload from the previous block is *finalized*,
@@ -124,10 +115,10 @@ func (s *Service[
 		// and possibly should be made more explicit later on.
 		lph.GetParentHash(),
 	); err != nil {
-		s.metrics.markRebuildPayloadForRejectedBlockFailure(stateSlot, err)
+		s.metrics.markRebuildPayloadForRejectedBlockFailure(slot, err)
 		return err
 	}
-	s.metrics.markRebuildPayloadForRejectedBlockSuccess(stateSlot)
+	s.metrics.markRebuildPayloadForRejectedBlockSuccess(slot)
 	return nil
 }
 
@@ -176,10 +167,6 @@ func (s *Service[
 	if _, err := s.localBuilder.RequestPayloadAsync(
 		ctx, st,
 		slot,
-		payloadtime.Next(s.chainSpec, payload.GetTimestamp()),
-		// The previous block root is simply the root of the block we just
-		// processed.
-		blk.HashTreeRoot(),
 		// We set the head of our chain to the block we just processed.
 		payload.GetBlockHash(),
 		// We can say that the payload from the previous block is *finalized*,
@@ -58,18 +58,38 @@ func (s *Service[
 	}
 
 	st := s.storageBackend.StateFromContext(ctx)
-	valUpdates, err := s.executeStateTransition(ctx, st, blk)
+	root, err := blk.ComputeBlockRoot()
 	if err != nil {
 		return nil, err
 	}
 
+	// Reuse the transition of the block if it was verified and its post
+	// state saved on ProcessProposal, otherwise run it.
+	valUpdates, verified := s.verified.take(root, func() bool {
+		return s.isParentRoot(st, root)
+	})
+	if !verified {
+		valUpdates, err = s.executeStateTransition(ctx, st, blk)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.orphans.onArrived(root)
+	s.trackUnsweptWithdrawals(st)
+	s.finality.onFinalized(s.chainSpec.SlotToEpoch(blk.GetSlot()))
+	s.equivocations.onFinalized(blk.GetSlot())
+	valUpdates = s.validatorSet.check(
+		ctx, s.chainSpec.SlotToEpoch(blk.GetSlot()), valUpdates,
+	)
+
 	// If the blobs needed to process the block are not available, we
-	// return an error. It is safe to use the slot off of the beacon block
-	// since it has been verified as correct already.
-	if !s.storageBackend.AvailabilityStore().IsDataAvailable(
-		ctx, blk.GetSlot(), blk.GetBody(),
-	) {
-		return nil, ErrDataNotAvailable
+	// quarantine the block until they are, or return an error if they do not
+	// become available in time. It is safe to use the slot off of the beacon
+	// block since it has been verified as correct already.
+	if !s.isDataAvailable(ctx, blk) {
+		if err = s.awaitDataAvailability(ctx, blk); err != nil {
+			return nil, err
+		}
 	}
 
 	// If required, we want to forkchoice at the end of post
@@ -85,11 +105,61 @@ func (s *Service[
 		return nil, err
 	}
 
-	go s.sendPostBlockFCU(ctx, st, blk)
+	s.goEngine(func() { s.sendPostBlockFCU(ctx, st, blk) })
 
 	return valUpdates.CanonicalSort(), nil
 }
 
+// trackUnsweptWithdrawals reports the number of validators eligible for a
+// withdrawal which the next payload does not sweep, for operators to size
+// the sweep.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _,
+]) trackUnsweptWithdrawals(st BeaconStateT) {
+	unswept, err := st.UnsweptWithdrawableValidators()
+	if err != nil {
+		s.logger.Warn(
+			"Failed to count the unswept withdrawable validators",
+			"error", err,
+		)
+		return
+	}
+	s.metrics.setUnsweptWithdrawableValidators(unswept)
+}
+
+// ReplayBeaconBlock runs the transition of a finalized beacon block again,
+// for its post state to be compared against the state finalized. Only the
+// state is written: the payload is not sent to the execution client, the
+// operations are not audited and the trackers of the service are left as
+// they are.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) ReplayBeaconBlock(
+	ctx context.Context,
+	blk BeaconBlockT,
+) (transition.ValidatorUpdates, error) {
+	// If the block is nil, exit early.
+	if blk.IsNil() {
+		return nil, ErrNilBlk
+	}
+
+	valUpdates, err := s.stateProcessor.Transition(
+		&transition.Context{
+			Context:          ctx,
+			OptimisticEngine: true,
+			// The payload is sent to the execution client by the
+			// finalization of the block itself.
+			SkipPayloadVerification: true,
+		},
+		s.storageBackend.StateFromContext(ctx),
+		blk,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return valUpdates.CanonicalSort(), nil
+}
+
 // executeStateTransition runs the stf.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
@@ -125,9 +195,79 @@ func (s *Service[
 			// the "verification aspect" of this NewPayload call is
 			// actually irrelevant at this point.
 			SkipPayloadVerification: false,
+
+			// The resulting state is committed, so its operations are
+			// recorded in the audit log.
+			AuditOperations: true,
 		},
 		st,
 		blk,
 	)
 	return valUpdates, err
 }
+
+// isDataAvailable returns whether the blobs referenced in the block are
+// available.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) isDataAvailable(ctx context.Context, blk BeaconBlockT) bool {
+	return s.storageBackend.AvailabilityStore().IsDataAvailable(
+		ctx, blk.GetSlot(), blk.GetBody(),
+	)
+}
+
+// awaitDataAvailability quarantines the block until its data becomes
+// available as sidecars are processed, returning an error if the quarantine
+// TTL elapses first or quarantining is disabled.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) awaitDataAvailability(ctx context.Context, blk BeaconBlockT) error {
+	if s.quarantine == nil {
+		return ErrDataNotAvailable
+	}
+
+	root, err := blk.ComputeBlockRoot()
+	if err != nil {
+		return err
+	}
+	promoted := s.quarantine.add(root, blk, time.Now())
+	s.logger.Warn(
+		"Quarantining block until its data is available",
+		"slot", blk.GetSlot().Base10(), "block_root", root,
+	)
+	timer := time.NewTimer(s.quarantine.ttl)
+	defer timer.Stop()
+
+	// The sidecars may have been processed before the block was quarantined.
+	s.recheckQuarantine(ctx)
+	for {
+		select {
+		case <-promoted:
+			return nil
+		case event := <-s.subFinalSidecarsProcessed:
+			s.recheckQuarantine(event.Context())
+		case <-timer.C:
+			s.quarantine.remove(root)
+			return ErrDataNotAvailable
+		case <-ctx.Done():
+			s.quarantine.remove(root)
+			return ctx.Err()
+		}
+	}
+}
+
+// recheckQuarantine promotes the quarantined blocks whose data has become
+// available.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) recheckQuarantine(ctx context.Context) {
+	for _, root := range s.quarantine.recheck(
+		time.Now(),
+		func(blk BeaconBlockT) bool { return s.isDataAvailable(ctx, blk) },
+	) {
+		s.logger.Info(
+			"Promoting quarantined block with available data",
+			"block_root", root,
+		)
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// quarantinedBlock is a block held in quarantine until its data becomes
+// available.
+type quarantinedBlock[BeaconBlockT any] struct {
+	// blk is the quarantined block.
+	blk BeaconBlockT
+	// expiry is the time after which the block is finally rejected.
+	expiry time.Time
+	// promoted is closed once the data of the block is available.
+	promoted chan struct{}
+}
+
+// blockQuarantine holds finalized blocks whose blob data is not yet
+// available, keyed by block root. The availability of the blocks is
+// re-checked as sidecars are processed, promoting the blocks whose data has
+// become available. Blocks are dropped once their TTL elapses, and the oldest
+// block is evicted to make room once the quarantine is full.
+//
+// The quarantine is not safe for concurrent use.
+type blockQuarantine[BeaconBlockT any] struct {
+	// size is the maximum number of quarantined blocks.
+	size int
+	// ttl is how long a block is held before it is finally rejected.
+	ttl time.Duration
+	// blocks are the quarantined blocks by root.
+	blocks map[common.Root]*quarantinedBlock[BeaconBlockT]
+	// order holds the roots of the quarantined blocks, oldest first.
+	order []common.Root
+}
+
+// newBlockQuarantine creates a new blockQuarantine. It returns nil if the
+// size is 0, which disables quarantining.
+func newBlockQuarantine[BeaconBlockT any](
+	size uint64,
+	ttl time.Duration,
+) *blockQuarantine[BeaconBlockT] {
+	if size == 0 {
+		return nil
+	}
+	return &blockQuarantine[BeaconBlockT]{
+		//#nosec:G701 // the size is configured by the operator.
+		size:   int(size),
+		ttl:    ttl,
+		blocks: make(map[common.Root]*quarantinedBlock[BeaconBlockT]),
+	}
+}
+
+// add quarantines the block with the given root until its TTL elapses. It
+// returns a channel which is closed once the block is promoted. Adding a
+// block which is already quarantined returns its existing channel.
+func (q *blockQuarantine[BeaconBlockT]) add(
+	root common.Root,
+	blk BeaconBlockT,
+	now time.Time,
+) <-chan struct{} {
+	if qb, ok := q.blocks[root]; ok {
+		return qb.promoted
+	}
+
+	q.prune(now)
+	if len(q.order) >= q.size {
+		q.remove(q.order[0])
+	}
+
+	qb := &quarantinedBlock[BeaconBlockT]{
+		blk:      blk,
+		expiry:   now.Add(q.ttl),
+		promoted: make(chan struct{}),
+	}
+	q.blocks[root] = qb
+	q.order = append(q.order, root)
+	return qb.promoted
+}
+
+// recheck drops the expired blocks and promotes the blocks whose data is
+// available, returning the roots of the promoted blocks.
+func (q *blockQuarantine[BeaconBlockT]) recheck(
+	now time.Time,
+	isAvailable func(BeaconBlockT) bool,
+) []common.Root {
+	q.prune(now)
+
+	var promoted []common.Root
+	for _, root := range slices.Clone(q.order) {
+		qb := q.blocks[root]
+		if !isAvailable(qb.blk) {
+			continue
+		}
+		close(qb.promoted)
+		q.remove(root)
+		promoted = append(promoted, root)
+	}
+	return promoted
+}
+
+// contains returns whether the block with the given root is quarantined.
+func (q *blockQuarantine[_]) contains(root common.Root) bool {
+	_, ok := q.blocks[root]
+	return ok
+}
+
+// remove drops the block with the given root from the quarantine.
+func (q *blockQuarantine[_]) remove(root common.Root) {
+	if _, ok := q.blocks[root]; !ok {
+		return
+	}
+	delete(q.blocks, root)
+	q.order = slices.DeleteFunc(q.order, func(r common.Root) bool {
+		return r == root
+	})
+}
+
+// prune drops the blocks whose TTL has elapsed.
+func (q *blockQuarantine[_]) prune(now time.Time) {
+	for len(q.order) > 0 && !now.Before(q.blocks[q.order[0]].expiry) {
+		q.remove(q.order[0])
+	}
+}
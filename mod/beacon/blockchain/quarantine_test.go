@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// isPromoted returns whether the promotion channel has been closed.
+func isPromoted(promoted <-chan struct{}) bool {
+	select {
+	case <-promoted:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestBlockQuarantinePromotion(t *testing.T) {
+	var (
+		now       = time.Unix(1_700_000_000, 0)
+		q         = newBlockQuarantine[string](4, time.Second)
+		available = map[string]bool{}
+		check     = func(blk string) bool { return available[blk] }
+	)
+
+	first := q.add(common.Root{0x01}, "first", now)
+	second := q.add(common.Root{0x02}, "second", now)
+
+	// Nothing is promoted while the data is unavailable.
+	require.Empty(t, q.recheck(now, check))
+	require.False(t, isPromoted(first))
+
+	// The block whose sidecars arrived is promoted, the other one is held.
+	available["first"] = true
+	require.Equal(
+		t, []common.Root{{0x01}}, q.recheck(now.Add(time.Millisecond), check),
+	)
+	require.True(t, isPromoted(first))
+	require.False(t, q.contains(common.Root{0x01}))
+	require.False(t, isPromoted(second))
+	require.True(t, q.contains(common.Root{0x02}))
+
+	// Re-adding a quarantined block keeps its promotion channel.
+	require.Equal(t, second, q.add(common.Root{0x02}, "second", now))
+
+	// Once its TTL elapses the block is rejected, even if its data arrives.
+	available["second"] = true
+	require.Empty(t, q.recheck(now.Add(time.Second), check))
+	require.False(t, isPromoted(second))
+	require.False(t, q.contains(common.Root{0x02}))
+}
+
+func TestBlockQuarantineEviction(t *testing.T) {
+	var (
+		now = time.Unix(1_700_000_000, 0)
+		q   = newBlockQuarantine[string](2, time.Second)
+	)
+
+	q.add(common.Root{0x01}, "first", now)
+	q.add(common.Root{0x02}, "second", now)
+	q.add(common.Root{0x03}, "third", now)
+
+	// The oldest block is evicted to make room.
+	require.False(t, q.contains(common.Root{0x01}))
+	require.True(t, q.contains(common.Root{0x02}))
+	require.True(t, q.contains(common.Root{0x03}))
+
+	// A disabled quarantine holds nothing.
+	require.Nil(t, newBlockQuarantine[string](0, time.Second))
+}
@@ -26,6 +26,7 @@ import (
 
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -53,11 +54,53 @@ func (s *Service[
 		)
 		return errors.WrapNonFatal(ErrNilBlk)
 	}
+	root, err := blk.ComputeBlockRoot()
+	if err != nil {
+		return errors.WrapNonFatal(err)
+	}
 
 	s.logger.Info(
 		"Received incoming beacon block",
 		"state_root", blk.GetStateRoot(), "slot", blk.GetSlot(),
 	)
+	s.finality.onSeen(ctx, s.chainSpec.SlotToEpoch(blk.GetSlot()))
+
+	// Reject the incoming block outright if its parent is known to be bad or
+	// is not the block finalized last.
+	if err := s.orphans.verifyParent(blk.GetParentBlockRoot()); err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			"slot",
+			blk.GetSlot(),
+			"parent_root",
+			blk.GetParentBlockRoot(),
+			"reason",
+			err,
+		)
+		return err
+	}
+
+	// Verify the incoming block adheres to the local proposal policy.
+	payload := blk.GetBody().GetExecutionPayload()
+	err = s.proposalPolicy.VerifyFeeRecipient(payload.GetFeeRecipient())
+	if err == nil {
+		err = s.proposalPolicy.VerifyExtraData(payload.GetExtraData())
+	}
+	if err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			"slot",
+			blk.GetSlot(),
+			"reason",
+			err,
+		)
+
+		if s.shouldBuildOptimisticPayloads() {
+			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
+		}
+
+		return err
+	}
 
 	// We purposefully make a copy of the BeaconState in order
 	// to avoid modifying the underlying state, for the event in which
@@ -66,7 +109,10 @@ func (s *Service[
 	postState := preState.Copy()
 
 	// Verify the state root of the incoming block.
-	if err := s.verifyStateRoot(ctx, postState, blk); err != nil {
+	valUpdates, emit, complete, err := s.verifyStateRoot(
+		ctx, postState, blk,
+	)
+	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
 			"state_root",
@@ -74,6 +120,9 @@ func (s *Service[
 			"reason",
 			err,
 		)
+		if s.isInvalidBlock(ctx, preState, blk, err) {
+			s.orphans.markBad(root)
+		}
 
 		if s.shouldBuildOptimisticPayloads() {
 			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
@@ -88,46 +137,128 @@ func (s *Service[
 		blk.GetStateRoot(),
 	)
 
+	// Save the verified post state into the state of the proposal, such that
+	// it is reused if the block is finalized.
+	if s.verified != nil && complete {
+		postState.Save()
+		s.verified.add(root, valUpdates, emit)
+	}
+	s.equivocations.onProposal(
+		ctx, blk.GetSlot(), blk.GetProposerIndex(), root,
+		blk.GetHeader(),
+	)
+
 	if s.shouldBuildOptimisticPayloads() {
-		go s.handleOptimisticPayloadBuild(ctx, postState, blk)
+		s.goEngine(func() {
+			s.handleOptimisticPayloadBuild(ctx, postState, blk)
+		})
 	}
 
 	return nil
 }
 
-// verifyStateRoot verifies the state root of an incoming block.
+// verifyStateRoot verifies the state root of an incoming block, returning the
+// validator updates of its transition and whether the transition completed.
+// If the post state may be reused on FinalizeBlock, the records of the
+// transition are held back, to be emitted with the returned function once
+// the block is finalized.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
 ]) verifyStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) error {
+) (transition.ValidatorUpdates, func(), bool, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
-	if _, err := s.stateProcessor.Transition(
+
+	var (
+		valUpdates transition.ValidatorUpdates
+		emit       = func() {}
+		err        error
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
-		&transition.Context{
+		// The payload is verified before the block is accepted, as an
+		// accepted block is final and cannot be reverted if its payload
+		// later turns out to be invalid.
+		transitionCtx = &transition.Context{
 			Context:                 ctx,
 			OptimisticEngine:        false,
 			SkipPayloadVerification: false,
 			SkipValidateResult:      false,
 			SkipValidateRandao:      false,
-		},
-		st, blk,
-	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
+		}
+	)
+	if s.verified != nil {
+		valUpdates, emit, err = s.stateProcessor.TransitionDeferred(
+			transitionCtx, st, blk,
+		)
+	} else {
+		valUpdates, err = s.stateProcessor.Transition(transitionCtx, st, blk)
+	}
+	if errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
 		// It is safe for the validator to ignore this error since
 		// the state transition will enforce that the block is part
 		// of the canonical chain.
 		//
 		// TODO: this is only true because we are assuming SSF.
-		return nil
+		return nil, nil, false, nil
 	} else if err != nil {
-		return err
+		return nil, nil, false, err
 	}
 
-	return nil
+	return valUpdates, emit, true, nil
+}
+
+// isInvalidBlock returns whether the block failed verification with the given
+// error because of the block itself, such that every node rejects it, rather
+// than because of this node, e.g. its execution client being unavailable.
+// Unless the execution client found the payload invalid, the block is only
+// invalid if its transition fails again without the execution client.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+]) isInvalidBlock(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	err error,
+) bool {
+	switch {
+	case errors.IsAny(err, context.Canceled, context.DeadlineExceeded):
+		return false
+	case errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+	):
+		return true
+	}
+
+	_, err = s.stateProcessor.Transition(
+		&transition.Context{
+			Context:                 ctx,
+			OptimisticEngine:        true,
+			SkipPayloadVerification: true,
+		},
+		st.Copy(), blk,
+	)
+	return err != nil &&
+		!errors.IsAny(err, context.Canceled, context.DeadlineExceeded)
+}
+
+// isParentRoot returns whether the given root is the root of the latest block
+// of the given state, i.e. of the parent of the block to be built on it.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _,
+]) isParentRoot(st BeaconStateT, root common.Root) bool {
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return false
+	}
+
+	// The state root of the latest header is only set on the next slot.
+	latestHeader.SetStateRoot(st.HashTreeRoot())
+	return latestHeader.HashTreeRoot() == root
 }
 
 // shouldBuildOptimisticPayloads returns true if optimistic
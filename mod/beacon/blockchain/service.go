@@ -23,6 +23,7 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -34,7 +35,7 @@ import (
 // Service is the blockchain service.
 type Service[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
-	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT],
 	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
@@ -72,8 +73,29 @@ type Service[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	]
+	// proposalPolicy is the node-local policy incoming blocks must satisfy.
+	proposalPolicy ProposalPolicy
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
+	// finality tracks the last finalized epoch to detect stalled finality.
+	finality *finalityTracker
+	// validatorSet detects the validator updates of an empty validator set.
+	validatorSet *validatorSetGuard
+	// equivocations tracks the valid proposals seen to detect equivocating
+	// proposers.
+	equivocations *equivocationTracker[BeaconBlockHeaderT]
+	// quarantine holds finalized blocks whose data is not yet available. If
+	// nil, such blocks are rejected outright.
+	quarantine *blockQuarantine[BeaconBlockT]
+	// backpressure tracks the calls queued on the execution engine, which
+	// the validator service refrains from proposing blocks under.
+	backpressure Backpressure
+	// orphans tracks the block finalized last and the blocks known to be
+	// bad, rejecting the incoming blocks building on neither.
+	orphans *orphanage
+	// verified holds the transition of the latest block verified, for
+	// FinalizeBlock to reuse. If nil, every finalized block is transitioned.
+	verified *verifiedCache
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
@@ -82,16 +104,22 @@ type Service[
 
 	// subFinalBlkReceived is a channel holding FinalBeaconBlockReceived events.
 	subFinalBlkReceived chan async.Event[BeaconBlockT]
+	// subReplayBlkReceived is a channel holding ReplayBeaconBlockReceived
+	// events.
+	subReplayBlkReceived chan async.Event[BeaconBlockT]
 	// subBlockReceived is a channel holding BeaconBlockReceived events.
 	subBlockReceived chan async.Event[BeaconBlockT]
 	// subGenDataReceived is a channel holding GenesisDataReceived events.
 	subGenDataReceived chan async.Event[GenesisT]
+	// subFinalSidecarsProcessed is a channel holding FinalSidecarsProcessed
+	// events. It is only subscribed to if quarantining is enabled.
+	subFinalSidecarsProcessed chan async.Event[int]
 }
 
 // NewService creates a new validator service.
 func NewService[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
-	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT],
 	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
@@ -120,13 +148,29 @@ func NewService[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	],
+	proposalPolicy ProposalPolicy,
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
+	finalityStallThreshold uint64,
+	quarantineSize uint64,
+	quarantineTTL time.Duration,
+	backpressure Backpressure,
+	verifiedBlockCache bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	GenesisT, PayloadAttributesT,
 ] {
+	metrics := newChainMetrics(telemetrySink)
+	finality := newFinalityTracker(
+		logger, dispatcher, metrics, finalityStallThreshold,
+	)
+	validatorSet := newValidatorSetGuard(
+		logger, dispatcher, metrics, chainSpec.WithholdEmptyValidatorSet(),
+	)
+	equivocations := newEquivocationTracker[BeaconBlockHeaderT](
+		logger, dispatcher,
+	)
 	return &Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
@@ -139,12 +183,24 @@ func NewService[
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
 		stateProcessor:          stateProcessor,
-		metrics:                 newChainMetrics(telemetrySink),
+		proposalPolicy:          proposalPolicy,
+		metrics:                 metrics,
+		finality:                finality,
+		validatorSet:            validatorSet,
+		equivocations:           equivocations,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
 		forceStartupSyncOnce:    new(sync.Once),
 		subFinalBlkReceived:     make(chan async.Event[BeaconBlockT]),
+		subReplayBlkReceived:    make(chan async.Event[BeaconBlockT]),
 		subBlockReceived:        make(chan async.Event[BeaconBlockT]),
 		subGenDataReceived:      make(chan async.Event[GenesisT]),
+		quarantine: newBlockQuarantine[BeaconBlockT](
+			quarantineSize, quarantineTTL,
+		),
+		subFinalSidecarsProcessed: make(chan async.Event[int]),
+		backpressure:              backpressure,
+		orphans:                   newOrphanage(),
+		verified:                  newVerifiedCache(verifiedBlockCache),
 	}
 }
 
@@ -155,6 +211,31 @@ func (s *Service[
 	return "blockchain"
 }
 
+// EpochsSinceFinality returns the number of epochs between the latest block
+// seen and the last finalized block.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) EpochsSinceFinality() uint64 {
+	return s.finality.epochsSinceFinality()
+}
+
+// Backpressure returns the number of execution engine calls currently queued
+// by the service.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) Backpressure() uint64 {
+	return s.backpressure.Depth()
+}
+
+// goEngine queues the execution engine call fn, reporting the depth of the
+// queue.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) goEngine(fn func()) {
+	s.backpressure.Go(fn)
+	s.metrics.setBackpressure(s.backpressure.Depth())
+}
+
 // Start subscribes the Blockchain service to GenesisDataReceived,
 // BeaconBlockReceived, and FinalBeaconBlockReceived events, and begins
 // the main event loop to handle them accordingly.
@@ -179,6 +260,20 @@ func (s *Service[
 		return err
 	}
 
+	if err := s.dispatcher.Subscribe(
+		async.ReplayBeaconBlockReceived, s.subReplayBlkReceived,
+	); err != nil {
+		return err
+	}
+
+	if s.quarantine != nil {
+		if err := s.dispatcher.Subscribe(
+			async.FinalSidecarsProcessed, s.subFinalSidecarsProcessed,
+		); err != nil {
+			return err
+		}
+	}
+
 	// start the main event loop to listen and handle events.
 	go s.eventLoop(ctx)
 	return nil
@@ -198,6 +293,10 @@ func (s *Service[
 			s.handleBeaconBlockReceived(event)
 		case event := <-s.subFinalBlkReceived:
 			s.handleBeaconBlockFinalization(event)
+		case event := <-s.subReplayBlkReceived:
+			s.handleBeaconBlockReplay(event)
+		case event := <-s.subFinalSidecarsProcessed:
+			s.recheckQuarantine(event.Context())
 		}
 	}
 }
@@ -313,3 +412,45 @@ func (s *Service[
 		)
 	}
 }
+
+// handleBeaconBlockReplay replays the transition of the finalized beacon
+// block and emits a ReplayValidatorUpdatesProcessed event containing the
+// resulting validator updates.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) handleBeaconBlockReplay(
+	msg async.Event[BeaconBlockT],
+) {
+	var (
+		valUpdates transition.ValidatorUpdates
+		replayErr  error
+	)
+	// If there's an error in the event, log it and return
+	if msg.Error() != nil {
+		s.logger.Error("Error replaying beacon block", "error", msg.Error())
+		return
+	}
+
+	// replay the block and get the validator updates
+	valUpdates, replayErr = s.ReplayBeaconBlock(msg.Context(), msg.Data())
+	if replayErr != nil {
+		s.logger.Error("Failed to replay beacon block",
+			"error", replayErr,
+		)
+	}
+
+	// Emit the event containing the validator updates.
+	if err := s.dispatcher.Publish(
+		async.NewEvent(
+			msg.Context(),
+			async.ReplayValidatorUpdatesProcessed,
+			valUpdates,
+			replayErr,
+		),
+	); err != nil {
+		s.logger.Error(
+			"Failed to emit event in replay beacon block",
+			"error", err,
+		)
+	}
+}
@@ -43,15 +43,24 @@ type AvailabilityStore[BeaconBlockBodyT any] interface {
 }
 
 // BeaconBlock represents a beacon block interface.
-type BeaconBlock[BeaconBlockBodyT any] interface {
+type BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT any] interface {
 	constraints.SSZMarshallableRootable
 	constraints.Nillable
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
+	// GetHeader returns the header of the beacon block.
+	GetHeader() BeaconBlockHeaderT
+	// GetParentBlockRoot returns the root of the parent of the beacon block.
+	GetParentBlockRoot() common.Root
 	// GetStateRoot returns the state root of the beacon block.
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
 	GetBody() BeaconBlockBodyT
+	// ComputeBlockRoot returns the canonical root of the beacon block, as
+	// referenced by the parent root of the next block.
+	ComputeBlockRoot() (common.Root, error)
 }
 
 // BeaconBlockBody represents the interface for the beacon block body.
@@ -93,6 +102,10 @@ type ExecutionEngine[PayloadAttributesT any] interface {
 // ExecutionPayload is the interface for the execution payload.
 type ExecutionPayload interface {
 	ExecutionPayloadHeader
+	// GetFeeRecipient returns the fee recipient.
+	GetFeeRecipient() common.ExecutionAddress
+	// GetExtraData returns the extra data.
+	GetExtraData() []byte
 }
 
 // ExecutionPayloadHeader is the interface for the execution payload header.
@@ -124,8 +137,6 @@ type LocalBuilder[BeaconStateT any] interface {
 		ctx context.Context,
 		st BeaconStateT,
 		slot math.Slot,
-		timestamp uint64,
-		parentBlockRoot common.Root,
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (*engineprimitives.PayloadID, error)
@@ -143,6 +154,26 @@ type PayloadAttributes interface {
 	GetSuggestedFeeRecipient() common.ExecutionAddress
 }
 
+// Backpressure tracks the calls queued on the execution engine.
+type Backpressure interface {
+	// Go runs the execution engine call in a new goroutine, counting it as
+	// queued until it returns.
+	Go(fn func())
+	// Depth returns the number of queued execution engine calls.
+	Depth() uint64
+}
+
+// ProposalPolicy defines the node-local rules that an incoming beacon block
+// must satisfy.
+type ProposalPolicy interface {
+	// VerifyFeeRecipient returns an error if the given fee recipient is not
+	// allowed.
+	VerifyFeeRecipient(common.ExecutionAddress) error
+	// VerifyExtraData returns an error if the given execution payload extra
+	// data is not allowed.
+	VerifyExtraData([]byte) error
+}
+
 // ReadOnlyBeaconState defines the interface for accessing various components of
 // the beacon state.
 type ReadOnlyBeaconState[
@@ -152,6 +183,9 @@ type ReadOnlyBeaconState[
 ] interface {
 	// Copy creates a copy of the beacon state.
 	Copy() T
+	// Save writes the changes made to a copy of the beacon state into the
+	// state it was copied from.
+	Save()
 	// GetLatestBlockHeader returns the most recent block header.
 	GetLatestBlockHeader() (
 		BeaconBlockHeaderT,
@@ -167,6 +201,9 @@ type ReadOnlyBeaconState[
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
+	// UnsweptWithdrawableValidators returns the number of validators
+	// eligible for a withdrawal which the next payload does not sweep.
+	UnsweptWithdrawableValidators() (uint64, error)
 }
 
 // StateProcessor defines the interface for processing various state transitions
@@ -197,6 +234,14 @@ type StateProcessor[
 		BeaconStateT,
 		BeaconBlockT,
 	) (transition.ValidatorUpdates, error)
+	// TransitionDeferred processes the state transition for a given block,
+	// holding back the records it gathers until the returned function is
+	// called.
+	TransitionDeferred(
+		ContextT,
+		BeaconStateT,
+		BeaconBlockT,
+	) (transition.ValidatorUpdates, func(), error)
 }
 
 // StorageBackend defines an interface for accessing various storage components
@@ -220,6 +265,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+
+	// SetGauge sets the gauge identified by the provided key to the value.
+	SetGauge(key string, value int64, args ...string)
 }
 
 type ValidatorUpdates = transition.ValidatorUpdates
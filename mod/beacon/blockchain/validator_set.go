@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// validatorSetGuard detects the validator updates of an epoch which leave the
+// CometBFT validator set without voting power, e.g. once all validators of a
+// devnet withdrew, which CometBFT fails to apply.
+type validatorSetGuard struct {
+	// logger is used for logging the empty validator set.
+	logger log.Logger
+	// dispatcher is used to publish EmptyValidatorSet events.
+	dispatcher asynctypes.EventDispatcher
+	// metrics is the metrics for the service.
+	metrics *chainMetrics
+	// withhold determines whether the validator updates of an empty
+	// validator set are withheld, such that CometBFT keeps the current
+	// validator set instead of rejecting the updates. It is part of the
+	// chain spec, as the validator updates are agreed on by all nodes.
+	withhold bool
+}
+
+// newValidatorSetGuard creates a new validatorSetGuard.
+func newValidatorSetGuard(
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	metrics *chainMetrics,
+	withhold bool,
+) *validatorSetGuard {
+	return &validatorSetGuard{
+		logger:     logger,
+		dispatcher: dispatcher,
+		metrics:    metrics,
+		withhold:   withhold,
+	}
+}
+
+// check returns the validator updates of the given epoch. The updates of an
+// epoch cover every validator with its effective balance, i.e. its voting
+// power, hence if they add up to none, an EmptyValidatorSet event is
+// published and, if the chain spec says so, no updates are returned.
+func (g *validatorSetGuard) check(
+	ctx context.Context,
+	epoch math.Epoch,
+	updates transition.ValidatorUpdates,
+) transition.ValidatorUpdates {
+	// The validator set only changes with the updates of an epoch.
+	if len(updates) == 0 {
+		return updates
+	}
+
+	for _, update := range updates {
+		if update.EffectiveBalance != 0 {
+			return updates
+		}
+	}
+
+	g.logger.Warn(
+		"No voting power remains in the validator set ⚠️",
+		"epoch", epoch,
+		"withhold_updates", g.withhold,
+	)
+	g.metrics.markEmptyValidatorSet(epoch)
+	if err := g.dispatcher.Publish(
+		async.NewEvent(ctx, async.EmptyValidatorSet, epoch),
+	); err != nil {
+		g.logger.Error(
+			"Failed to publish empty validator set event", "error", err,
+		)
+	}
+
+	if g.withhold {
+		return nil
+	}
+	return updates
+}
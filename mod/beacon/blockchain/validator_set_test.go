@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// validatorSetUpdates returns the validator updates of an epoch, which cover
+// every validator with its effective balance.
+func validatorSetUpdates(
+	effectiveBalances ...math.Gwei,
+) transition.ValidatorUpdates {
+	updates := make(transition.ValidatorUpdates, len(effectiveBalances))
+	for i, balance := range effectiveBalances {
+		updates[i] = &transition.ValidatorUpdate{
+			Pubkey:           crypto.BLSPubkey{byte(i)},
+			EffectiveBalance: balance,
+		}
+	}
+	return updates
+}
+
+func TestValidatorSetGuardAllValidatorsWithdrew(t *testing.T) {
+	for _, withhold := range []bool{false, true} {
+		var (
+			ctx        = context.Background()
+			dispatcher = &testDispatcher{}
+			sink       = &testTelemetrySink{counters: make(map[string]int)}
+			guard      = newValidatorSetGuard(
+				noop.NewLogger[any](), dispatcher, newChainMetrics(sink),
+				withhold,
+			)
+		)
+
+		// Drive the validators to withdraw one epoch after another, while
+		// at least one of them retains voting power.
+		for epoch, balances := range [][]math.Gwei{
+			{32e9, 32e9, 32e9},
+			{0, 32e9, 32e9},
+			{0, 0, 32e9},
+		} {
+			updates := validatorSetUpdates(balances...)
+			require.Equal(t, updates, guard.check(
+				ctx, math.Epoch(epoch), updates,
+			))
+		}
+		require.Empty(t, dispatcher.published)
+
+		// Blocks which do not update the validator set are not checked.
+		require.Empty(t, guard.check(ctx, 3, nil))
+		require.Empty(t, dispatcher.published)
+
+		// Once the last validator withdrew, the condition is detected.
+		updates := validatorSetUpdates(0, 0, 0)
+		if withhold {
+			require.Empty(t, guard.check(ctx, 3, updates))
+		} else {
+			require.Equal(t, updates, guard.check(ctx, 3, updates))
+		}
+		require.Len(t, dispatcher.published, 1)
+		event, ok := dispatcher.published[0].(async.Event[math.Epoch])
+		require.True(t, ok)
+		require.True(t, event.Is(async.EmptyValidatorSet))
+		require.Equal(t, math.Epoch(3), event.Data())
+		require.Equal(
+			t, 1, sink.counters["beacon_kit.blockchain.empty_validator_set"],
+		)
+	}
+}
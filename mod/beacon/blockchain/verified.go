@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// verifiedCache holds the outcome of the transition of the latest block
+// verified on ProcessProposal, whose post state was saved into the state of
+// the proposal, such that FinalizeBlock reuses it rather than running the
+// transition again. A nil verifiedCache is disabled.
+type verifiedCache struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// block is the latest block verified, if any.
+	block *verifiedBlock
+}
+
+// verifiedBlock is a block whose transition was verified.
+type verifiedBlock struct {
+	// root is the root of the block.
+	root common.Root
+	// valUpdates are the validator updates of the transition of the block.
+	valUpdates transition.ValidatorUpdates
+	// emit emits the audit records and phase timings of the transition of
+	// the block, held back until it is finalized.
+	emit func()
+}
+
+// newVerifiedCache creates a new verifiedCache, or returns nil if disabled.
+func newVerifiedCache(enabled bool) *verifiedCache {
+	if !enabled {
+		return nil
+	}
+	return &verifiedCache{}
+}
+
+// add records the block with the given root as verified, its post state
+// saved, replacing the block verified previously. The records of its
+// transition are emitted with emit once the block is finalized.
+func (c *verifiedCache) add(
+	root common.Root,
+	valUpdates transition.ValidatorUpdates,
+	emit func(),
+) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.block = &verifiedBlock{root: root, valUpdates: valUpdates, emit: emit}
+}
+
+// take returns the validator updates of the block with the given root if it
+// is the block verified, and isPostState reports the state it is finalized
+// on to be its saved post state, emitting the records of its transition. The
+// verified block is discarded either way, as the finalized block differing
+// from it invalidates it, along with the records of its transition.
+func (c *verifiedCache) take(
+	root common.Root,
+	isPostState func() bool,
+) (transition.ValidatorUpdates, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	block := c.block
+	c.block = nil
+	c.mu.Unlock()
+	if block == nil || block.root != root || !isPostState() {
+		return nil, false
+	}
+	block.emit()
+	return block.valUpdates, true
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifiedCacheTransitionsOnce(t *testing.T) {
+	var (
+		block       = common.Root{0x01}
+		other       = common.Root{0x02}
+		valUpdates  = transition.ValidatorUpdates{{}}
+		transitions int
+		emitted     int
+	)
+	// process verifies the block on ProcessProposal, recording it.
+	process := func(c *verifiedCache, root common.Root) {
+		transitions++
+		c.add(root, valUpdates, func() { emitted++ })
+	}
+	// finalize finalizes the block, transitioning only on a miss.
+	finalize := func(
+		c *verifiedCache, root common.Root, isPostState bool,
+	) transition.ValidatorUpdates {
+		updates, ok := c.take(root, func() bool { return isPostState })
+		if !ok {
+			transitions++
+			return valUpdates
+		}
+		return updates
+	}
+
+	// The block is transitioned once across Process and Finalize.
+	c := newVerifiedCache(true)
+	process(c, block)
+	require.Equal(t, valUpdates, finalize(c, block, true))
+	require.Equal(t, 1, transitions)
+	// The records of the verification are emitted once it is reused.
+	require.Equal(t, 1, emitted)
+
+	// The verified block is used only once.
+	transitions = 0
+	require.Equal(t, valUpdates, finalize(c, block, true))
+	require.Equal(t, 1, transitions)
+
+	// Finalizing another block invalidates the verified one.
+	transitions = 0
+	process(c, block)
+	finalize(c, other, true)
+	finalize(c, block, true)
+	require.Equal(t, 3, transitions)
+
+	// As does finalizing it on a state other than its post state.
+	transitions = 0
+	process(c, block)
+	finalize(c, block, false)
+	require.Equal(t, 2, transitions)
+
+	// The records of verifications which are not reused are discarded.
+	require.Equal(t, 1, emitted)
+
+	// A disabled cache always transitions.
+	transitions = 0
+	c = newVerifiedCache(false)
+	require.Nil(t, c)
+	process(c, block)
+	finalize(c, block, true)
+	require.Equal(t, 2, transitions)
+}
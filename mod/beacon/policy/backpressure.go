@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package policy
+
+import (
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrBackpressure is returned when the node is too far behind on the work
+// queued on the execution engine to build another block.
+var ErrBackpressure = errors.New("too much work queued to build block")
+
+// Backpressure tracks the depth of the work queued on the execution engine,
+// signalling once the node falls behind such that it refrains from proposing
+// blocks faster than it can process them. Blocks are still accepted from the
+// other proposers regardless, since rejecting them would not lighten the
+// work queued, but merely stall the chain.
+//
+// The backpressure is safe for concurrent use.
+type Backpressure struct {
+	// threshold is the queue depth at or above which the node is congested.
+	// A value of 0 disables the signal.
+	threshold uint64
+	// engine is the number of queued execution engine calls.
+	engine atomic.Int64
+}
+
+// NewBackpressure creates a new backpressure with the given threshold.
+func NewBackpressure(threshold uint64) *Backpressure {
+	return &Backpressure{threshold: threshold}
+}
+
+// Go runs the execution engine call fn in a new goroutine, counting it as
+// queued until it returns.
+func (b *Backpressure) Go(fn func()) {
+	b.engine.Add(1)
+	go func() {
+		defer b.engine.Add(-1)
+		fn()
+	}()
+}
+
+// Depth returns the number of queued execution engine calls.
+func (b *Backpressure) Depth() uint64 {
+	//#nosec:G701 // the counter is never negative.
+	return uint64(b.engine.Load())
+}
+
+// VerifyUncongested returns ErrBackpressure if the queue depth has reached
+// the threshold.
+func (b *Backpressure) VerifyUncongested() error {
+	if depth := b.Depth(); b.threshold > 0 && depth >= b.threshold {
+		return errors.Wrapf(
+			ErrBackpressure, "%d calls queued, threshold %d", depth,
+			b.threshold,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package policy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/policy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackpressure(t *testing.T) {
+	t.Parallel()
+	const threshold = 3
+	b := policy.NewBackpressure(threshold)
+	require.NoError(t, b.VerifyUncongested())
+
+	// Simulate an engine backlog of calls blocking until released.
+	release := make(chan struct{})
+	for range threshold - 1 {
+		b.Go(func() { <-release })
+	}
+	require.Equal(t, uint64(threshold-1), b.Depth())
+	require.NoError(t, b.VerifyUncongested())
+
+	// Another queued call tips the node into congestion.
+	b.Go(func() { <-release })
+	require.Equal(t, uint64(threshold), b.Depth())
+	require.ErrorIs(t, b.VerifyUncongested(), policy.ErrBackpressure)
+
+	// Clearing the engine backlog drains the queue.
+	close(release)
+	require.Eventually(t, func() bool {
+		return b.Depth() == 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, b.VerifyUncongested())
+}
+
+func TestBackpressureDisabled(t *testing.T) {
+	t.Parallel()
+	b := policy.NewBackpressure(0)
+	release := make(chan struct{})
+	defer close(release)
+	b.Go(func() { <-release })
+	require.Equal(t, uint64(1), b.Depth())
+	require.NoError(t, b.VerifyUncongested())
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package policy
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
+var (
+	// ErrFeeRecipientNotAllowed is returned when the fee recipient of an
+	// execution payload is not part of the configured allowlist.
+	ErrFeeRecipientNotAllowed = errors.New("fee recipient not allowed")
+
+	// ErrExtraDataTooLarge is returned when the extra data of an execution
+	// payload exceeds the configured maximum size.
+	ErrExtraDataTooLarge = errors.New("extra data too large")
+
+	// ErrExtraDataPrefixMismatch is returned when the extra data of an
+	// execution payload does not start with the required prefix.
+	ErrExtraDataPrefixMismatch = errors.New("extra data prefix mismatch")
+)
+
+// ProposalPolicy is a set of node-local rules that a beacon block must
+// satisfy in order to be built or accepted by this node.
+type ProposalPolicy struct {
+	// allowedFeeRecipients is the set of fee recipients that execution
+	// payloads may pay to. An empty set allows any fee recipient.
+	allowedFeeRecipients map[common.ExecutionAddress]struct{}
+	// maxExtraDataSize is the maximum size of the extra data of execution
+	// payloads, in bytes.
+	maxExtraDataSize uint64
+	// extraDataPrefix is the prefix the extra data of execution payloads
+	// must start with. An empty prefix allows any extra data.
+	extraDataPrefix []byte
+}
+
+// NewProposalPolicy creates a new proposal policy which only accepts the
+// given fee recipients. If no fee recipients are given, the fee recipient
+// check is disabled.
+func NewProposalPolicy(
+	allowedFeeRecipients ...common.ExecutionAddress,
+) *ProposalPolicy {
+	p := &ProposalPolicy{
+		allowedFeeRecipients: make(
+			map[common.ExecutionAddress]struct{}, len(allowedFeeRecipients),
+		),
+		maxExtraDataSize: constants.ExtraDataLength,
+	}
+	for _, addr := range allowedFeeRecipients {
+		p.allowedFeeRecipients[addr] = struct{}{}
+	}
+	return p
+}
+
+// WithExtraData restricts the extra data of execution payloads to at most
+// maxSize bytes, starting with the given prefix. A maxSize of 0, or beyond
+// the protocol maximum, allows up to the protocol maximum, and an empty
+// prefix allows any content.
+func (p *ProposalPolicy) WithExtraData(
+	maxSize uint64,
+	prefix []byte,
+) *ProposalPolicy {
+	if maxSize == 0 || maxSize > constants.ExtraDataLength {
+		maxSize = constants.ExtraDataLength
+	}
+	p.maxExtraDataSize = maxSize
+	p.extraDataPrefix = bytes.Clone(prefix)
+	return p
+}
+
+// VerifyFeeRecipient returns an error if the given fee recipient is not
+// allowed by the policy.
+func (p *ProposalPolicy) VerifyFeeRecipient(
+	feeRecipient common.ExecutionAddress,
+) error {
+	if len(p.allowedFeeRecipients) == 0 {
+		return nil
+	}
+	if _, ok := p.allowedFeeRecipients[feeRecipient]; !ok {
+		return errors.Wrapf(
+			ErrFeeRecipientNotAllowed, "fee recipient: %s", feeRecipient,
+		)
+	}
+	return nil
+}
+
+// VerifyExtraData returns an error if the given extra data of an execution
+// payload is not allowed by the policy.
+func (p *ProposalPolicy) VerifyExtraData(extraData []byte) error {
+	if uint64(len(extraData)) > p.maxExtraDataSize {
+		return errors.Wrapf(
+			ErrExtraDataTooLarge,
+			"size: %d, max: %d", len(extraData), p.maxExtraDataSize,
+		)
+	}
+	if !bytes.HasPrefix(extraData, p.extraDataPrefix) {
+		return errors.Wrapf(
+			ErrExtraDataPrefixMismatch,
+			"extra data: %#x, prefix: %#x", extraData, p.extraDataPrefix,
+		)
+	}
+	return nil
+}
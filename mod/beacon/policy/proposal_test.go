@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/policy"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyFeeRecipient(t *testing.T) {
+	var (
+		allowed    = common.ExecutionAddress{0x01}
+		disallowed = common.ExecutionAddress{0x02}
+	)
+
+	t.Run("allowed recipient", func(t *testing.T) {
+		p := policy.NewProposalPolicy(allowed)
+		require.NoError(t, p.VerifyFeeRecipient(allowed))
+	})
+
+	t.Run("disallowed recipient", func(t *testing.T) {
+		p := policy.NewProposalPolicy(allowed)
+		require.ErrorIs(
+			t, p.VerifyFeeRecipient(disallowed),
+			policy.ErrFeeRecipientNotAllowed,
+		)
+	})
+
+	t.Run("empty allowlist", func(t *testing.T) {
+		p := policy.NewProposalPolicy()
+		require.NoError(t, p.VerifyFeeRecipient(disallowed))
+	})
+}
+
+func TestVerifyExtraData(t *testing.T) {
+	t.Run("default allows up to the protocol max", func(t *testing.T) {
+		p := policy.NewProposalPolicy()
+		require.NoError(t, p.VerifyExtraData(nil))
+		require.NoError(t, p.VerifyExtraData(make([]byte, 32)))
+		require.ErrorIs(
+			t, p.VerifyExtraData(make([]byte, 33)),
+			policy.ErrExtraDataTooLarge,
+		)
+	})
+
+	t.Run("compliant extra data", func(t *testing.T) {
+		p := policy.NewProposalPolicy().WithExtraData(8, []byte("bera"))
+		require.NoError(t, p.VerifyExtraData([]byte("bera")))
+		require.NoError(t, p.VerifyExtraData([]byte("bera-v01")))
+	})
+
+	t.Run("over-size extra data", func(t *testing.T) {
+		p := policy.NewProposalPolicy().WithExtraData(8, []byte("bera"))
+		require.ErrorIs(
+			t, p.VerifyExtraData([]byte("bera-v1.0")),
+			policy.ErrExtraDataTooLarge,
+		)
+	})
+
+	t.Run("missing prefix", func(t *testing.T) {
+		p := policy.NewProposalPolicy().WithExtraData(8, []byte("bera"))
+		require.ErrorIs(
+			t, p.VerifyExtraData([]byte("geth")),
+			policy.ErrExtraDataPrefixMismatch,
+		)
+		require.ErrorIs(
+			t, p.VerifyExtraData(nil), policy.ErrExtraDataPrefixMismatch,
+		)
+	})
+
+	t.Run("max beyond the protocol max", func(t *testing.T) {
+		p := policy.NewProposalPolicy().WithExtraData(64, nil)
+		require.ErrorIs(
+			t, p.VerifyExtraData(make([]byte, 33)),
+			policy.ErrExtraDataTooLarge,
+		)
+	})
+}
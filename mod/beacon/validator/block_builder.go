@@ -25,8 +25,9 @@ import (
 	"fmt"
 	"time"
 
-	payloadtime "github.com/berachain/beacon-kit/mod/beacon/payload-time"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -52,6 +53,13 @@ func (s *Service[
 
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
 
+	// Refrain from proposing a block while the node is behind on the calls
+	// queued on the execution engine, rather than piling more of them up.
+	// The proposal then carries no block, as if building it failed.
+	if err := s.backpressure.VerifyUncongested(); err != nil {
+		return blk, sidecars, err
+	}
+
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
 	// the next finalized block in the chain. A byproduct of this design
@@ -91,6 +99,19 @@ func (s *Service[
 		return blk, sidecars, ErrNilPayload
 	}
 
+	// Refuse to build a block which violates the local proposal policy.
+	payload := envelope.GetExecutionPayload()
+	if err = s.proposalPolicy.VerifyFeeRecipient(
+		payload.GetFeeRecipient(),
+	); err != nil {
+		return blk, sidecars, err
+	}
+	if err = s.proposalPolicy.VerifyExtraData(
+		payload.GetExtraData(),
+	); err != nil {
+		return blk, sidecars, err
+	}
+
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
 	if err = s.buildBlockBody(
@@ -181,17 +202,55 @@ func (s *Service[
 		return crypto.BLSSignature{}, err
 	}
 
-	signingRoot := forkData.New(
-		version.FromUint32[common.Version](
-			s.chainSpec.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
-	).ComputeRandaoSigningRoot(
-		s.chainSpec.DomainTypeRandao(),
-		epoch,
+	forkVersion := s.chainSpec.ActiveForkVersionForEpoch(epoch)
+	domain, err := s.chainSpec.DomainTypeRegistry().DomainType(
+		chain.DomainNameRandao, forkVersion,
 	)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	signingRoot := forkData.New(
+		version.FromUint32[common.Version](forkVersion), genesisValidatorsRoot,
+	).ComputeRandaoSigningRoot(domain, epoch)
 	return s.signer.Sign(signingRoot[:])
 }
 
+// ProposalSigningRoot computes the signing root of the given block for the
+// given slot, such that it can be signed by an external signer.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+]) ProposalSigningRoot(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	slot math.Slot,
+) (common.Root, error) {
+	var forkData ForkDataT
+	if blk.GetSlot() != slot {
+		return common.Root{}, errors.Wrapf(
+			ErrBlockSlotMismatch,
+			"block slot: %d, slot: %d", blk.GetSlot(), slot,
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	forkVersion := s.chainSpec.ActiveForkVersionForSlot(slot)
+	domain, err := s.chainSpec.DomainTypeRegistry().DomainType(
+		chain.DomainNameProposer, forkVersion,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	return forkData.New(
+		version.FromUint32[common.Version](forkVersion), genesisValidatorsRoot,
+	).ComputeProposalSigningRoot(domain, blk), nil
+}
+
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
@@ -235,8 +294,6 @@ func (s *Service[
 			ctx,
 			st,
 			blk.GetSlot(),
-			payloadtime.Next(s.chainSpec, lph.GetTimestamp()),
-			blk.GetParentBlockRoot(),
 			lph.GetBlockHash(),
 			lph.GetParentHash(),
 		)
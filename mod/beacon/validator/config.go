@@ -20,6 +20,12 @@
 
 package validator
 
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -27,6 +33,38 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultFinalityStallThreshold is the default number of epochs without
+	// finality after which finality is reported as stalled.
+	defaultFinalityStallThreshold = 4
+
+	// defaultDAQuarantineSize is the default maximum number of blocks held
+	// in quarantine until their data is available.
+	defaultDAQuarantineSize = 0
+
+	// defaultDAQuarantineTTL is the default time a block is held in
+	// quarantine before it is rejected.
+	defaultDAQuarantineTTL = time.Second
+
+	// defaultBackpressureThreshold is the default queue depth at which the
+	// node refrains from proposing blocks.
+	defaultBackpressureThreshold = 0
+
+	// defaultProductionLogSize is the default number of slots whose block
+	// production metadata is recorded.
+	defaultProductionLogSize = 0
+
+	// defaultMaxExtraDataSize is the default maximum size of the extra data
+	// of execution payloads, which allows up to the protocol maximum.
+	defaultMaxExtraDataSize = 0
+
+	// defaultExtraDataPrefix is the default prefix the extra data of
+	// execution payloads must start with.
+	defaultExtraDataPrefix = ""
+
+	// defaultVerifiedBlockCache is the default of whether the transition of
+	// a block verified on ProcessProposal is reused on FinalizeBlock.
+	defaultVerifiedBlockCache = false
 )
 
 // Config is the validator configuration.
@@ -39,6 +77,49 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// AllowedFeeRecipients is the set of fee recipients that execution
+	// payloads are allowed to pay to. An empty set allows any fee recipient.
+	AllowedFeeRecipients []common.ExecutionAddress `mapstructure:"allowed-fee-recipients"`
+
+	// FinalityStallThreshold is the number of epochs without finality after
+	// which finality is reported as stalled. A value of 0 disables reporting.
+	FinalityStallThreshold uint64 `mapstructure:"finality-stall-threshold"`
+
+	// DAQuarantineSize is the maximum number of finalized blocks held in
+	// quarantine until their blob data is available, instead of being
+	// rejected outright. A value of 0 disables quarantining.
+	DAQuarantineSize uint64 `mapstructure:"da-quarantine-size"`
+
+	// DAQuarantineTTL is how long a block is held in quarantine before it is
+	// rejected. It must be shorter than the time FinalizeBlock awaits the
+	// block to be processed.
+	DAQuarantineTTL time.Duration `mapstructure:"da-quarantine-ttl"`
+
+	// BackpressureThreshold is the number of queued execution engine calls
+	// at which the node refrains from proposing blocks until the queue
+	// drains. A value of 0 disables the check.
+	BackpressureThreshold uint64 `mapstructure:"backpressure-threshold"`
+
+	// ProductionLogSize is the number of latest slots whose block production
+	// metadata is recorded for inspection. A value of 0 disables recording.
+	ProductionLogSize uint64 `mapstructure:"production-log-size"`
+
+	// MaxExtraDataSize is the maximum size in bytes of the extra data of the
+	// execution payloads built or accepted. A value of 0, or beyond the
+	// protocol maximum, allows up to the protocol maximum.
+	MaxExtraDataSize uint64 `mapstructure:"max-extra-data-size"`
+
+	// ExtraDataPrefix is the hex-encoded prefix the extra data of the
+	// execution payloads built or accepted must start with. An empty prefix
+	// allows any extra data.
+	ExtraDataPrefix string `mapstructure:"extra-data-prefix"`
+
+	// VerifiedBlockCache determines whether the post state of a block
+	// verified on ProcessProposal is kept and reused on FinalizeBlock if the
+	// same block is finalized, rather than transitioning the block again.
+	// The operations of a reused block are not audited.
+	VerifiedBlockCache bool `mapstructure:"verified-block-cache"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +127,14 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		AllowedFeeRecipients:          []common.ExecutionAddress{},
+		FinalityStallThreshold:        defaultFinalityStallThreshold,
+		DAQuarantineSize:              defaultDAQuarantineSize,
+		DAQuarantineTTL:               defaultDAQuarantineTTL,
+		BackpressureThreshold:         defaultBackpressureThreshold,
+		ProductionLogSize:             defaultProductionLogSize,
+		MaxExtraDataSize:              defaultMaxExtraDataSize,
+		ExtraDataPrefix:               defaultExtraDataPrefix,
+		VerifiedBlockCache:            defaultVerifiedBlockCache,
 	}
 }
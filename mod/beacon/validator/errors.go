@@ -37,4 +37,8 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrBlockSlotMismatch is an error for when the slot of a beacon block
+	// does not match the requested slot.
+	ErrBlockSlotMismatch = errors.New("block slot does not match slot")
 )
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlockProduction is the metadata of the production of a block for a slot.
+type BlockProduction struct {
+	// Slot is the slot the block was requested for.
+	Slot math.Slot `json:"slot"`
+	// Produced is whether the block was built successfully.
+	Produced bool `json:"produced"`
+	// ProposerIndex is the index of the proposer of the block, if produced.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// NumBlobs is the number of blobs of the block, if produced.
+	NumBlobs int `json:"num_blobs"`
+	// BuildLatency is the time taken to build the block, or to fail to.
+	BuildLatency time.Duration `json:"build_latency"`
+	// Error is the reason the block was not produced, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// productionLog is a ring buffer holding the metadata of the latest blocks
+// produced.
+type productionLog struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// entries holds the metadata recorded, wrapping around once full.
+	entries []BlockProduction
+	// next is the index in entries the next metadata is recorded at.
+	next int
+	// full is whether entries has wrapped around.
+	full bool
+}
+
+// newProductionLog creates a new productionLog holding up to size entries. A
+// size of 0 disables the log, returning nil.
+func newProductionLog(size uint64) *productionLog {
+	if size == 0 {
+		return nil
+	}
+	return &productionLog{entries: make([]BlockProduction, size)}
+}
+
+// record records the given metadata, evicting the oldest one once full.
+func (l *productionLog) record(p BlockProduction) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries[l.next] = p
+	l.next = (l.next + 1) % len(l.entries)
+	l.full = l.full || l.next == 0
+}
+
+// list returns the metadata recorded, from the oldest to the latest.
+func (l *productionLog) list() []BlockProduction {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.full {
+		return append([]BlockProduction(nil), l.entries[:l.next]...)
+	}
+	return append(
+		append([]BlockProduction(nil), l.entries[l.next:]...),
+		l.entries[:l.next]...,
+	)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductionLogRecordsProducedSlots(t *testing.T) {
+	l := newProductionLog(2)
+	require.Empty(t, l.list())
+
+	produced := BlockProduction{
+		Slot:          1,
+		Produced:      true,
+		ProposerIndex: 3,
+		NumBlobs:      2,
+		BuildLatency:  time.Millisecond,
+	}
+	l.record(produced)
+	require.Equal(t, []BlockProduction{produced}, l.list())
+
+	failed := BlockProduction{
+		Slot:         2,
+		BuildLatency: time.Second,
+		Error:        "nil payload",
+	}
+	l.record(failed)
+	require.Equal(t, []BlockProduction{produced, failed}, l.list())
+
+	// Once full, the oldest metadata is evicted.
+	latest := BlockProduction{Slot: 3, Produced: true, ProposerIndex: 3}
+	l.record(latest)
+	require.Equal(t, []BlockProduction{failed, latest}, l.list())
+	l.record(BlockProduction{Slot: 4})
+	require.Equal(t, []math.Slot{3, 4}, []math.Slot{
+		l.list()[0].Slot, l.list()[1].Slot,
+	})
+}
+
+func TestProductionLogDisabled(t *testing.T) {
+	l := newProductionLog(0)
+	require.Nil(t, l)
+	l.record(BlockProduction{Slot: 1, Produced: true})
+	require.Empty(t, l.list())
+}
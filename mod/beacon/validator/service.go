@@ -22,12 +22,14 @@ package validator
 
 import (
 	"context"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -43,7 +45,7 @@ type Service[
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -78,8 +80,16 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// proposalPolicy is the node-local policy built blocks must satisfy.
+	proposalPolicy ProposalPolicy
+	// backpressure refrains from building blocks while the node is behind on
+	// the calls queued on the execution engine.
+	backpressure Backpressure
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
+	// productions records the metadata of the latest blocks produced. If
+	// nil, no metadata is recorded.
+	productions *productionLog
 	// subNewSlot is a channel to hold NewSlot events.
 	subNewSlot chan async.Event[SlotDataT]
 }
@@ -96,7 +106,7 @@ func NewService[
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -116,6 +126,8 @@ func NewService[
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	proposalPolicy ProposalPolicy,
+	backpressure Backpressure,
 	ts TelemetrySink,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
@@ -138,7 +150,10 @@ func NewService[
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		proposalPolicy:        proposalPolicy,
+		backpressure:          backpressure,
 		metrics:               newValidatorMetrics(ts),
+		productions:           newProductionLog(cfg.ProductionLogSize),
 		dispatcher:            dispatcher,
 		subNewSlot:            make(chan async.Event[SlotDataT]),
 	}
@@ -151,6 +166,14 @@ func (s *Service[
 	return "validator"
 }
 
+// BlockProductions returns the metadata of the latest blocks produced, from
+// the oldest to the latest, for debugging purposes.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockProductions() []BlockProduction {
+	return s.productions.list()
+}
+
 // Start listens for NewSlot events and builds a block and sidecars for the
 // requested slot data.
 func (s *Service[
@@ -189,14 +212,16 @@ func (s *Service[
 	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
 ]) handleNewSlot(req async.Event[SlotDataT]) {
 	var (
-		blk      BeaconBlockT
-		sidecars BlobSidecarsT
-		err      error
+		blk       BeaconBlockT
+		sidecars  BlobSidecarsT
+		err       error
+		startTime = time.Now()
 	)
 	// build the block and sidecars for the requested slot data
 	blk, sidecars, err = s.buildBlockAndSidecars(
 		req.Context(), req.Data(),
 	)
+	s.recordProduction(req.Data().GetSlot(), blk, err, time.Since(startTime))
 	if err != nil {
 		s.logger.Error("failed to build block", "err", err)
 	}
@@ -215,3 +240,27 @@ func (s *Service[
 		s.logger.Error("failed to dispatch built sidecars", "err", err)
 	}
 }
+
+// recordProduction records the metadata of the production of the given
+// block for the given slot.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) recordProduction(
+	slot math.Slot,
+	blk BeaconBlockT,
+	err error,
+	latency time.Duration,
+) {
+	production := BlockProduction{
+		Slot:         slot,
+		Produced:     err == nil,
+		BuildLatency: latency,
+	}
+	if err != nil {
+		production.Error = err.Error()
+	} else {
+		production.ProposerIndex = blk.GetProposerIndex()
+		production.NumBlobs = len(blk.GetBody().GetBlobKzgCommitments())
+	}
+	s.productions.record(production)
+}
@@ -38,7 +38,7 @@ type BeaconBlock[
 	T any,
 	BeaconBlockBodyT any,
 ] interface {
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	// NewWithVersion creates a new beacon block with the given parameters.
 	NewWithVersion(
 		slot math.Slot,
@@ -48,6 +48,8 @@ type BeaconBlock[
 	) (T, error)
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
 	// SetStateRoot sets the state root of the beacon block.
@@ -81,6 +83,9 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// GetBlobKzgCommitments returns the blob KZG commitments of the beacon
+	// block body.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 }
 
 // BeaconState represents a beacon state interface.
@@ -136,6 +141,14 @@ type Eth1Data[T any] interface {
 	) T
 }
 
+// ExecutionPayload represents the execution payload interface.
+type ExecutionPayload interface {
+	// GetFeeRecipient returns the fee recipient of the execution payload.
+	GetFeeRecipient() common.ExecutionAddress
+	// GetExtraData returns the extra data of the execution payload.
+	GetExtraData() []byte
+}
+
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
 	// GetTimestamp returns the timestamp of the execution payload header.
@@ -158,6 +171,11 @@ type ForkData[T any] interface {
 		common.DomainType,
 		math.Epoch,
 	) common.Root
+	// ComputeProposalSigningRoot computes the block proposal signing root.
+	ComputeProposalSigningRoot(
+		common.DomainType,
+		interface{ HashTreeRoot() common.Root },
+	) common.Root
 }
 
 // PayloadBuilder represents a service that is responsible for
@@ -175,13 +193,29 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		ctx context.Context,
 		st BeaconStateT,
 		slot math.Slot,
-		timestamp uint64,
-		parentBlockRoot common.Root,
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
+// Backpressure signals whether the node is too far behind on the calls queued
+// on the execution engine to build another block.
+type Backpressure interface {
+	// VerifyUncongested returns an error if the node is congested.
+	VerifyUncongested() error
+}
+
+// ProposalPolicy represents the node-local rules that a built beacon block
+// must satisfy.
+type ProposalPolicy interface {
+	// VerifyFeeRecipient returns an error if the given fee recipient is not
+	// allowed.
+	VerifyFeeRecipient(common.ExecutionAddress) error
+	// VerifyExtraData returns an error if the given execution payload extra
+	// data is not allowed.
+	VerifyExtraData([]byte) error
+}
+
 // SlotData represents the slot data interface.
 type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	// GetSlot returns the slot of the incoming slot.
@@ -38,6 +38,11 @@ type Spec[
 	// calculations in Gwei.
 	MaxEffectiveBalance() uint64
 
+	// MaxEffectiveBalanceElectra returns the maximum effective balance of
+	// validators with compounding withdrawal credentials in Gwei, as
+	// introduced by EIP-7251.
+	MaxEffectiveBalanceElectra() uint64
+
 	// EjectionBalance returns the balance below which a validator is ejected.
 	EjectionBalance() uint64
 
@@ -57,6 +62,17 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// MinSeedLookahead returns the number of epochs a seed is fixed ahead of
+	// the epoch it is used in.
+	MinSeedLookahead() uint64
+
+	// GenesisDelay returns the number of seconds between the genesis time and
+	// the start of the genesis slot.
+	GenesisDelay() uint64
+
+	// SecondsPerSlot returns the target duration of a slot, in seconds.
+	SecondsPerSlot() uint64
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -80,9 +96,17 @@ type Spec[
 	// DomainTypeAggregateAndProof returns the domain for aggregate and proof
 	DomainTypeAggregateAndProof() DomainTypeT
 
+	// DomainTypeSyncCommittee returns the domain for sync committee
+	// signatures.
+	DomainTypeSyncCommittee() DomainTypeT
+
 	// DomainTypeApplicationMask returns the domain for application signatures.
 	DomainTypeApplicationMask() DomainTypeT
 
+	// DomainTypeRegistry returns the registry resolving the signature domains
+	// by name for each fork.
+	DomainTypeRegistry() DomainTypeRegistry[DomainTypeT]
+
 	// Eth1-related values.
 
 	// DepositContractAddress returns the deposit contract address.
@@ -102,6 +126,10 @@ type Spec[
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
 
+	// EpochsPerEth1VotingPeriod returns the number of epochs in an eth1 data
+	// voting period.
+	EpochsPerEth1VotingPeriod() uint64
+
 	// Fork-related values.
 	// DenebPlusForkEpoch returns the epoch at which the Deneb+ fork takes
 	DenebPlusForkEpoch() EpochT
@@ -125,6 +153,37 @@ type Spec[
 	// registry.
 	ValidatorRegistryLimit() uint64
 
+	// Validator Cycle
+
+	// MinPerEpochChurnLimit returns the minimum number of validators that may
+	// be activated or exited per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the quotient used to derive the churn limit
+	// from the number of active validators.
+	ChurnLimitQuotient() uint64
+
+	// MinActivationDelay returns the number of epochs between the epoch in
+	// which a validator leaves the activation queue and its activation.
+	MinActivationDelay() uint64
+
+	// MinValidatorWithdrawabilityDelay returns the number of epochs between
+	// the exit of a validator and the epoch in which it may withdraw.
+	MinValidatorWithdrawabilityDelay() uint64
+
+	// MaxCommitteesPerSlot returns the maximum number of attestation
+	// committees per slot.
+	MaxCommitteesPerSlot() uint64
+
+	// TargetCommitteeSize returns the targeted number of validators in an
+	// attestation committee.
+	TargetCommitteeSize() uint64
+
+	// MinAttestationInclusionDelay returns the minimum number of slots
+	// between the slot of an attestation and the slot of the block including
+	// it.
+	MinAttestationInclusionDelay() uint64
+
 	// Rewards and Penalties
 
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
@@ -134,6 +193,40 @@ type Spec[
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the quotient of the effective
+	// balance of a validator it is penalized by when slashed.
+	MinSlashingPenaltyQuotient() uint64
+
+	// WhistleblowerRewardQuotient returns the quotient of the effective
+	// balance of a slashed validator paid as the whistleblower reward.
+	WhistleblowerRewardQuotient() uint64
+
+	// ProposerRewardQuotient returns the quotient of the whistleblower reward
+	// paid to the proposer including the slashing.
+	ProposerRewardQuotient() uint64
+
+	// BaseRewardFactor returns the factor of the base reward paid per
+	// effective balance increment for the timely source, target and head
+	// votes.
+	BaseRewardFactor() uint64
+
+	// InactivityScoreBias returns the increase of the inactivity score of a
+	// validator for each epoch it is inactive.
+	InactivityScoreBias() uint64
+
+	// InactivityScoreRecoveryRate returns the decrease of the inactivity
+	// scores for each epoch outside of an inactivity leak.
+	InactivityScoreRecoveryRate() uint64
+
+	// Altair Values
+
+	// SyncCommitteeSize returns the number of validators in a sync committee.
+	SyncCommitteeSize() uint64
+
+	// EpochsPerSyncCommitteePeriod returns the number of epochs a sync
+	// committee serves for.
+	EpochsPerSyncCommitteePeriod() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -144,6 +237,18 @@ type Spec[
 	// per withdrawal sweep.
 	MaxValidatorsPerWithdrawalsSweep() uint64
 
+	// SkipIneligibleWithdrawals returns whether the withdrawals sweep skips
+	// validators not eligible for a withdrawal.
+	SkipIneligibleWithdrawals() bool
+
+	// CreditDepositTopUps returns whether deposits to existing validators
+	// are credited to their balances.
+	CreditDepositTopUps() bool
+
+	// WithholdEmptyValidatorSet returns whether the validator updates of an
+	// epoch leaving the validator set without voting power are withheld.
+	WithholdEmptyValidatorSet() bool
+
 	// Deneb Values
 
 	// MinEpochsForBlobsSidecarsRequest returns the minimum number of epochs for
@@ -173,9 +278,18 @@ type Spec[
 	// epoch.
 	ActiveForkVersionForEpoch(epoch EpochT) uint32
 
+	// MaxEffectiveBalanceForEpoch returns the maximum effective balance at
+	// the given epoch of a validator, by whether it has compounding
+	// withdrawal credentials.
+	MaxEffectiveBalanceForEpoch(epoch EpochT, compounding bool) uint64
+
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot SlotT) EpochT
 
+	// SlotsPerEth1VotingPeriod returns the number of slots in an eth1 data
+	// voting period.
+	SlotsPerEth1VotingPeriod() uint64
+
 	// WithinDAPeriod checks if a given block slot is within the data
 	// availability period relative to the current slot.
 	WithinDAPeriod(block, current SlotT) bool
@@ -183,6 +297,9 @@ type Spec[
 	// GetCometBFTConfigForSlot retrieves the CometBFT config for a specific
 	// slot.
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
+
+	// Validate returns an error if the chain spec is inconsistent.
+	Validate() error
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -196,6 +313,8 @@ type chainSpec[
 ] struct {
 	// Data contains the actual chain-specific parameter values.
 	Data SpecData[DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT]
+	// domains resolves the signature domains of Data by name.
+	domains DomainTypeRegistry[DomainTypeT]
 }
 
 // NewChainSpec creates a new instance of a ChainSpec with the provided data.
@@ -213,7 +332,8 @@ func NewChainSpec[
 	return &chainSpec[
 		DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 	]{
-		Data: data,
+		Data:    data,
+		domains: NewDomainTypeRegistry(data),
 	}
 }
 
@@ -231,6 +351,14 @@ func (c chainSpec[
 	return c.Data.MaxEffectiveBalance
 }
 
+// MaxEffectiveBalanceElectra returns the maximum effective balance of
+// validators with compounding withdrawal credentials.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxEffectiveBalanceElectra() uint64 {
+	return c.Data.MaxEffectiveBalanceElectra
+}
+
 // EjectionBalance returns the balance below which a validator is ejected.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -267,6 +395,29 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MinSeedLookahead returns the number of epochs a seed is fixed ahead of the
+// epoch it is used in.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSeedLookahead() uint64 {
+	return c.Data.MinSeedLookahead
+}
+
+// GenesisDelay returns the number of seconds between the genesis time and the
+// start of the genesis slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) GenesisDelay() uint64 {
+	return c.Data.GenesisDelay
+}
+
+// SecondsPerSlot returns the target duration of a slot, in seconds.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SecondsPerSlot() uint64 {
+	return c.Data.SecondsPerSlot
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -317,6 +468,13 @@ func (c chainSpec[
 	return c.Data.DomainTypeAggregateAndProof
 }
 
+// DomainTypeSyncCommittee returns the domain for sync committee signatures.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DomainTypeSyncCommittee() DomainTypeT {
+	return c.Data.DomainTypeSyncCommittee
+}
+
 // DomainTypeApplicationMask returns the domain for the application mask.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -324,6 +482,14 @@ func (c chainSpec[
 	return c.Data.DomainTypeApplicationMask
 }
 
+// DomainTypeRegistry returns the registry resolving the signature domains by
+// name for each fork.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DomainTypeRegistry() DomainTypeRegistry[DomainTypeT] {
+	return c.domains
+}
+
 // DepositContractAddress returns the address of the deposit contract.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -360,6 +526,14 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// EpochsPerEth1VotingPeriod returns the number of epochs in an eth1 data
+// voting period.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EpochsPerEth1VotingPeriod() uint64 {
+	return c.Data.EpochsPerEth1VotingPeriod
+}
+
 // DenebPlusForEpoch returns the epoch of the Deneb+ fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -402,6 +576,62 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// MinPerEpochChurnLimit returns the minimum number of validators that may be
+// activated or exited per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the quotient used to derive the churn limit from
+// the number of active validators.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// MinActivationDelay returns the number of epochs between the epoch in which
+// a validator leaves the activation queue and its activation.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinActivationDelay() uint64 {
+	return c.Data.MinActivationDelay
+}
+
+// MinValidatorWithdrawabilityDelay returns the number of epochs between the
+// exit of a validator and the epoch in which it may withdraw.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// MaxCommitteesPerSlot returns the maximum number of attestation committees
+// per slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxCommitteesPerSlot() uint64 {
+	return c.Data.MaxCommitteesPerSlot
+}
+
+// TargetCommitteeSize returns the targeted number of validators in an
+// attestation committee.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) TargetCommitteeSize() uint64 {
+	return c.Data.TargetCommitteeSize
+}
+
+// MinAttestationInclusionDelay returns the minimum number of slots between the
+// slot of an attestation and the slot of the block including it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinAttestationInclusionDelay() uint64 {
+	return c.Data.MinAttestationInclusionDelay
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -416,6 +646,69 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the quotient of the effective balance of
+// a validator it is penalized by when slashed.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
+// WhistleblowerRewardQuotient returns the quotient of the effective balance
+// of a slashed validator paid as the whistleblower reward.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) WhistleblowerRewardQuotient() uint64 {
+	return c.Data.WhistleblowerRewardQuotient
+}
+
+// ProposerRewardQuotient returns the quotient of the whistleblower reward
+// paid to the proposer including the slashing.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ProposerRewardQuotient() uint64 {
+	return c.Data.ProposerRewardQuotient
+}
+
+// InactivityScoreBias returns the increase of the inactivity score of a
+// validator for each epoch it is inactive.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) InactivityScoreBias() uint64 {
+	return c.Data.InactivityScoreBias
+}
+
+// BaseRewardFactor returns the factor of the base reward paid per effective
+// balance increment for the timely source, target and head votes.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BaseRewardFactor() uint64 {
+	return c.Data.BaseRewardFactor
+}
+
+// InactivityScoreRecoveryRate returns the decrease of the inactivity scores
+// for each epoch outside of an inactivity leak.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) InactivityScoreRecoveryRate() uint64 {
+	return c.Data.InactivityScoreRecoveryRate
+}
+
+// SyncCommitteeSize returns the number of validators in a sync committee.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SyncCommitteeSize() uint64 {
+	return c.Data.SyncCommitteeSize
+}
+
+// EpochsPerSyncCommitteePeriod returns the number of epochs a sync committee
+// serves for.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EpochsPerSyncCommitteePeriod() uint64 {
+	return c.Data.EpochsPerSyncCommitteePeriod
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
@@ -432,6 +725,30 @@ func (c chainSpec[
 	return c.Data.MaxValidatorsPerWithdrawalsSweep
 }
 
+// SkipIneligibleWithdrawals returns whether the withdrawals sweep skips
+// validators not eligible for a withdrawal.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SkipIneligibleWithdrawals() bool {
+	return c.Data.SkipIneligibleWithdrawals
+}
+
+// CreditDepositTopUps returns whether deposits to existing validators are
+// credited to their balances.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) CreditDepositTopUps() bool {
+	return c.Data.CreditDepositTopUps
+}
+
+// WithholdEmptyValidatorSet returns whether the validator updates of an epoch
+// leaving the validator set without voting power are withheld.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) WithholdEmptyValidatorSet() bool {
+	return c.Data.WithholdEmptyValidatorSet
+}
+
 // MinEpochsForBlobsSidecarsRequest returns the minimum number of epochs for
 // blobs sidecars request.
 func (c chainSpec[
@@ -38,6 +38,10 @@ type SpecData[
 	// MaxEffectiveBalance is the maximum effective balance allowed for a
 	// validator.
 	MaxEffectiveBalance uint64 `mapstructure:"max-effective-balance"`
+	// MaxEffectiveBalanceElectra is the maximum effective balance allowed
+	// for a validator with compounding withdrawal credentials. If zero, they
+	// are capped at MaxEffectiveBalance as well.
+	MaxEffectiveBalanceElectra uint64 `mapstructure:"max-effective-balance-electra"`
 	// EjectionBalance is the balance at which a validator is ejected.
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
@@ -52,6 +56,15 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MinSeedLookahead is the number of epochs a seed is fixed ahead of the
+	// epoch it is used in.
+	MinSeedLookahead uint64 `mapstructure:"min-seed-lookahead"`
+	// GenesisDelay is the number of seconds between the genesis time and the
+	// start of the genesis slot.
+	GenesisDelay uint64 `mapstructure:"genesis-delay"`
+	// SecondsPerSlot is the target duration of a slot, in seconds. A value
+	// of 0 starts all slots at the genesis slot.
+	SecondsPerSlot uint64 `mapstructure:"seconds-per-slot"`
 
 	// Signature domains.
 	//
@@ -71,8 +84,13 @@ type SpecData[
 	// DomainTypeAggregateAndProof is the domain for aggregate and proof
 	// signatures.
 	DomainTypeAggregateAndProof DomainTypeT `mapstructure:"domain-type-aggregate-and-proof"`
+	// DomainTypeSyncCommittee is the domain for sync committee signatures.
+	DomainTypeSyncCommittee DomainTypeT `mapstructure:"domain-type-sync-committee"`
 	// DomainTypeApplicationMask is the domain for the application mask.
 	DomainTypeApplicationMask DomainTypeT `mapstructure:"domain-type-application-mask"`
+	// DomainTypeOverrides holds the domains overridden by a fork, keyed by
+	// the version of the fork from which they apply.
+	DomainTypeOverrides map[uint32]map[DomainName]DomainTypeT `mapstructure:"domain-type-overrides"`
 
 	// Eth1-related values.
 	//
@@ -88,6 +106,9 @@ type SpecData[
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
+	// EpochsPerEth1VotingPeriod is the number of epochs in an eth1 data
+	// voting period.
+	EpochsPerEth1VotingPeriod uint64 `mapstructure:"epochs-per-eth1-voting-period"`
 
 	// Fork-related values.
 	//
@@ -109,6 +130,31 @@ type SpecData[
 	// registry.
 	ValidatorRegistryLimit uint64 `mapstructure:"validator-registry-limit"`
 
+	// Validator cycle constants.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that may be
+	// activated or exited per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the quotient used to derive the churn limit from
+	// the number of active validators.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// MinActivationDelay is the number of epochs between the epoch in which
+	// a validator leaves the activation queue and its activation, i.e.
+	// MAX_SEED_LOOKAHEAD.
+	MinActivationDelay uint64 `mapstructure:"min-activation-delay"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs between the
+	// exit of a validator and the epoch in which it may withdraw.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// MaxCommitteesPerSlot is the maximum number of attestation committees
+	// per slot.
+	MaxCommitteesPerSlot uint64 `mapstructure:"max-committees-per-slot"`
+	// TargetCommitteeSize is the targeted number of validators in an
+	// attestation committee.
+	TargetCommitteeSize uint64 `mapstructure:"target-committee-size"`
+	// MinAttestationInclusionDelay is the minimum number of slots between
+	// the slot of an attestation and the slot of the block including it.
+	MinAttestationInclusionDelay uint64 `mapstructure:"min-attestation-inclusion-delay"`
+
 	// Rewards and penalties constants.
 	//
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
@@ -116,6 +162,32 @@ type SpecData[
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient of the effective balance of
+	// a validator it is penalized by when slashed.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// WhistleblowerRewardQuotient is the quotient of the effective balance of
+	// a slashed validator paid as the whistleblower reward.
+	WhistleblowerRewardQuotient uint64 `mapstructure:"whistleblower-reward-quotient"`
+	// ProposerRewardQuotient is the quotient of the whistleblower reward paid
+	// to the proposer including the slashing.
+	ProposerRewardQuotient uint64 `mapstructure:"proposer-reward-quotient"`
+	// BaseRewardFactor is the factor of the base reward paid per effective
+	// balance increment for the timely source, target and head votes.
+	BaseRewardFactor uint64 `mapstructure:"base-reward-factor"`
+	// InactivityScoreBias is the increase of the inactivity score of a
+	// validator for each epoch it is inactive.
+	InactivityScoreBias uint64 `mapstructure:"inactivity-score-bias"`
+	// InactivityScoreRecoveryRate is the decrease of the inactivity scores
+	// for each epoch outside of an inactivity leak.
+	InactivityScoreRecoveryRate uint64 `mapstructure:"inactivity-score-recovery-rate"`
+
+	// Altair Values
+	//
+	// SyncCommitteeSize is the number of validators in a sync committee.
+	SyncCommitteeSize uint64 `mapstructure:"sync-committee-size"`
+	// EpochsPerSyncCommitteePeriod is the number of epochs a sync committee
+	// serves for.
+	EpochsPerSyncCommitteePeriod uint64 `mapstructure:"epochs-per-sync-committee-period"`
 
 	// Capella Values
 	//
@@ -126,6 +198,21 @@ type SpecData[
 	// validator
 	// withdrawals allowed per sweep.
 	MaxValidatorsPerWithdrawalsSweep uint64 `mapstructure:"max-validators-per-withdrawals-sweep"`
+	// SkipIneligibleWithdrawals makes the withdrawals sweep skip validators
+	// which are not eligible for a withdrawal, e.g. those with a zero or
+	// non-excess balance or not yet withdrawable, rather than including them
+	// with a zero amount.
+	SkipIneligibleWithdrawals bool `mapstructure:"skip-ineligible-withdrawals"`
+	// CreditDepositTopUps makes a deposit to an existing validator credit its
+	// full amount to the balance of the validator, its effective balance
+	// following the balance up to the ceiling, rather than only raising the
+	// effective balance.
+	CreditDepositTopUps bool `mapstructure:"credit-deposit-top-ups"`
+	// WithholdEmptyValidatorSet withholds the validator updates of an epoch
+	// which would leave the CometBFT validator set without voting power, such
+	// that CometBFT keeps the current validator set instead of failing to
+	// apply them. Meant for devnets only.
+	WithholdEmptyValidatorSet bool `mapstructure:"withhold-empty-validator-set"`
 
 	// Deneb Values
 	//
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrUnknownDomainType is returned when a signature domain is looked up by a
+// name the registry does not know of.
+var ErrUnknownDomainType = errors.New("unknown domain type")
+
+// DomainName is the name a signature domain is registered under.
+type DomainName string
+
+const (
+	// DomainNameProposer names the domain for beacon proposer signatures.
+	DomainNameProposer DomainName = "beacon-proposer"
+	// DomainNameAttester names the domain for beacon attester signatures.
+	DomainNameAttester DomainName = "beacon-attester"
+	// DomainNameRandao names the domain for RANDAO reveal signatures.
+	DomainNameRandao DomainName = "randao"
+	// DomainNameDeposit names the domain for deposit contract signatures.
+	DomainNameDeposit DomainName = "deposit"
+	// DomainNameVoluntaryExit names the domain for voluntary exit signatures.
+	DomainNameVoluntaryExit DomainName = "voluntary-exit"
+	// DomainNameSelectionProof names the domain for selection proof
+	// signatures.
+	DomainNameSelectionProof DomainName = "selection-proof"
+	// DomainNameAggregateAndProof names the domain for aggregate and proof
+	// signatures.
+	DomainNameAggregateAndProof DomainName = "aggregate-and-proof"
+	// DomainNameSyncCommittee names the domain for sync committee signatures.
+	DomainNameSyncCommittee DomainName = "sync-committee"
+	// DomainNameApplicationMask names the domain for the application mask.
+	DomainNameApplicationMask DomainName = "application-mask"
+)
+
+// DomainTypeRegistry resolves signature domains by name for a fork version.
+// A domain overridden at a fork version applies from that fork onwards,
+// until a later fork overrides it again.
+type DomainTypeRegistry[DomainTypeT ~[4]byte] struct {
+	// base holds the domains in effect before any override.
+	base map[DomainName]DomainTypeT
+	// overrides holds the domains overridden at each fork version.
+	overrides map[uint32]map[DomainName]DomainTypeT
+	// versions holds the fork versions with overrides, in ascending order.
+	versions []uint32
+}
+
+// NewDomainTypeRegistry creates a new registry of the domains of the given
+// spec data.
+func NewDomainTypeRegistry[
+	DomainTypeT ~[4]byte,
+	EpochT ~uint64,
+	ExecutionAddressT ~[20]byte,
+	SlotT ~uint64,
+	CometBFTConfigT any,
+](data SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DomainTypeRegistry[DomainTypeT] {
+	versions := make([]uint32, 0, len(data.DomainTypeOverrides))
+	for forkVersion := range data.DomainTypeOverrides {
+		versions = append(versions, forkVersion)
+	}
+	slices.Sort(versions)
+
+	return DomainTypeRegistry[DomainTypeT]{
+		base: map[DomainName]DomainTypeT{
+			DomainNameProposer:          data.DomainTypeProposer,
+			DomainNameAttester:          data.DomainTypeAttester,
+			DomainNameRandao:            data.DomainTypeRandao,
+			DomainNameDeposit:           data.DomainTypeDeposit,
+			DomainNameVoluntaryExit:     data.DomainTypeVoluntaryExit,
+			DomainNameSelectionProof:    data.DomainTypeSelectionProof,
+			DomainNameAggregateAndProof: data.DomainTypeAggregateAndProof,
+			DomainNameSyncCommittee:     data.DomainTypeSyncCommittee,
+			DomainNameApplicationMask:   data.DomainTypeApplicationMask,
+		},
+		overrides: data.DomainTypeOverrides,
+		versions:  versions,
+	}
+}
+
+// DomainType returns the domain registered under the given name, as in
+// effect at the given fork version.
+func (r DomainTypeRegistry[DomainTypeT]) DomainType(
+	name DomainName,
+	forkVersion uint32,
+) (DomainTypeT, error) {
+	domain, ok := r.base[name]
+	if !ok {
+		return domain, fmt.Errorf("%w: %s", ErrUnknownDomainType, name)
+	}
+
+	// The latest override at or before the fork version takes precedence.
+	for i := len(r.versions) - 1; i >= 0; i-- {
+		if r.versions[i] > forkVersion {
+			continue
+		}
+		if override, found := r.overrides[r.versions[i]][name]; found {
+			return override, nil
+		}
+	}
+	return domain, nil
+}
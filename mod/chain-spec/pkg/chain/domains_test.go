@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDomainTypeRegistry tests that the registry resolves the domains of the
+// specification, and the overrides of each fork from that fork onwards.
+func TestDomainTypeRegistry(t *testing.T) {
+	registry := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			DomainTypeProposer:          domainType{0x00, 0x00, 0x00, 0x00},
+			DomainTypeAttester:          domainType{0x01, 0x00, 0x00, 0x00},
+			DomainTypeRandao:            domainType{0x02, 0x00, 0x00, 0x00},
+			DomainTypeDeposit:           domainType{0x03, 0x00, 0x00, 0x00},
+			DomainTypeVoluntaryExit:     domainType{0x04, 0x00, 0x00, 0x00},
+			DomainTypeSelectionProof:    domainType{0x05, 0x00, 0x00, 0x00},
+			DomainTypeAggregateAndProof: domainType{0x06, 0x00, 0x00, 0x00},
+			DomainTypeSyncCommittee:     domainType{0x07, 0x00, 0x00, 0x00},
+			DomainTypeApplicationMask:   domainType{0x00, 0x00, 0x00, 0x01},
+			DomainTypeOverrides: map[uint32]map[chain.DomainName]domainType{
+				version.DenebPlus: {
+					chain.DomainNameRandao: {0x12, 0x00, 0x00, 0x00},
+				},
+				version.Electra: {
+					chain.DomainNameRandao:   {0x22, 0x00, 0x00, 0x00},
+					chain.DomainNameProposer: {0x20, 0x00, 0x00, 0x00},
+				},
+			},
+		},
+	).DomainTypeRegistry()
+
+	tests := []struct {
+		name        string
+		domain      chain.DomainName
+		forkVersion uint32
+		expected    domainType
+	}{
+		{
+			name:        "Proposer Deneb",
+			domain:      chain.DomainNameProposer,
+			forkVersion: version.Deneb,
+			expected:    domainType{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Attester Deneb",
+			domain:      chain.DomainNameAttester,
+			forkVersion: version.Deneb,
+			expected:    domainType{0x01, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Deposit Electra",
+			domain:      chain.DomainNameDeposit,
+			forkVersion: version.Electra,
+			expected:    domainType{0x03, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Voluntary Exit Deneb",
+			domain:      chain.DomainNameVoluntaryExit,
+			forkVersion: version.Deneb,
+			expected:    domainType{0x04, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Sync Committee Deneb",
+			domain:      chain.DomainNameSyncCommittee,
+			forkVersion: version.Deneb,
+			expected:    domainType{0x07, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Application Mask Deneb",
+			domain:      chain.DomainNameApplicationMask,
+			forkVersion: version.Deneb,
+			expected:    domainType{0x00, 0x00, 0x00, 0x01},
+		},
+		{
+			name:        "Randao Deneb",
+			domain:      chain.DomainNameRandao,
+			forkVersion: version.Deneb,
+			expected:    domainType{0x02, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Randao Deneb+ Override",
+			domain:      chain.DomainNameRandao,
+			forkVersion: version.DenebPlus,
+			expected:    domainType{0x12, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Randao Electra Override",
+			domain:      chain.DomainNameRandao,
+			forkVersion: version.Electra,
+			expected:    domainType{0x22, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Proposer Deneb+ Before Override",
+			domain:      chain.DomainNameProposer,
+			forkVersion: version.DenebPlus,
+			expected:    domainType{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "Proposer Electra Override",
+			domain:      chain.DomainNameProposer,
+			forkVersion: version.Electra,
+			expected:    domainType{0x20, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := registry.DomainType(tt.domain, tt.forkVersion)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, err := registry.DomainType("unknown", version.Deneb)
+	require.ErrorIs(t, err, chain.ErrUnknownDomainType)
+}
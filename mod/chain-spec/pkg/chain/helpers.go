@@ -48,6 +48,23 @@ func (c chainSpec[
 	return version.Deneb
 }
 
+// MaxEffectiveBalanceForEpoch returns the maximum effective balance at the
+// given epoch of a validator, by whether it has compounding withdrawal
+// credentials. From the Electra fork onwards, compounding validators are
+// capped at MaxEffectiveBalanceElectra (EIP-7251), unless it is not set.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxEffectiveBalanceForEpoch(
+	epoch EpochT,
+	compounding bool,
+) uint64 {
+	if compounding && c.Data.MaxEffectiveBalanceElectra != 0 &&
+		c.ActiveForkVersionForEpoch(epoch) >= version.Electra {
+		return c.Data.MaxEffectiveBalanceElectra
+	}
+	return c.Data.MaxEffectiveBalance
+}
+
 // SlotToEpoch converts a slot to an epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -56,6 +73,14 @@ func (c chainSpec[
 	return EpochT(uint64(slot) / c.SlotsPerEpoch())
 }
 
+// SlotsPerEth1VotingPeriod returns the number of slots in an eth1 data voting
+// period.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SlotsPerEth1VotingPeriod() uint64 {
+	return c.EpochsPerEth1VotingPeriod() * c.SlotsPerEpoch()
+}
+
 // WithinDAPeriod checks if the block epoch is within
 // MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS
 // of the given current epoch.
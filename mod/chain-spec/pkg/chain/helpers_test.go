@@ -46,6 +46,8 @@ var spec = chain.NewChainSpec(
 		ElectraForkEpoch:                 10,
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
+		MaxEffectiveBalance:              32e9,
+		MaxEffectiveBalanceElectra:       2048e9,
 	},
 )
 
@@ -71,6 +73,43 @@ func TestActiveForkVersionForEpoch(t *testing.T) {
 	}
 }
 
+// TestMaxEffectiveBalanceForEpoch tests the MaxEffectiveBalanceForEpoch
+// method.
+func TestMaxEffectiveBalanceForEpoch(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		epoch       epoch
+		compounding bool
+		expected    uint64
+	}{
+		{name: "Legacy Before Electra Fork", epoch: 9, expected: 32e9},
+		{name: "Legacy At Electra Fork", epoch: 10, expected: 32e9},
+		{
+			name:        "Compounding Before Electra Fork",
+			epoch:       9,
+			compounding: true,
+			expected:    32e9,
+		},
+		{
+			name:        "Compounding At Electra Fork",
+			epoch:       10,
+			compounding: true,
+			expected:    2048e9,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.MaxEffectiveBalanceForEpoch(
+				tt.epoch, tt.compounding,
+			)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
 // TestSlotToEpoch tests the SlotToEpoch method.
 func TestSlotToEpoch(t *testing.T) {
 	// Define test cases
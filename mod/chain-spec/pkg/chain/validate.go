@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrZeroSlotsPerEpoch is returned when a chain spec has no slots per
+	// epoch.
+	ErrZeroSlotsPerEpoch = errors.New("slots per epoch must be non-zero")
+
+	// ErrInvalidEth1VotingPeriod is returned when the eth1 data voting period
+	// of a chain spec is empty or its number of slots overflows.
+	ErrInvalidEth1VotingPeriod = errors.New("invalid eth1 voting period")
+
+	// ErrZeroEth1FollowDistance is returned when a chain spec reads deposits
+	// at the eth1 head rather than some distance behind it.
+	ErrZeroEth1FollowDistance = errors.New(
+		"eth1 follow distance must be non-zero",
+	)
+)
+
+// Validate returns an error if the chain spec is inconsistent, e.g. when its
+// parameters could not be used together by the state transition.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Validate() error {
+	if c.SlotsPerEpoch() == 0 {
+		return ErrZeroSlotsPerEpoch
+	}
+
+	// The voting period is expressed in epochs, as votes are reset at epoch
+	// boundaries, but tallied against its number of slots.
+	epochs := c.EpochsPerEth1VotingPeriod()
+	if epochs == 0 {
+		return fmt.Errorf(
+			"%w: epochs per eth1 voting period must be non-zero",
+			ErrInvalidEth1VotingPeriod,
+		)
+	}
+	if epochs > math.MaxUint64/c.SlotsPerEpoch() {
+		return fmt.Errorf(
+			"%w: %d epochs of %d slots overflow",
+			ErrInvalidEth1VotingPeriod, epochs, c.SlotsPerEpoch(),
+		)
+	}
+
+	if c.Eth1FollowDistance() == 0 {
+		return ErrZeroEth1FollowDistance
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidate tests the Validate method.
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      chain.SpecData[domainType, epoch, executionAddress, slot, any]
+		expectErr error
+	}{
+		{
+			name: "valid",
+			data: chain.SpecData[
+				domainType, epoch, executionAddress, slot, any,
+			]{
+				SlotsPerEpoch:             32,
+				EpochsPerEth1VotingPeriod: 8,
+				Eth1FollowDistance:        1,
+			},
+		},
+		{
+			name: "zero slots per epoch",
+			data: chain.SpecData[
+				domainType, epoch, executionAddress, slot, any,
+			]{
+				EpochsPerEth1VotingPeriod: 8,
+			},
+			expectErr: chain.ErrZeroSlotsPerEpoch,
+		},
+		{
+			name: "zero voting period",
+			data: chain.SpecData[
+				domainType, epoch, executionAddress, slot, any,
+			]{
+				SlotsPerEpoch: 32,
+			},
+			expectErr: chain.ErrInvalidEth1VotingPeriod,
+		},
+		{
+			name: "voting period overflow",
+			data: chain.SpecData[
+				domainType, epoch, executionAddress, slot, any,
+			]{
+				SlotsPerEpoch:             32,
+				EpochsPerEth1VotingPeriod: math.MaxUint64 / 16,
+			},
+			expectErr: chain.ErrInvalidEth1VotingPeriod,
+		},
+		{
+			name: "zero follow distance",
+			data: chain.SpecData[
+				domainType, epoch, executionAddress, slot, any,
+			]{
+				SlotsPerEpoch:             32,
+				EpochsPerEth1VotingPeriod: 8,
+			},
+			expectErr: chain.ErrZeroEth1FollowDistance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := chain.NewChainSpec(tt.data).Validate()
+			if tt.expectErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
+
+// TestSlotsPerEth1VotingPeriod tests the SlotsPerEth1VotingPeriod method.
+func TestSlotsPerEth1VotingPeriod(t *testing.T) {
+	require.Equal(t, uint64(96), chain.NewChainSpec(
+		chain.SpecData[domainType, epoch, executionAddress, slot, any]{
+			SlotsPerEpoch:             32,
+			EpochsPerEth1VotingPeriod: 3,
+		},
+	).SlotsPerEth1VotingPeriod())
+}
@@ -24,6 +24,7 @@ import (
 	"os"
 
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
@@ -31,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
@@ -100,10 +102,17 @@ func createValidatorCmd[
 			return err
 		}
 
+		domain, err := chainSpec.DomainTypeRegistry().DomainType(
+			chain.DomainNameDeposit, version.ToUint32(currentVersion),
+		)
+		if err != nil {
+			return err
+		}
+
 		// Create and sign the deposit message.
 		depositMsg, signature, err := types.CreateAndSignDepositMessage(
 			types.NewForkData(currentVersion, genesisValidatorRoot),
-			chainSpec.DomainTypeDeposit(),
+			domain,
 			blsSigner,
 			credentials,
 			amount,
@@ -116,7 +125,7 @@ func createValidatorCmd[
 		if err = depositMsg.VerifyCreateValidator(
 			types.NewForkData(currentVersion, genesisValidatorRoot),
 			signature,
-			chainSpec.DomainTypeDeposit(),
+			domain,
 			signer.BLSSigner{}.VerifySignature,
 		); err != nil {
 			return err
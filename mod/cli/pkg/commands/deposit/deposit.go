@@ -21,11 +21,13 @@
 package deposit
 
 import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
@@ -106,6 +108,13 @@ func validateDepositMessage(chainSpec common.ChainSpec) func(
 			return err
 		}
 
+		domain, err := chainSpec.DomainTypeRegistry().DomainType(
+			chain.DomainNameDeposit, version.ToUint32(currentVersion),
+		)
+		if err != nil {
+			return err
+		}
+
 		depositMessage := types.DepositMessage{
 			Pubkey:      pubkey,
 			Credentials: credentials,
@@ -115,7 +124,7 @@ func validateDepositMessage(chainSpec common.ChainSpec) func(
 		return depositMessage.VerifyCreateValidator(
 			types.NewForkData(currentVersion, genesisValidatorRoot),
 			signature,
-			chainSpec.DomainTypeDeposit(),
+			domain,
 			signer.BLSSigner{}.VerifySignature,
 		)
 	}
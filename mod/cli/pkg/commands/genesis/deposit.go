@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/context"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -91,10 +92,16 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 			currentVersion := version.FromUint32[common.Version](
 				version.Deneb,
 			)
+			domain, err := cs.DomainTypeRegistry().DomainType(
+				chain.DomainNameDeposit, version.ToUint32(currentVersion),
+			)
+			if err != nil {
+				return err
+			}
 
 			depositMsg, signature, err := types.CreateAndSignDepositMessage(
 				types.NewForkData(currentVersion, common.Root{}),
-				cs.DomainTypeDeposit(),
+				domain,
 				blsSigner,
 				// TODO: configurable.
 				types.NewCredentialsFromExecutionAddress(
@@ -110,7 +117,7 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 			if err = depositMsg.VerifyCreateValidator(
 				types.NewForkData(currentVersion, common.Root{}),
 				signature,
-				cs.DomainTypeDeposit(),
+				domain,
 				signer.BLSSigner{}.VerifySignature,
 			); err != nil {
 				return err
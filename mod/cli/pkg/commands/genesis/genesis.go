@@ -46,6 +46,7 @@ func Commands(
 		CollectGenesisDepositsCmd(),
 		AddExecutionPayloadCmd(cs),
 		GetGenesisValidatorRootCmd(cs),
+		ValidateGenesisCmd(cs),
 	)
 
 	// Add additional commands
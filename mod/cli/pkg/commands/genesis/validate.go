@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+// genesisChecksumKey is the key of the configured genesis checksum.
+const genesisChecksumKey = "genesis-checksum"
+
+type (
+	// kvStore is the beacon store the genesis is dry-run on.
+	kvStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	// beaconStateMarshallable is the marshallable beacon state.
+	beaconStateMarshallable = types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]
+
+	// beaconState is the beacon state the genesis is dry-run on.
+	beaconState = state.StateDB[
+		*types.BeaconBlockHeader,
+		*beaconStateMarshallable,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*kvStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+// ValidateGenesisCmd returns the cobra command to dry-run the genesis of a
+// genesis file. The genesis is initialized on an in-memory store, which is
+// discarded afterwards, and the resulting number of validators and beacon
+// state root are printed.
+func ValidateGenesisCmd(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [beacond/genesis.json]",
+		Short: "dry-runs the genesis of the genesis file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			// The checksum is verified on the app state bytes as they are in
+			// the file, which are the ones InitChain is given.
+			v := context.GetViperFromCmd(cmd)
+			if err = verifyGenesisChecksum(
+				appGenesis.AppState, v.GetString(genesisChecksumKey),
+			); err != nil {
+				return err
+			}
+
+			var appState map[string]json.RawMessage
+			if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return errors.Wrap(err, "failed to unmarshal app state")
+			}
+			genesis := &types.Genesis[
+				*types.Deposit, *types.ExecutionPayloadHeader,
+			]{}
+			if err = json.Unmarshal(appState["beacon"], genesis); err != nil {
+				return errors.Wrap(err, "failed to unmarshal beacon genesis")
+			}
+
+			beaconCfg, err := config.ReadConfigFromAppOpts(v)
+			if err != nil {
+				return err
+			}
+			count, stateRoot, err := dryRunGenesis(
+				cs, beaconCfg.StateProcessor, genesis,
+			)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("validators: %d\nstate root: %s\n", count, stateRoot)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// verifyGenesisChecksum rejects app state bytes whose SHA-256 differs from
+// the given hex-encoded checksum, if any.
+func verifyGenesisChecksum(appState []byte, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+
+	expected, err := hex.DecodeString(checksum)
+	if err != nil {
+		return errors.Wrap(err, "invalid genesis-checksum")
+	}
+	if sum := sha256.Sum256(appState); !bytes.Equal(sum[:], expected) {
+		return fmt.Errorf(
+			"genesis checksum mismatch: expected %x, got %x", expected, sum,
+		)
+	}
+	return nil
+}
+
+// dryRunGenesis initializes the beacon state of the genesis on an in-memory
+// store and returns its number of validators and its root.
+func dryRunGenesis(
+	cs common.ChainSpec,
+	cfg core.Config,
+	genesis *types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader],
+) (int, common.Root, error) {
+	var (
+		nopLog   = log.NewNopLogger()
+		storeKey = components.ProvideKVStoreKey()
+		cms      = store.NewCommitMultiStore(
+			dbm.NewMemDB(), nopLog, metrics.NewNoOpMetrics(),
+		)
+	)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return 0, common.Root{}, err
+	}
+
+	kv := components.ProvideKVStore[
+		*types.BeaconBlockHeader, *types.ExecutionPayloadHeader,
+	](components.KVStoreInput{
+		KVStoreService: components.NewKVStoreService(storeKey),
+	})
+	st := (&beaconState{}).NewFromDB(
+		kv.WithContext(sdk.NewContext(cms, true, nopLog)), cs,
+	)
+
+	sp := core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*beaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*kvStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, nil, signer.BLSSigner{}, nil, cfg, nil)
+	if _, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genesis.GetDeposits(),
+		genesis.GetExecutionPayloadHeader(),
+		genesis.GetForkVersion(),
+	); err != nil {
+		return 0, common.Root{}, err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return 0, common.Root{}, err
+	}
+	return len(validators), st.HashTreeRoot(), nil
+}
@@ -23,6 +23,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
@@ -42,12 +43,25 @@ const (
 	FlagHaltTime        = "halt-time"
 	FlagInterBlockCache = "inter-block-cache"
 
-	FlagPruning             = "pruning"
-	FlagPruningKeepRecent   = "pruning-keep-recent"
-	FlagPruningInterval     = "pruning-interval"
-	FlagMinRetainBlocks     = "min-retain-blocks"
-	FlagIAVLCacheSize       = "iavl-cache-size"
-	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+	FlagPruning               = "pruning"
+	FlagPruningKeepRecent     = "pruning-keep-recent"
+	FlagPruningInterval       = "pruning-interval"
+	FlagMinRetainBlocks       = "min-retain-blocks"
+	FlagRetainCatchUpBlocks   = "retain-catch-up-blocks"
+	FlagMaxReorgDepth         = "max-reorg-depth"
+	FlagProcessProposalBudget = "process-proposal-budget-percent"
+	FlagMinPeers              = "min-peers"
+	FlagChainIDPrefix         = "chain-id-prefix"
+	FlagMaxGenesisValidators  = "max-genesis-validators"
+	FlagGenesisChecksum       = "genesis-checksum"
+	FlagQueryRateLimits       = "query-rate-limits"
+	FlagCommitRetries         = "commit-retries"
+	FlagCommitRetryBackoff    = "commit-retry-backoff"
+	FlagFinalizeWAL           = "finalize-wal"
+	FlagVerifyAppHash         = "verify-app-hash"
+	FlagSkipEmptySlots        = "skip-empty-slots"
+	FlagIAVLCacheSize         = "iavl-cache-size"
+	FlagDisableIAVLFastNode   = "iavl-disable-fastnode"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -179,6 +193,71 @@ func addStartNodeFlags[
 			FlagMinRetainBlocks,
 			0,
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
+	cmd.Flags().
+		Uint64(
+			FlagRetainCatchUpBlocks,
+			0,
+			"Maximum number of blocks the retain height may advance by per commit, to prune a backlog of blocks over several commits (0 disables)")
+	cmd.Flags().
+		Uint64(
+			FlagMaxReorgDepth,
+			0,
+			"Maximum number of blocks the parent of a proposal may lag behind the last committed block, guarding against a misbehaving consensus engine (0 disables)")
+	cmd.Flags().
+		Uint64(
+			FlagProcessProposalBudget,
+			100, //nolint:mnd // percentage.
+			"Percentage of the slot duration proposal verification may take (0 disables)")
+	cmd.Flags().
+		Uint64(
+			FlagMinPeers,
+			0,
+			"Number of peers required before proposing or accepting proposals (0 disables)")
+	cmd.Flags().
+		String(
+			FlagChainIDPrefix,
+			"",
+			"Accept any chain ID with this prefix on InitChain (devnet only)")
+	cmd.Flags().
+		Uint64(
+			FlagMaxGenesisValidators,
+			1<<21, //nolint:mnd // mainnet-scale.
+			"Maximum number of validators the genesis may create (0 disables)")
+	cmd.Flags().
+		String(
+			FlagGenesisChecksum,
+			"",
+			"Expected hex-encoded SHA-256 of the genesis app state (empty disables)")
+	cmd.Flags().
+		String(
+			FlagQueryRateLimits,
+			"",
+			"Comma separated class=rate:burst limits of the ABCI queries per source, e.g. peer=10:20 (empty disables)")
+	cmd.Flags().
+		Uint64(
+			FlagCommitRetries,
+			3, //nolint:mnd // retries.
+			"Number of times a failed commit is retried before halting (0 disables)")
+	cmd.Flags().
+		Duration(
+			FlagCommitRetryBackoff,
+			100*time.Millisecond, //nolint:mnd // a momentary IO hiccup.
+			"Time waited before the first retry of a failed commit, doubling after each retry")
+	cmd.Flags().
+		Bool(
+			FlagFinalizeWAL,
+			false,
+			"Keep a write-ahead log of the finalized block until it is committed, to detect a crash in between on restart")
+	cmd.Flags().
+		Bool(
+			FlagVerifyAppHash,
+			false,
+			"Run the state transition of every block twice and halt if the resulting app hashes differ, doubling the cost of the state transition (debug only)")
+	cmd.Flags().
+		Bool(
+			FlagSkipEmptySlots,
+			false,
+			"Derive the slot of a block from its time rather than its height, skipping the slots without a block (must match across the network)")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 
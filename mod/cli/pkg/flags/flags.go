@@ -37,8 +37,9 @@ const (
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
 	// Validator Config.
-	validatorRoot = beaconKitRoot + "validator."
-	Graffiti      = validatorRoot + "graffiti"
+	validatorRoot      = beaconKitRoot + "validator."
+	Graffiti           = validatorRoot + "graffiti"
+	VerifiedBlockCache = validatorRoot + "verified-block-cache"
 
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
@@ -53,6 +54,7 @@ const (
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
+	KZGTrustedSetupHash = kzgRoot + "trusted-setup-hash"
 	KZGImplementation   = kzgRoot + "implementation"
 
 	// Logger Config.
@@ -111,6 +113,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.KZG.TrustedSetupPath,
 		"kzg trusted setup path",
 	)
+	startCmd.Flags().String(
+		KZGTrustedSetupHash,
+		defaultCfg.KZG.TrustedSetupHash,
+		"kzg trusted setup sha256",
+	)
 	startCmd.Flags().String(
 		KZGImplementation,
 		defaultCfg.KZG.Implementation,
@@ -25,12 +25,15 @@ import (
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -49,6 +52,9 @@ func DefaultConfig() *Config {
 		PayloadBuilder:    builder.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
+		AvailabilityStore: dastore.DefaultConfig(),
+		Deposit:           deposit.DefaultConfig(),
+		StateProcessor:    core.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
 	}
 }
@@ -67,6 +73,12 @@ type Config struct {
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// AvailabilityStore is the configuration for the blob availability store.
+	AvailabilityStore dastore.Config `mapstructure:"availability-store"`
+	// Deposit is the configuration for the deposit service.
+	Deposit deposit.Config `mapstructure:"deposit"`
+	// StateProcessor is the configuration for the state processor.
+	StateProcessor core.Config `mapstructure:"state-processor"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
 }
@@ -22,6 +22,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -67,6 +68,55 @@ type BaseConfig struct {
 	// ResponseCommit.RetainHeight.
 	MinRetainBlocks uint64 `mapstructure:"min-retain-blocks"`
 
+	// RetainCatchUpBlocks defines the maximum number of blocks the retain
+	// height may advance by per commit, such that a backlog of blocks
+	// retained before pruning was enabled is pruned over several commits. A
+	// value of 0 disables the bound.
+	RetainCatchUpBlocks uint64 `mapstructure:"retain-catch-up-blocks"`
+
+	// MaxReorgDepth defines the maximum number of blocks that the parent of a
+	// proposal may lag behind the last committed block. Proposals exceeding
+	// this depth are rejected. As CometBFT finalizes every block there are no
+	// reorgs, so this only guards against a misbehaving consensus engine. A
+	// value of 0 disables the check.
+	MaxReorgDepth uint64 `mapstructure:"max-reorg-depth"`
+
+	// ProcessProposalBudgetPercent defines the percentage of the slot
+	// duration that ProcessProposal may spend verifying a proposal before
+	// rejecting it. It only applies if the chain spec sets SecondsPerSlot. A
+	// value of 0 disables the budget.
+	ProcessProposalBudgetPercent uint64 `mapstructure:"process-proposal-budget-percent"`
+
+	// ChainIDPrefix, if set, makes InitChain accept any chain ID beginning
+	// with this prefix in addition to the configured chain ID. It is only
+	// permitted on devnets.
+	ChainIDPrefix string `mapstructure:"chain-id-prefix"`
+
+	// MaxGenesisValidators defines the maximum number of validators the
+	// genesis may create. InitChain rejects a genesis exceeding it before
+	// building the validator set. A value of 0 disables the check.
+	MaxGenesisValidators uint64 `mapstructure:"max-genesis-validators"`
+
+	// GenesisChecksum, if set, is the expected hex-encoded SHA-256 of the
+	// genesis app state. InitChain rejects a genesis with a different
+	// checksum before decoding it.
+	GenesisChecksum string `mapstructure:"genesis-checksum"`
+
+	// QueryRateLimits defines the comma separated class=rate:burst limits of
+	// the ABCI queries per source, e.g. "peer=10:20". The peer filter queries
+	// of CometBFT are of the "peer" class, other queries of the "default"
+	// class. An empty value disables the limits.
+	QueryRateLimits string `mapstructure:"query-rate-limits"`
+
+	// CommitRetries defines the number of times a failed commit of the
+	// application state is retried before the node halts. A value of 0
+	// disables retries.
+	CommitRetries uint64 `mapstructure:"commit-retries"`
+
+	// CommitRetryBackoff defines the time waited before the first retry of a
+	// failed commit. It doubles after each retry.
+	CommitRetryBackoff time.Duration `mapstructure:"commit-retry-backoff"`
+
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
@@ -89,11 +139,23 @@ type Config struct {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			InterBlockCache:   true,
-			Pruning:           pruningtypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningInterval:   "0",
-			MinRetainBlocks:   0,
+			InterBlockCache:     true,
+			Pruning:             pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:   "0",
+			PruningInterval:     "0",
+			MinRetainBlocks:     0,
+			RetainCatchUpBlocks: 0,
+			MaxReorgDepth:       0,
+			//nolint:mnd // the whole slot.
+			ProcessProposalBudgetPercent: 100,
+			ChainIDPrefix:                "",
+			//nolint:mnd // mainnet-scale.
+			MaxGenesisValidators: 1 << 21,
+			GenesisChecksum:      "",
+			QueryRateLimits:      "",
+			CommitRetries:        3,
+			//nolint:mnd // a momentary IO hiccup.
+			CommitRetryBackoff: 100 * time.Millisecond,
 			//nolint:mnd // its a bet.
 			IAVLCacheSize:       5000,
 			IAVLDisableFastNode: false,
@@ -44,6 +44,51 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # ResponseCommit.RetainHeight.
 min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 
+# RetainCatchUpBlocks defines the maximum number of blocks the retain height
+# may advance by per commit, such that a backlog of blocks retained before
+# pruning was enabled is pruned over several commits. A value of 0 disables the
+# bound.
+retain-catch-up-blocks = {{ .BaseConfig.RetainCatchUpBlocks }}
+
+# MaxReorgDepth defines the maximum number of blocks that the parent of a
+# proposal may lag behind the last committed block. Proposals exceeding this
+# depth are rejected. A value of 0 disables the check.
+max-reorg-depth = {{ .BaseConfig.MaxReorgDepth }}
+
+# ProcessProposalBudgetPercent defines the percentage of the slot duration that
+# ProcessProposal may spend verifying a proposal before rejecting it. It only
+# applies if the chain spec sets seconds-per-slot. A value of 0 disables the
+# budget.
+process-proposal-budget-percent = {{ .BaseConfig.ProcessProposalBudgetPercent }}
+
+# ChainIDPrefix, if set, makes InitChain accept any chain ID beginning with this
+# prefix in addition to the configured chain ID. Only permitted on devnets.
+chain-id-prefix = "{{ .BaseConfig.ChainIDPrefix }}"
+
+# MaxGenesisValidators defines the maximum number of validators the genesis may
+# create. InitChain rejects a genesis exceeding it before building the
+# validator set. A value of 0 disables the check.
+max-genesis-validators = {{ .BaseConfig.MaxGenesisValidators }}
+
+# GenesisChecksum, if set, is the expected hex-encoded SHA-256 of the genesis
+# app state. InitChain rejects a genesis with a different checksum before
+# decoding it.
+genesis-checksum = "{{ .BaseConfig.GenesisChecksum }}"
+
+# QueryRateLimits defines the comma separated class=rate:burst limits of the
+# ABCI queries per source, e.g. "peer=10:20". The peer filter queries of
+# CometBFT are of the "peer" class, other queries of the "default" class. An
+# empty value disables the limits.
+query-rate-limits = "{{ .BaseConfig.QueryRateLimits }}"
+
+# CommitRetries defines the number of times a failed commit of the application
+# state is retried before the node halts. A value of 0 disables retries.
+commit-retries = {{ .BaseConfig.CommitRetries }}
+
+# CommitRetryBackoff defines the time waited before the first retry of a failed
+# commit. It doubles after each retry.
+commit-retry-backoff = "{{ .BaseConfig.CommitRetryBackoff }}"
+
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
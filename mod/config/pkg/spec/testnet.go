@@ -60,14 +60,16 @@ func BaseSpec() chain.SpecData[
 		any,
 	]{
 		// // Gwei value constants.
-		MinDepositAmount:          uint64(1e9),
-		MaxEffectiveBalance:       uint64(32e9),
-		EjectionBalance:           uint64(16e9),
-		EffectiveBalanceIncrement: uint64(1e9),
+		MinDepositAmount:           uint64(1e9),
+		MaxEffectiveBalance:        uint64(32e9),
+		MaxEffectiveBalanceElectra: uint64(2048e9),
+		EjectionBalance:            uint64(16e9),
+		EffectiveBalanceIncrement:  uint64(1e9),
 		// Time parameters constants.
 		SlotsPerEpoch:                32,
 		MinEpochsToInactivityPenalty: 4,
 		SlotsPerHistoricalRoot:       8,
+		MinSeedLookahead:             1,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -90,6 +92,9 @@ func BaseSpec() chain.SpecData[
 		DomainTypeAggregateAndProof: common.DomainType{
 			0x06, 0x00, 0x00, 0x00,
 		},
+		DomainTypeSyncCommittee: common.DomainType{
+			0x07, 0x00, 0x00, 0x00,
+		},
 		DomainTypeApplicationMask: common.DomainType{
 			0x00, 0x00, 0x00, 0x01,
 		},
@@ -100,6 +105,7 @@ func BaseSpec() chain.SpecData[
 		DepositEth1ChainID:        uint64(80084),
 		Eth1FollowDistance:        1,
 		TargetSecondsPerEth1Block: 3,
+		EpochsPerEth1VotingPeriod: 8,
 		// Fork-related values.
 		DenebPlusForkEpoch: 9999999999999998,
 		ElectraForkEpoch:   9999999999999999,
@@ -108,13 +114,43 @@ func BaseSpec() chain.SpecData[
 		EpochsPerSlashingsVector:  8,
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
+		// Validator cycle values.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
+		MinActivationDelay:    4,
+		MaxCommitteesPerSlot:  64,
+		TargetCommitteeSize:   128,
+		// Attestation inclusion values.
+		MinAttestationInclusionDelay: 1,
+		// Validator exit values.
+		MinValidatorWithdrawabilityDelay: 256,
 		// Max operations per block constants.
 		MaxDepositsPerBlock: 16,
-		// Slashing
+		// Rewards and penalties values.
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     128,
+		WhistleblowerRewardQuotient:    512,
+		ProposerRewardQuotient:         8,
+		InactivityPenaltyQuotient:      1 << 24,
+		InactivityScoreBias:            4,
+		InactivityScoreRecoveryRate:    16,
+		// Attestation rewards are disabled, as they would mint new supply.
+		BaseRewardFactor: 0,
+		// Altair values.
+		SyncCommitteeSize:            512,
+		EpochsPerSyncCommitteePeriod: 4,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
+		// Swept validators not eligible for a withdrawal are withdrawn a zero
+		// amount, as on existing networks.
+		SkipIneligibleWithdrawals: false,
+		// Deposits to existing validators only raise their effective
+		// balance, as on existing networks.
+		CreditDepositTopUps: false,
+		// Validator updates leaving the validator set without voting power
+		// are sent to CometBFT, which fails to apply them.
+		WithholdEmptyValidatorSet: false,
 		// Deneb values.
 		MinEpochsForBlobsSidecarsRequest: 4096,
 		MaxBlobCommitmentsPerBlock:       16,
@@ -60,6 +60,10 @@ style = "{{.BeaconKit.Logger.Style}}"
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 
+# Hex-encoded SHA-256 of the trusted setup file, verified at startup.
+# Leave empty to skip the check.
+trusted-setup-hash = "{{.BeaconKit.KZG.TrustedSetupHash}}"
+
 # KZG implementation to use.
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
@@ -85,6 +89,43 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# AllowedFeeRecipients is the list of fee recipients that execution payloads are allowed to pay to.
+# Blocks paying to any other fee recipient are neither built nor accepted. An empty list disables the check.
+allowed-fee-recipients = [{{ range $i, $addr := .BeaconKit.Validator.AllowedFeeRecipients }}{{ if $i }}, {{ end }}"{{ $addr }}"{{ end }}]
+
+# FinalityStallThreshold is the number of epochs without finality after which finality is
+# reported as stalled. 0 disables reporting.
+finality-stall-threshold = {{ .BeaconKit.Validator.FinalityStallThreshold }}
+
+# DAQuarantineSize is the maximum number of finalized blocks held in quarantine until their
+# blob data is available, instead of being rejected outright. 0 disables quarantining.
+da-quarantine-size = {{ .BeaconKit.Validator.DAQuarantineSize }}
+
+# DAQuarantineTTL is how long a block is held in quarantine before it is rejected. It must be
+# shorter than the time FinalizeBlock awaits the block to be processed.
+da-quarantine-ttl = "{{ .BeaconKit.Validator.DAQuarantineTTL }}"
+
+# BackpressureThreshold is the number of queued execution engine calls at which the node refrains
+# from proposing blocks until the queue drains. 0 disables the check.
+backpressure-threshold = {{ .BeaconKit.Validator.BackpressureThreshold }}
+
+# ProductionLogSize is the number of latest slots whose block production metadata is recorded
+# for inspection. 0 disables recording.
+production-log-size = {{ .BeaconKit.Validator.ProductionLogSize }}
+
+# MaxExtraDataSize is the maximum size in bytes of the extra data of the execution payloads
+# built or accepted. 0 allows up to the protocol maximum.
+max-extra-data-size = {{ .BeaconKit.Validator.MaxExtraDataSize }}
+
+# ExtraDataPrefix is the hex-encoded prefix the extra data of the execution payloads built or
+# accepted must start with. An empty prefix allows any extra data.
+extra-data-prefix = "{{ .BeaconKit.Validator.ExtraDataPrefix }}"
+
+# VerifiedBlockCache determines whether the post state of a block verified on ProcessProposal
+# is kept and reused on FinalizeBlock if the same block is finalized, rather than transitioning
+# the block again. The operations of a reused block are not audited.
+verified-block-cache = {{ .BeaconKit.Validator.VerifiedBlockCache }}
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -92,6 +133,45 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+# BlocksWindow is the number of slots whose full blocks are persisted to disk,
+# such that they can be reconstructed with their blob sidecars. 0 disables it.
+blocks-window = "{{ .BeaconKit.BlockStoreService.BlocksWindow }}"
+
+[beacon-kit.availability-store]
+# CompressSidecars enables zstd compression of persisted blob sidecars. Sidecars
+# stored with either setting remain readable.
+compress-sidecars = {{ .BeaconKit.AvailabilityStore.CompressSidecars }}
+
+# MaxConcurrentPersists bounds the number of sidecars written to disk
+# concurrently, smoothing the disk IO while syncing. 0 disables it.
+max-concurrent-persists = {{ .BeaconKit.AvailabilityStore.MaxConcurrentPersists }}
+
+# FailWhenPersistBusy fails persisting sidecars rather than waiting while
+# max-concurrent-persists sidecars are already being written.
+fail-when-persist-busy = {{ .BeaconKit.AvailabilityStore.FailWhenPersistBusy }}
+
+# MaxRetainedSlots caps the number of most recent slots whose sidecars are kept on
+# disk, pruning them before the end of the DA window if stricter. 0 disables it.
+max-retained-slots = {{ .BeaconKit.AvailabilityStore.MaxRetainedSlots }}
+
+[beacon-kit.deposit]
+# BufferFutureDeposits reads deposits from the latest execution block and persists
+# them until the block is behind the eth1 follow distance of a canonical block.
+buffer-future-deposits = {{ .BeaconKit.Deposit.BufferFutureDeposits }}
+
+[beacon-kit.state-processor]
+# GenesisVerificationWorkers is the number of workers verifying the signatures
+# of the genesis deposits concurrently. 0 or 1 verifies them serially.
+genesis-verification-workers = {{ .BeaconKit.StateProcessor.GenesisVerificationWorkers }}
+
+# RejectDuplicateGenesisPubkeys rejects a genesis listing several deposits for the same
+# validator pubkey. By default, the deposits following the first one top up its validator.
+reject-duplicate-genesis-pubkeys = {{ .BeaconKit.StateProcessor.RejectDuplicateGenesisPubkeys }}
+
+# ValidateGenesisForkVersion rejects a genesis whose fork version is not the one the
+# chain spec activates at the genesis epoch.
+validate-genesis-fork-version = {{ .BeaconKit.StateProcessor.ValidateGenesisForkVersion }}
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -101,4 +181,8 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# MaxConcurrentQueries is the maximum number of queries against historical
+# state served concurrently. Excess queries are rejected, 0 disables the limit.
+max-concurrent-queries = {{ .BeaconKit.NodeAPI.MaxConcurrentQueries }}
 `
@@ -231,6 +231,21 @@ func (b *BeaconBlock) GetHeader() *BeaconBlockHeader {
 	}
 }
 
+// ComputeBlockRoot returns the canonical root of the block, as referenced by
+// the parent root of the next block and checked against the latest block
+// header of the state. It is the root of the block's header, which merkleizes
+// to the root of the block itself, and does not depend on any signature.
+func (b *BeaconBlock) ComputeBlockRoot() (common.Root, error) {
+	if b.IsNil() {
+		return common.Root{}, ErrNilBlock
+	} else if b.GetBody() == nil {
+		return common.Root{}, errors.Wrapf(
+			ErrNilBlockBody, "slot: %d", b.GetSlot(),
+		)
+	}
+	return b.GetHeader().HashTreeRoot(), nil
+}
+
 // GetTimestamp retrieves the timestamp of the BeaconBlock from
 // the ExecutionPayload.
 func (b *BeaconBlock) GetTimestamp() math.U64 {
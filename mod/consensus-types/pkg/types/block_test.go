@@ -147,6 +147,26 @@ func TestBeaconBlock_HashTreeRoot(t *testing.T) {
 	require.NotNil(t, hashRoot)
 }
 
+func TestComputeBlockRoot(t *testing.T) {
+	block := generateValidBeaconBlock()
+	root, err := block.ComputeBlockRoot()
+	require.NoError(t, err)
+	require.Equal(t, block.HashTreeRoot(), root)
+	require.Equal(t, block.GetHeader().HashTreeRoot(), root)
+
+	// The root commits to every field of the block.
+	block.StateRoot = common.Root{6}
+	changed, err := block.ComputeBlockRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, root, changed)
+	require.Equal(t, block.HashTreeRoot(), changed)
+
+	_, err = (*types.BeaconBlock)(nil).ComputeBlockRoot()
+	require.ErrorIs(t, err, types.ErrNilBlock)
+	_, err = (&types.BeaconBlock{Slot: 1}).ComputeBlockRoot()
+	require.ErrorIs(t, err, types.ErrNilBlockBody)
+}
+
 func TestBeaconBlockEmpty(t *testing.T) {
 	block := &types.BeaconBlock{}
 	emptyBlock := block.Empty()
@@ -26,10 +26,12 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -47,6 +49,10 @@ const (
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
 
+	// ExecutionPayloadGIndexDeneb is the generalized index of the
+	// ExecutionPayload in the merkle tree built from the block body.
+	ExecutionPayloadGIndexDeneb = 12
+
 	// ExtraDataSize is the size of ExtraData in bytes.
 	ExtraDataSize = 32
 )
@@ -230,6 +236,52 @@ func (b *BeaconBlockBody) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(b)
 }
 
+// ExecutionPayloadInclusionProof returns the proof of the ExecutionPayload
+// against the root of the body, such that the payload may be delivered
+// separately from the block header committing to the body.
+func (b *BeaconBlockBody) ExecutionPayloadInclusionProof() (
+	[]common.Root, error,
+) {
+	tree, err := b.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	payloadProof, err := tree.Prove(ExecutionPayloadGIndexDeneb)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([]common.Root, len(payloadProof.Hashes))
+	for i, hash := range payloadProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, nil
+}
+
+// VerifyPayloadAgainstHeader verifies that the execution payload is the one
+// committed to by the body root of the block header, given the inclusion
+// proof of the payload in the body.
+func VerifyPayloadAgainstHeader(
+	header *BeaconBlockHeader,
+	payload *ExecutionPayload,
+	proof []common.Root,
+) error {
+	if !merkle.VerifyProof(
+		header.GetBodyRoot(),
+		payload.HashTreeRoot(),
+		ExecutionPayloadGIndexDeneb,
+		proof,
+	) {
+		return errors.Wrapf(
+			ErrPayloadNotInHeader,
+			"block hash: %s, body root: %s",
+			payload.GetBlockHash(), header.GetBodyRoot(),
+		)
+	}
+	return nil
+}
+
 // IsNil checks if the BeaconBlockBody is nil.
 func (b *BeaconBlockBody) IsNil() bool {
 	return b == nil
@@ -160,3 +160,37 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	body := blockBody.Empty(version.Deneb)
 	require.NotNil(t, body)
 }
+
+func TestVerifyPayloadAgainstHeader(t *testing.T) {
+	body := generateBeaconBlockBody()
+	body.ExecutionPayload.BlockHash = common.ExecutionHash{1, 2, 3}
+	body.BlobKzgCommitments = []eip4844.KZGCommitment{{4}, {5}}
+	header := types.NewBeaconBlockHeader(
+		math.Slot(1),
+		math.ValidatorIndex(2),
+		common.Root{3},
+		common.Root{4},
+		body.HashTreeRoot(),
+	)
+
+	proof, err := body.ExecutionPayloadInclusionProof()
+	require.NoError(t, err)
+
+	// The payload committed to by the header verifies.
+	require.NoError(t, types.VerifyPayloadAgainstHeader(
+		header, body.GetExecutionPayload(), proof,
+	))
+
+	// A payload with a different block hash does not.
+	mismatched := *body.GetExecutionPayload()
+	mismatched.BlockHash = common.ExecutionHash{6}
+	require.ErrorIs(t, types.VerifyPayloadAgainstHeader(
+		header, &mismatched, proof,
+	), types.ErrPayloadNotInHeader)
+
+	// Nor does it against a header committing to another body.
+	header.SetBodyRoot(common.Root{7})
+	require.ErrorIs(t, types.VerifyPayloadAgainstHeader(
+		header, body.GetExecutionPayload(), proof,
+	), types.ErrPayloadNotInHeader)
+}
@@ -36,6 +36,18 @@ var (
 	// version is not supported.
 	ErrForkVersionNotSupported = errors.New("fork version not supported")
 
+	// ErrNilBlock is an error for when the beacon block is nil.
+	ErrNilBlock = errors.New("nil beacon block")
+
+	// ErrNilBlockBody is an error for when the beacon block body is nil.
+	ErrNilBlockBody = errors.New("nil beacon block body")
+
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrPayloadNotInHeader is an error for when an execution payload is not
+	// the one committed to by a block header.
+	ErrPayloadNotInHeader = errors.New(
+		"execution payload not committed to by block header",
+	)
 )
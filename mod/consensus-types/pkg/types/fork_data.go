@@ -54,6 +54,19 @@ func (fd *ForkData) New(
 	return NewForkData(currentVersion, genesisValidatorsRoot)
 }
 
+// ComputeForkDataRoot as defined in the Ethereum 2.0 specification, i.e. the
+// root of the fork data of the given version and genesis validators root,
+// which domains and fork digests derive from.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_data_root
+//
+//nolint:lll // link.
+func ComputeForkDataRoot(
+	version common.Version,
+	genesisValidatorsRoot common.Root,
+) (common.Root, error) {
+	return NewForkData(version, genesisValidatorsRoot).HashTreeRoot(), nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -117,3 +130,36 @@ func (fd *ForkData) ComputeRandaoSigningRoot(
 		fd.ComputeDomain(domainType),
 	)
 }
+
+// ComputeSyncCommitteeSigningRoot computes the signing root of the block root
+// signed by the sync committee.
+func (fd *ForkData) ComputeSyncCommitteeSigningRoot(
+	domainType common.DomainType,
+	blockRoot common.Root,
+) common.Root {
+	return (&SigningData{
+		ObjectRoot: blockRoot,
+		Domain:     fd.ComputeDomain(domainType),
+	}).HashTreeRoot()
+}
+
+// ComputeAttestationSigningRoot computes the signing root of the attestation
+// data signed by the members of a beacon committee.
+func (fd *ForkData) ComputeAttestationSigningRoot(
+	domainType common.DomainType,
+	data interface{ HashTreeRoot() common.Root },
+) common.Root {
+	return (&SigningData{
+		ObjectRoot: data.HashTreeRoot(),
+		Domain:     fd.ComputeDomain(domainType),
+	}).HashTreeRoot()
+}
+
+// ComputeProposalSigningRoot computes the signing root of a beacon block
+// proposal.
+func (fd *ForkData) ComputeProposalSigningRoot(
+	domainType common.DomainType,
+	block interface{ HashTreeRoot() common.Root },
+) common.Root {
+	return ComputeSigningRoot(block, fd.ComputeDomain(domainType))
+}
@@ -74,6 +74,30 @@ func TestForkData_HashTreeRoot(t *testing.T) {
 	})
 }
 
+//nolint:lll // vectors.
+func TestComputeForkDataRoot(t *testing.T) {
+	// The genesis validators root of Ethereum mainnet, whose fork data roots
+	// are prefixed by the fork digests 0xb5303f2a, 0xbba4da96 and 0x6a95a1a9
+	// of the phase0, Capella and Deneb forks.
+	genesisValidatorsRoot, err := common.NewRootFromHex(
+		"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	)
+	require.NoError(t, err)
+	for version, expected := range map[common.Version]string{
+		{0x00, 0x00, 0x00, 0x00}: "0xb5303f2ad2010d699a76c8e62350947421a3e4a979779642cfdb0f6668986b25",
+		{0x03, 0x00, 0x00, 0x00}: "0xbba4da96354c9f25476cf1bc69bf583a7f9e0af049305b62de676640e84b3899",
+		{0x04, 0x00, 0x00, 0x00}: "0x6a95a1a967855d676d48be69883b712607f952d5198d0f5677564636f365ac53",
+	} {
+		root, err := types.ComputeForkDataRoot(version, genesisValidatorsRoot)
+		require.NoError(t, err)
+		require.Equal(t, expected, root.String(), "version: %x", version)
+		require.Equal(
+			t, types.NewForkData(version, genesisValidatorsRoot).HashTreeRoot(),
+			root,
+		)
+	}
+}
+
 func TestForkData_ComputeDomain(t *testing.T) {
 	forkData := &types.ForkData{
 		CurrentVersion:        common.Version{},
@@ -101,6 +125,26 @@ func TestForkData_ComputeRandaoSigningRoot(t *testing.T) {
 	})
 }
 
+func TestForkData_ComputeProposalSigningRoot(t *testing.T) {
+	fd := &types.ForkData{
+		CurrentVersion:        common.Version{0x04, 0x00, 0x00, 0x00},
+		GenesisValidatorsRoot: common.Root{0x01},
+	}
+	blk := generateValidBeaconBlock()
+	domainType := common.DomainType{0x00, 0x00, 0x00, 0x00}
+
+	expected, err := common.NewRootFromHex(
+		"0x9ac1ebde18238cf4f1dee15013abcd981f393dacee13242bd8d3e184faad736e",
+	)
+	require.NoError(t, err)
+
+	signingRoot := fd.ComputeProposalSigningRoot(domainType, blk)
+	require.Equal(t, expected, signingRoot)
+	require.Equal(t, types.ComputeSigningRoot(
+		blk, fd.ComputeDomain(domainType),
+	), signingRoot)
+}
+
 func TestNewForkData(t *testing.T) {
 	currentVersion := common.Version{}
 	genesisValidatorsRoot := common.Root{}
@@ -24,11 +24,19 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
-// BeaconState represents the entire state of the beacon chain.
+// historicalRootsLimit is the maximum number of historical summaries in the
+// BeaconState, HISTORICAL_ROOTS_LIMIT in the Ethereum 2.0 specification.
+const historicalRootsLimit = 16777216
+
+// BeaconState represents the entire state of the beacon chain. The
+// HistoricalSummaries are only part of the container from the Electra fork
+// onwards, which leaves the encoding and the generalized indices of the
+// states before it unchanged.
 type BeaconState[
 	BeaconBlockHeaderT constraints.
 		StaticSSZField[BeaconBlockHeaderT, B],
@@ -71,6 +79,12 @@ type BeaconState[
 	// Slashing
 	Slashings     []math.Gwei
 	TotalSlashing math.Gwei
+
+	// Electra
+	HistoricalSummaries []*HistoricalSummary
+
+	// forkVersion is the version of the fork the container is of.
+	forkVersion uint32
 }
 
 // New creates a new BeaconState.
@@ -82,7 +96,7 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ]) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork ForkT,
@@ -99,6 +113,7 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []math.Gwei,
 	totalSlashing math.Gwei,
+	historicalSummaries []common.HistoricalSummary,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	Eth1DataT,
@@ -107,6 +122,17 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ], error) {
+	var summaries []*HistoricalSummary
+	if forkVersion >= version.Electra {
+		summaries = make([]*HistoricalSummary, len(historicalSummaries))
+		for i, summary := range historicalSummaries {
+			summaries[i] = &HistoricalSummary{
+				BlockSummaryRoot: summary.BlockSummaryRoot,
+				StateSummaryRoot: summary.StateSummaryRoot,
+			}
+		}
+	}
+
 	return &BeaconState[
 		BeaconBlockHeaderT,
 		Eth1DataT,
@@ -131,9 +157,18 @@ func (st *BeaconState[
 		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 		Slashings:                    slashings,
 		TotalSlashing:                totalSlashing,
+		HistoricalSummaries:          summaries,
+		forkVersion:                  forkVersion,
 	}, nil
 }
 
+// isElectra returns whether the container is of the Electra fork or later.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) isElectra() bool {
+	return st.forkVersion >= version.Electra
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -143,6 +178,9 @@ func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 300
+	if st.isElectra() {
+		size += 4
+	}
 
 	if fixed {
 		return size
@@ -156,6 +194,9 @@ func (st *BeaconState[
 	size += ssz.SizeSliceOfUint64s(st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(st.Slashings)
+	if st.isElectra() {
+		size += ssz.SizeSliceOfStaticObjects(st.HistoricalSummaries)
+	}
 
 	return size
 }
@@ -196,6 +237,13 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Electra
+	if st.isElectra() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.HistoricalSummaries, historicalRootsLimit,
+		)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -204,6 +252,11 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	if st.isElectra() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.HistoricalSummaries, historicalRootsLimit,
+		)
+	}
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -214,7 +267,8 @@ func (st *BeaconState[
 	return buf, ssz.EncodeToBytes(buf, st)
 }
 
-// UnmarshalSSZ unmarshals the BeaconState from SSZ format.
+// UnmarshalSSZ unmarshals the BeaconState from SSZ format, in the container
+// of the fork version the BeaconState was created with.
 func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) UnmarshalSSZ(buf []byte) error {
@@ -391,6 +445,21 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	// Field (16) 'HistoricalSummaries'
+	if st.isElectra() {
+		subIndx = hh.Index()
+		num = uint64(len(st.HistoricalSummaries))
+		if num > historicalRootsLimit {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.HistoricalSummaries {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, historicalRootsLimit)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
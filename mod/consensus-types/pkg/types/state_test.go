@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -239,3 +240,82 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 		"HashTreeRoot and HashSequential should produce the same result",
 	)
 }
+
+func TestBeaconStateElectraHistoricalSummaries(t *testing.T) {
+	deneb := generateValidBeaconState()
+	summaries := []common.HistoricalSummary{
+		{
+			BlockSummaryRoot: common.Root{0x01},
+			StateSummaryRoot: common.Root{0x02},
+		},
+		{
+			BlockSummaryRoot: common.Root{0x03},
+			StateSummaryRoot: common.Root{0x04},
+		},
+	}
+	newState := func(forkVersion uint32) *types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	] {
+		st, err := deneb.New(
+			forkVersion,
+			deneb.GenesisValidatorsRoot,
+			deneb.Slot,
+			deneb.Fork,
+			deneb.LatestBlockHeader,
+			deneb.BlockRoots,
+			deneb.StateRoots,
+			deneb.Eth1Data,
+			deneb.Eth1DepositIndex,
+			deneb.LatestExecutionPayloadHeader,
+			deneb.Validators,
+			deneb.Balances,
+			deneb.RandaoMixes,
+			deneb.NextWithdrawalIndex,
+			deneb.NextWithdrawalValidatorIndex,
+			deneb.Slashings,
+			deneb.TotalSlashing,
+			summaries,
+		)
+		require.NoError(t, err)
+		return st
+	}
+
+	// The summaries are not part of the state before the Electra fork.
+	denebPlus := newState(version.DenebPlus)
+	require.Empty(t, denebPlus.HistoricalSummaries)
+	require.Equal(t, deneb.SizeSSZ(false), denebPlus.SizeSSZ(false))
+	require.Equal(t, deneb.HashTreeRoot(), denebPlus.HashTreeRoot())
+
+	electra := newState(version.Electra)
+	require.Len(t, electra.HistoricalSummaries, len(summaries))
+	require.Equal(
+		t,
+		deneb.SizeSSZ(false)+4+uint32(len(summaries))*
+			types.HistoricalSummarySize,
+		electra.SizeSSZ(false),
+	)
+	require.NotEqual(t, deneb.HashTreeRoot(), electra.HashTreeRoot())
+
+	// Both hashers agree on the Electra container.
+	tree, err := electra.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, electra.HashTreeRoot(), common.Root(tree.Hash()))
+
+	// An Electra state decodes into the Electra container.
+	data, err := electra.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := newState(version.Electra)
+	decoded.HistoricalSummaries = nil
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, electra.HistoricalSummaries, decoded.HistoricalSummaries)
+	require.Equal(t, electra.HashTreeRoot(), decoded.HashTreeRoot())
+}
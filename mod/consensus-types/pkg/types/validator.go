@@ -230,6 +230,15 @@ func (v Validator) IsActive(epoch math.Epoch) bool {
 	return v.ActivationEpoch <= epoch && epoch < v.ExitEpoch
 }
 
+// IsInValidatorSet reports whether the validator is part of the CometBFT
+// validator set at the given epoch, i.e. it has a non-zero effective balance,
+// its voting power, and has not exited. Validators join the set as soon as
+// their deposit is processed rather than through the activation queue, hence
+// their activation epoch is never set and IsActive does not hold for them.
+func (v Validator) IsInValidatorSet(epoch math.Epoch) bool {
+	return v.EffectiveBalance > 0 && epoch < v.ExitEpoch
+}
+
 // IsEligibleForActivation as defined in the Ethereum 2.0 Spec
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#is_eligible_for_activation_queue
 //
@@ -276,8 +285,8 @@ func (v Validator) IsFullyWithdrawable(
 	balance math.Gwei,
 	epoch math.Epoch,
 ) bool {
-	return v.HasEth1WithdrawalCredentials() && v.WithdrawableEpoch <= epoch &&
-		balance > 0
+	return v.HasExecutionWithdrawalCredential() &&
+		v.WithdrawableEpoch <= epoch && balance > 0
 }
 
 // IsPartiallyWithdrawable as defined in the Ethereum 2.0 specification:
@@ -288,7 +297,7 @@ func (v Validator) IsPartiallyWithdrawable(
 	balance, maxEffectiveBalance math.Gwei,
 ) bool {
 	hasExcessBalance := balance > maxEffectiveBalance
-	return v.HasEth1WithdrawalCredentials() &&
+	return v.HasExecutionWithdrawalCredential() &&
 		v.HasMaxEffectiveBalance(maxEffectiveBalance) && hasExcessBalance
 }
 
@@ -300,6 +309,25 @@ func (v Validator) HasEth1WithdrawalCredentials() bool {
 	return v.WithdrawalCredentials[0] == EthSecp256k1CredentialPrefix
 }
 
+// HasCompoundingWithdrawalCredential as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_compounding_withdrawal_credential
+//
+//nolint:lll
+func (v Validator) HasCompoundingWithdrawalCredential() bool {
+	return v.WithdrawalCredentials[0] == CompoundingCredentialPrefix
+}
+
+// HasExecutionWithdrawalCredential as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_execution_withdrawal_credential
+//
+//nolint:lll
+func (v Validator) HasExecutionWithdrawalCredential() bool {
+	return v.HasEth1WithdrawalCredentials() ||
+		v.HasCompoundingWithdrawalCredential()
+}
+
 // HasMaxEffectiveBalance determines if the validator has the maximum effective
 // balance.
 func (v Validator) HasMaxEffectiveBalance(
@@ -313,11 +341,42 @@ func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
 }
 
+// GetActivationEligibilityEpoch returns the epoch when the validator became
+// eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// GetActivationEpoch returns the epoch when the validator is activated.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// GetExitEpoch returns the epoch when the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetSlashed marks the validator as slashed.
+func (v *Validator) SetSlashed() {
+	v.Slashed = true
+}
+
+// SetExitEpoch sets the epoch when the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
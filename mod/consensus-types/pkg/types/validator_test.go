@@ -196,6 +196,48 @@ func TestValidator_IsActive(t *testing.T) {
 	}
 }
 
+func TestValidator_IsInValidatorSet(t *testing.T) {
+	tests := []struct {
+		name      string
+		epoch     math.Epoch
+		validator *types.Validator
+		want      bool
+	}{
+		{
+			name:  "in set, activation epoch never set",
+			epoch: 10,
+			validator: types.NewValidatorFromDeposit(
+				[48]byte{0x01}, types.WithdrawalCredentials{}, 32e9, 1e9, 32e9,
+			),
+			want: true,
+		},
+		{
+			name:  "not in set, no effective balance",
+			epoch: 10,
+			validator: types.NewValidatorFromDeposit(
+				[48]byte{0x01}, types.WithdrawalCredentials{}, 1e8, 1e9, 32e9,
+			),
+			want: false,
+		},
+		{
+			name:  "not in set, after exit",
+			epoch: 16,
+			validator: &types.Validator{
+				EffectiveBalance: 32e9,
+				ExitEpoch:        15,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.want, tt.validator.IsInValidatorSet(tt.epoch),
+			)
+		})
+	}
+}
+
 func TestValidator_IsEligibleForActivation(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -369,6 +411,18 @@ func TestValidator_IsFullyWithdrawable(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name:    "fully withdrawable, compounding credentials",
+			balance: 32e9,
+			epoch:   10,
+			validator: &types.Validator{
+				WithdrawalCredentials: types.WithdrawalCredentials{
+					types.CompoundingCredentialPrefix,
+				},
+				WithdrawableEpoch: 5,
+			},
+			want: true,
+		},
 		{
 			name:    "not fully withdrawable, non-eth1 credentials",
 			balance: 32e9,
@@ -438,6 +492,17 @@ func TestValidator_IsPartiallyWithdrawable(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name:    "partially withdrawable, compounding credentials",
+			balance: 33e9,
+			validator: &types.Validator{
+				WithdrawalCredentials: types.WithdrawalCredentials{
+					types.CompoundingCredentialPrefix,
+				},
+				EffectiveBalance: maxEffectiveBalance,
+			},
+			want: true,
+		},
 		{
 			name:    "not partially withdrawable, non-eth1 credentials",
 			balance: 33e9,
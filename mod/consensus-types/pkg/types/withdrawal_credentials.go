@@ -26,11 +26,21 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
 )
 
-// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
-const EthSecp256k1CredentialPrefix = byte(iota + 1)
+const (
+	// BLSCredentialPrefix is the prefix for a BLS withdrawal pubkey.
+	BLSCredentialPrefix = byte(iota)
+	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
+	EthSecp256k1CredentialPrefix
+	// CompoundingCredentialPrefix is the prefix for an Ethereum secp256k1
+	// of a validator whose rewards compound, as introduced by EIP-7251.
+	CompoundingCredentialPrefix
+)
 
 // WithdrawalCredentials is a staking credential that is used to identify a
 // validator.
@@ -47,12 +57,47 @@ func NewCredentialsFromExecutionAddress(
 	return credentials
 }
 
+// NewCredentials creates the WithdrawalCredentials of the given prefix for an
+// execution address, i.e. the prefix followed by 11 zero bytes and the
+// address. Only the prefixes of credentials withdrawing to an execution
+// address are accepted, BLS credentials commit to a pubkey instead.
+func NewCredentials(
+	prefix byte,
+	address common.ExecutionAddress,
+) (WithdrawalCredentials, error) {
+	switch prefix {
+	case EthSecp256k1CredentialPrefix, CompoundingCredentialPrefix:
+	default:
+		return WithdrawalCredentials{}, errors.Wrapf(
+			ErrInvalidWithdrawalCredentials, "prefix: %#02x", prefix,
+		)
+	}
+
+	credentials := WithdrawalCredentials{}
+	credentials[0] = prefix
+	copy(credentials[12:], address[:])
+	return credentials, nil
+}
+
+// NewCredentialsFromBLSPubkey creates the BLS WithdrawalCredentials of a
+// withdrawal pubkey, i.e. the prefix followed by the last 31 bytes of the
+// SHA-256 hash of the pubkey.
+func NewCredentialsFromBLSPubkey(
+	pubkey crypto.BLSPubkey,
+) WithdrawalCredentials {
+	credentials := WithdrawalCredentials(sha256.Hash(pubkey[:]))
+	credentials[0] = BLSCredentialPrefix
+	return credentials
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
 func (wc WithdrawalCredentials) ToExecutionAddress() (
 	common.ExecutionAddress,
 	error,
 ) {
-	if wc[0] != EthSecp256k1CredentialPrefix {
+	switch wc[0] {
+	case EthSecp256k1CredentialPrefix, CompoundingCredentialPrefix:
+	default:
 		return common.ExecutionAddress{}, ErrInvalidWithdrawalCredentials
 	}
 	return common.ExecutionAddress(wc[12:]), nil
@@ -21,10 +21,12 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,6 +103,84 @@ func TestToExecutionAddress_InvalidPrefix(t *testing.T) {
 	require.Error(t, err, "Expected an error due to invalid prefix")
 }
 
+func TestNewCredentials(t *testing.T) {
+	address := common.ExecutionAddress{
+		0xde, 0xad, 0xbe, 0xef, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06,
+		0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+	}
+	tests := []struct {
+		name    string
+		prefix  byte
+		wantErr bool
+	}{
+		{
+			name:   "eth1 address",
+			prefix: types.EthSecp256k1CredentialPrefix,
+		},
+		{
+			name:   "compounding",
+			prefix: types.CompoundingCredentialPrefix,
+		},
+		{
+			name:    "bls",
+			prefix:  types.BLSCredentialPrefix,
+			wantErr: true,
+		},
+		{
+			name:    "unknown",
+			prefix:  0x03,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credentials, err := types.NewCredentials(tt.prefix, address)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidWithdrawalCredentials)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.prefix, credentials[0])
+			require.Equal(t, make([]byte, 11), credentials[1:12])
+			require.Equal(
+				t, address, common.ExecutionAddress(credentials[12:]),
+			)
+
+			converted, err := credentials.ToExecutionAddress()
+			require.NoError(t, err)
+			require.Equal(t, address, converted)
+		})
+	}
+}
+
+func TestNewCredentials_MatchesExecutionAddress(t *testing.T) {
+	address := common.ExecutionAddress{0xde, 0xad, 0xbe, 0xef}
+	credentials, err := types.NewCredentials(
+		types.EthSecp256k1CredentialPrefix, address,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, types.NewCredentialsFromExecutionAddress(address), credentials,
+	)
+
+	converted, err := credentials.ToExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, converted)
+}
+
+func TestNewCredentialsFromBLSPubkey(t *testing.T) {
+	pubkey := crypto.BLSPubkey{0xde, 0xad, 0xbe, 0xef}
+	hash := sha256.Sum256(pubkey[:])
+
+	credentials := types.NewCredentialsFromBLSPubkey(pubkey)
+	require.Equal(t, types.BLSCredentialPrefix, credentials[0])
+	require.Equal(t, hash[1:], credentials[1:])
+
+	_, err := credentials.ToExecutionAddress()
+	require.ErrorIs(t, err, types.ErrInvalidWithdrawalCredentials)
+}
+
 func TestWithdrawalCredentials_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -27,6 +27,8 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"cosmossdk.io/store/rootmulti"
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -35,6 +37,7 @@ import (
 	errorsmod "github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -42,16 +45,26 @@ import (
 	"github.com/sourcegraph/conc/iter"
 )
 
+// prepareProposalBudgetPercent is the percentage of the slot duration that
+// PrepareProposal may spend building a proposal.
+const prepareProposalBudgetPercent = 40
+
 var (
-	errInvalidHeight         = errors.New("invalid height")
-	errNilFinalizeBlockState = errors.New("finalizeBlockState is nil")
+	errInvalidHeight            = errors.New("invalid height")
+	errNilFinalizeBlockState    = errors.New("finalizeBlockState is nil")
+	errTooManyGenesisValidators = errors.New("too many genesis validators")
+	errResetState               = errors.New("failed to reset state")
+	errCommitFailed             = errors.New("failed to commit state")
+	errGenesisChecksumMismatch  = errors.New("genesis checksum mismatch")
+	errStoreIntegrity           = errors.New("store integrity check failed")
+	errInvalidLastSlot          = errors.New("invalid slot of the last block")
 )
 
 func (s *Service[LoggerT]) InitChain(
 	_ context.Context,
 	req *cmtabci.InitChainRequest,
 ) (*cmtabci.InitChainResponse, error) {
-	if req.ChainId != s.chainID {
+	if !s.isAllowedChainID(req.ChainId) {
 		return nil, fmt.Errorf(
 			"invalid chain-id on InitChain; expected: %s, got: %s",
 			s.chainID,
@@ -74,6 +87,7 @@ func (s *Service[LoggerT]) InitChain(
 	if s.initialHeight == 0 {
 		s.initialHeight = 1
 	}
+	s.setGenesis(s.initialHeight, req.Time)
 
 	// if req.InitialHeight is > 1, then we set the initial version on all
 	// stores
@@ -84,7 +98,11 @@ func (s *Service[LoggerT]) InitChain(
 		}
 	}
 
-	s.finalizeBlockState = s.resetState()
+	finalizeBlockState, err := s.resetState()
+	if err != nil {
+		return nil, err
+	}
+	s.finalizeBlockState = finalizeBlockState
 
 	resValidators, err := s.initChainer(
 		s.finalizeBlockState.Context(),
@@ -133,15 +151,32 @@ func (s *Service[LoggerT]) InitChain(
 	}, nil
 }
 
+// isAllowedChainID returns true if the chain ID matches the configured chain
+// ID, or begins with the configured devnet chain ID prefix.
+func (s *Service[LoggerT]) isAllowedChainID(chainID string) bool {
+	if chainID == s.chainID {
+		return true
+	}
+	return s.chainIDPrefix != "" &&
+		strings.HasPrefix(chainID, s.chainIDPrefix)
+}
+
 // InitChainer initializes the chain.
 func (s *Service[LoggerT]) initChainer(
 	ctx sdk.Context,
 	appStateBytes []byte,
 ) ([]cmtabci.ValidatorUpdate, error) {
+	if err := s.checkGenesisChecksum(appStateBytes); err != nil {
+		return nil, err
+	}
+
 	var genesisState map[string]json.RawMessage
 	if err := json.Unmarshal(appStateBytes, &genesisState); err != nil {
 		return nil, err
 	}
+	if err := s.checkGenesisValidators(genesisState["beacon"]); err != nil {
+		return nil, err
+	}
 	valUpdates, err := s.Middleware.InitGenesis(
 		ctx,
 		[]byte(genesisState["beacon"]),
@@ -194,9 +229,38 @@ func (s *Service[LoggerT]) PrepareProposal(
 		)
 	}
 
+	// Abstain from proposing until we are connected to enough peers to
+	// trust our view of the chain.
+	if peers := s.numPeers(); peers < s.minPeers {
+		s.logger.Warn(
+			"abstaining from proposal",
+			"reason",
+			"insufficient-peers",
+			"height",
+			req.Height,
+			"peers",
+			peers,
+			"min_peers",
+			s.minPeers,
+		)
+		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
+	}
+
 	// Always reset state given that PrepareProposal can timeout
 	// and be called again in a subsequent round.
-	s.prepareProposalState = s.resetState()
+	prepareProposalState, err := s.resetState()
+	if err != nil {
+		s.logger.Error(
+			"failed to prepare proposal",
+			"height",
+			req.Height,
+			"err",
+			err,
+		)
+		s.prepareProposalState = nil
+		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
+	}
+	s.prepareProposalState = prepareProposalState
 	s.prepareProposalState.SetContext(
 		s.getContextForProposal(
 			s.prepareProposalState.Context(),
@@ -204,12 +268,37 @@ func (s *Service[LoggerT]) PrepareProposal(
 		),
 	)
 
+	slot, err := s.slotForHeight(
+		s.prepareProposalState.Context(), req.Height, req.Time,
+	)
+	if err != nil {
+		s.logger.Error(
+			"failed to prepare proposal",
+			"height",
+			req.Height,
+			"err",
+			err,
+		)
+		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
+	}
+
+	// Bound the time spent building the proposal, such that a slow builder
+	// does not cause us to miss the slot.
+	ctx := withMisbehaviors(s.prepareProposalState.Context(), req.Misbehavior)
+	if budget := s.prepareProposalBudget(); budget > 0 {
+		deadlineCtx, cancel := context.WithDeadline(
+			ctx, s.proposalDeadline(slot, budget),
+		)
+		defer cancel()
+		ctx = ctx.WithContext(deadlineCtx)
+	}
+
 	blkBz, sidecarsBz, err := s.Middleware.PrepareProposal(
-		s.prepareProposalState.Context(), &types.SlotData[
+		ctx, &types.SlotData[
 			*ctypes.AttestationData,
 			*ctypes.SlashingInfo,
 		]{
-			Slot: math.Slot(req.Height),
+			Slot: slot,
 		},
 	)
 	if err != nil {
@@ -230,6 +319,51 @@ func (s *Service[LoggerT]) PrepareProposal(
 	}, nil
 }
 
+// processProposalBudget returns the maximum time ProcessProposal may spend
+// verifying a proposal, derived from the configured slot duration.
+func (s *Service[_]) processProposalBudget() time.Duration {
+	//nolint:mnd // percentage.
+	return s.slotDuration * time.Duration(s.processProposalBudgetPercent) / 100
+}
+
+// prepareProposalBudget returns the maximum time PrepareProposal may spend
+// building a proposal, derived from the configured slot duration.
+func (s *Service[_]) prepareProposalBudget() time.Duration {
+	//nolint:mnd // percentage.
+	return s.slotDuration * prepareProposalBudgetPercent / 100
+}
+
+// proposalDeadline returns the time until which the budget may be spent on
+// the proposal of the slot. The budget counts from the start of the slot, as
+// told by the slot clock, unless the clock has moved past the slot already,
+// in which case the proposal is late and the budget counts from now.
+func (s *Service[_]) proposalDeadline(
+	slot math.Slot,
+	budget time.Duration,
+) time.Time {
+	clock := s.slotClock()
+	if clock.CurrentSlot() > slot {
+		return time.Now().Add(budget)
+	}
+	return clock.SlotStartTime(slot).Add(budget)
+}
+
+// withMisbehaviors returns a copy of ctx carrying the CometBFT addresses of
+// the validators the evidence of the block is against, for the state
+// transition to slash them.
+func withMisbehaviors(
+	ctx sdk.Context,
+	misbehaviors []cmtabci.Misbehavior,
+) sdk.Context {
+	addresses := make([][]byte, len(misbehaviors))
+	for i, misbehavior := range misbehaviors {
+		addresses[i] = misbehavior.Validator.Address
+	}
+	return ctx.WithContext(
+		transition.WithMisbehavingValidators(ctx.Context(), addresses),
+	)
+}
+
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
 // ResponseProcessProposal object to the client.
 func (s *Service[LoggerT]) ProcessProposal(
@@ -244,6 +378,48 @@ func (s *Service[LoggerT]) ProcessProposal(
 			errInvalidHeight,
 		)
 	}
+	s.acceptedProposalHash = nil
+
+	// Abstain from accepting proposals until we are connected to enough
+	// peers to trust our view of the chain.
+	if peers := s.numPeers(); peers < s.minPeers {
+		s.logger.Error(
+			"rejecting proposal",
+			"reason",
+			"insufficient-peers",
+			"height",
+			req.Height,
+			"peers",
+			peers,
+			"min_peers",
+			s.minPeers,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
+	}
+
+	// Refuse proposals whose parent lags further behind the last committed
+	// block than the configured maximum depth. CometBFT only proposes at the
+	// height after the last committed one, so this only trips on a
+	// misbehaving consensus engine.
+	if depth := s.reorgDepth(req.Height); s.maxReorgDepth > 0 &&
+		depth > s.maxReorgDepth {
+		s.logger.Error(
+			"rejecting proposal",
+			"reason",
+			"reorg-too-deep",
+			"height",
+			req.Height,
+			"depth",
+			depth,
+			"max_reorg_depth",
+			s.maxReorgDepth,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
+	}
 
 	// Since the application can get access to FinalizeBlock state and write to
 	// it, we must be sure to reset it in case ProcessProposal timeouts and is
@@ -252,9 +428,19 @@ func (s *Service[LoggerT]) ProcessProposal(
 	// processed the first block, as we want to avoid overwriting the
 	// finalizeState
 	// after state changes during InitChain.
-	s.processProposalState = s.resetState()
-	if req.Height > s.initialHeight {
-		s.finalizeBlockState = s.resetState()
+	if err := s.resetProposalStates(req.Height); err != nil {
+		s.logger.Error(
+			"rejecting proposal",
+			"reason",
+			"state-reset-failed",
+			"height",
+			req.Height,
+			"err",
+			err,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
 	}
 
 	s.processProposalState.SetContext(
@@ -264,10 +450,51 @@ func (s *Service[LoggerT]) ProcessProposal(
 		),
 	)
 
-	resp, err := s.Middleware.ProcessProposal(
-		s.processProposalState.Context(),
-		req,
+	slot, err := s.slotForHeight(
+		s.processProposalState.Context(), req.Height, req.Time,
 	)
+	if err != nil {
+		s.logger.Error(
+			"rejecting proposal",
+			"reason",
+			"slot-derivation-failed",
+			"height",
+			req.Height,
+			"err",
+			err,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
+	}
+
+	// Bound the time spent verifying the proposal, such that a slow
+	// verifier does not cause us to blow past the round.
+	ctx := withMisbehaviors(s.processProposalState.Context(), req.Misbehavior)
+	budget := s.processProposalBudget()
+	if budget > 0 {
+		deadlineCtx, cancel := context.WithDeadline(
+			ctx, s.proposalDeadline(slot, budget),
+		)
+		defer cancel()
+		ctx = ctx.WithContext(deadlineCtx)
+	}
+
+	resp, err := s.Middleware.ProcessProposal(ctx, req, slot)
+	if errors.Is(ctx.Context().Err(), context.DeadlineExceeded) {
+		s.logger.Error(
+			"rejecting proposal",
+			"reason",
+			"verification-timeout",
+			"height",
+			req.Height,
+			"budget",
+			budget,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
+	}
 	if err != nil {
 		s.logger.Error(
 			"failed to process proposal",
@@ -285,9 +512,47 @@ func (s *Service[LoggerT]) ProcessProposal(
 		}, nil
 	}
 
+	if resp.GetStatus() == cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+		s.acceptedProposalHash = req.Hash
+	}
 	return resp, nil
 }
 
+// resetProposalStates resets the processProposal state, and the
+// finalizeBlock state past the initial height. A state which fails to reset
+// is cleared rather than left as it was.
+func (s *Service[_]) resetProposalStates(height int64) error {
+	processProposalState, err := s.resetState()
+	if err != nil {
+		s.processProposalState = nil
+		return err
+	}
+	s.processProposalState = processProposalState
+
+	if height > s.initialHeight {
+		finalizeBlockState, err := s.resetState()
+		if err != nil {
+			s.finalizeBlockState = nil
+			return err
+		}
+		s.finalizeBlockState = finalizeBlockState
+	}
+	return nil
+}
+
+// reorgDepth returns the number of blocks by which the parent of a proposal at
+// the given height lags behind the last committed block. It is always 0 for
+// the proposals of a well-behaved consensus engine.
+func (s *Service[_]) reorgDepth(height int64) uint64 {
+	parentHeight := height - 1
+	lastBlockHeight := s.LastBlockHeight()
+	if parentHeight >= lastBlockHeight {
+		return 0
+	}
+	//#nosec:G701 // checked above.
+	return uint64(lastBlockHeight - parentHeight)
+}
+
 func (s *Service[LoggerT]) internalFinalizeBlock(
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
@@ -295,14 +560,39 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 		return nil, err
 	}
 
+	// Finalize the proposal accepted last on the state it was verified on,
+	// if so configured. On the initial height, proposals are verified on a
+	// branch of the state of InitChain, which is not kept.
+	if s.reuseProposalState && !s.verifyAppHash &&
+		req.Height > s.initialHeight && s.processProposalState != nil &&
+		s.acceptedProposalHash != nil &&
+		bytes.Equal(req.Hash, s.acceptedProposalHash) {
+		s.finalizeBlockState = s.processProposalState
+	}
+	s.acceptedProposalHash = nil
+
 	// finalizeBlockState should be set on InitChain or ProcessProposal. If it
 	// is nil, it means we are replaying this block and we need to set the state
 	// here given that during block replay ProcessProposal is not executed by
 	// CometBFT.
 	if s.finalizeBlockState == nil {
-		s.finalizeBlockState = s.resetState()
+		finalizeBlockState, err := s.resetState()
+		if err != nil {
+			return nil, err
+		}
+		s.finalizeBlockState = finalizeBlockState
 	}
 
+	// Carry the header of the block on the finalizeBlockState, such that
+	// Commit persists the state at the height of the block.
+	header := s.finalizeBlockHeader(
+		req, s.sm.CommitMultiStore().LastCommitID().Hash,
+	)
+	s.finalizeBlockState.SetContext(withMisbehaviors(
+		s.finalizeBlockState.Context().WithBlockHeader(header),
+		req.Misbehavior,
+	))
+
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
 	//
@@ -320,14 +610,28 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 		})
 	}
 
-	finalizeBlock, err := s.Middleware.FinalizeBlock(
-		s.finalizeBlockState.Context(),
-		req,
+	// The middleware fails unless the block is of the slot derived here.
+	slot, err := s.slotForHeight(
+		s.finalizeBlockState.Context(), req.Height, req.Time,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	var finalizeBlock transition.ValidatorUpdates
+	if s.verifyAppHash {
+		finalizeBlock, err = s.finalizeBlockVerified(req, slot)
+	} else {
+		finalizeBlock, err = s.Middleware.FinalizeBlock(
+			s.finalizeBlockState.Context(),
+			req,
+			slot,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	valUpdates, err := iter.MapErr(
 		finalizeBlock,
 		convertValidatorUpdate[cmtabci.ValidatorUpdate],
@@ -336,6 +640,16 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 		return nil, err
 	}
 
+	// Keep the slot of the block, which the slot of its child derives from.
+	if s.skipEmptySlots {
+		s.setLastSlot(s.finalizeBlockState.Context(), slot)
+	}
+
+	s.finalized = &BlockFinalized{
+		Header:              header,
+		Hash:                req.Hash,
+		NumValidatorUpdates: len(valUpdates),
+	}
 	return &cmtabci.FinalizeBlockResponse{
 		TxResults:             txResults,
 		ValidatorUpdates:      valUpdates,
@@ -391,6 +705,9 @@ func (s *Service[_]) FinalizeBlock(
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
+	if err == nil && s.wal != nil {
+		err = s.logFinalizedBlock(req, res.AppHash)
+	}
 
 	return res, err
 }
@@ -403,7 +720,7 @@ func (s *Service[_]) FinalizeBlock(
 // against that height and gracefully halt if it matches the latest committed
 // height.
 func (s *Service[LoggerT]) Commit(
-	context.Context, *cmtabci.CommitRequest,
+	ctx context.Context, _ *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
 	if s.finalizeBlockState == nil {
 		// This is unexpected since CometBFT should call Commit only
@@ -411,21 +728,83 @@ func (s *Service[LoggerT]) Commit(
 		panic(fmt.Errorf("commit: %w", errNilFinalizeBlockState))
 	}
 	header := s.finalizeBlockState.Context().BlockHeader()
-	retainHeight := s.GetBlockRetentionHeight(header.Height)
+	retainHeight := s.catchUpRetainHeight(
+		s.GetBlockRetentionHeight(header.Height),
+	)
 
 	rms, ok := s.sm.CommitMultiStore().(*rootmulti.Store)
 	if ok {
 		rms.SetCommitHeader(header)
 	}
-	s.sm.CommitMultiStore().Commit()
+	if err := s.commitMultiStore(header.Height); err != nil {
+		return nil, err
+	}
+	s.queryContexts.onCommit(
+		header.Height, s.sm.CommitMultiStore().GetPruning(),
+	)
+
+	// A log left behind is discarded on restart, as the block is committed.
+	if s.wal != nil {
+		if err := s.wal.remove(); err != nil {
+			s.logger.Error(
+				"Failed to remove write-ahead log",
+				"height", header.Height,
+				"error", err,
+			)
+		}
+	}
 
 	s.finalizeBlockState = nil
+	s.publishBlockFinalized(ctx)
 
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
 }
 
+// commitMultiStore commits the multistore at the given height. A failed
+// commit is retried up to commitRetries times, waiting commitRetryBackoff
+// before the first retry and doubling the wait after each one. Consensus
+// cannot proceed without the commit, hence the error returned once the
+// retries are exhausted halts the node.
+func (s *Service[_]) commitMultiStore(height int64) error {
+	backoff := s.commitRetryBackoff
+	for attempt := uint64(1); ; attempt++ {
+		err := s.sm.Commit()
+		if err == nil {
+			return nil
+		}
+		if s.sm.LatestVersion() == height {
+			// The commit failed only after persisting the height, the
+			// store is reloaded to catch up with it.
+			return s.sm.LoadLatestVersion()
+		}
+
+		if attempt > s.commitRetries {
+			s.logger.Error(
+				"Failed to commit state, halting",
+				"height", height,
+				"attempts", attempt,
+				"error", err,
+			)
+			return errorsmod.Wrapf(
+				errCommitFailed, "height %d after %d attempts: %v",
+				height, attempt, err,
+			)
+		}
+
+		s.logger.Warn(
+			"Failed to commit state, retrying",
+			"height", height,
+			"attempt", attempt,
+			"backoff", backoff,
+			"error", err,
+		)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
 // workingHash gets the apphash that will be finalized in commit.
 // These writes will be persisted to the root multi-store
 // (s.sm.CommitMultiStore()) and flushed
@@ -465,7 +844,7 @@ func (s *Service[LoggerT]) getContextForProposal(
 	height int64,
 ) sdk.Context {
 	if height != s.initialHeight {
-		return ctx
+		return ctx.WithBlockHeight(height)
 	}
 
 	if s.finalizeBlockState == nil {
@@ -474,7 +853,22 @@ func (s *Service[LoggerT]) getContextForProposal(
 		panic(fmt.Errorf("getContextForProposal: %w", errNilFinalizeBlockState))
 	}
 	ctx, _ = s.finalizeBlockState.Context().CacheContext()
-	return ctx
+	return ctx.WithBlockHeight(height)
+}
+
+// Query serves no queries yet, but limits the rate of queries of every source
+// with the limits of its class, returning ErrQueryRateLimited once a source
+// has exhausted its limit. The peer filter queries of CometBFT are limited
+// per peer, unless the context carries a source.
+func (s *Service[_]) Query(
+	ctx context.Context,
+	req *cmtabci.QueryRequest,
+) (*cmtabci.QueryResponse, error) {
+	ctx = contextWithRequestSource(ctx, req)
+	if err := s.queryRateLimiter.allow(ctx); err != nil {
+		return nil, err
+	}
+	return &cmtabci.QueryResponse{}, nil
 }
 
 // CreateQueryContext creates a new sdk.Context for a query, taking as args
@@ -514,25 +908,63 @@ func (s *Service[LoggerT]) CreateQueryContext(
 			)
 	}
 
-	cacheMS, err := s.sm.CommitMultiStore().CacheMultiStoreWithVersion(height)
-	if err != nil {
-		return sdk.Context{},
-			errorsmod.Wrapf(
-				sdkerrors.ErrNotFound,
-				"failed to load state at height %d; %s (latest height: %d)",
-				height,
-				err,
-				lastBlockHeight,
-			)
+	// reuse a recently loaded version for this height if there is one.
+	cacheMS, ok := s.queryContexts.get(height)
+	if !ok {
+		var err error
+		cacheMS, err = s.sm.CommitMultiStore().
+			CacheMultiStoreWithVersion(height)
+		if err != nil {
+			return sdk.Context{},
+				errorsmod.Wrapf(
+					sdkerrors.ErrNotFound,
+					"failed to load state at height %d; %s (latest height: %d)",
+					height,
+					err,
+					lastBlockHeight,
+				)
+		}
+		s.queryContexts.add(height, cacheMS)
 	}
 
+	// Every query gets its own branch of the version.
 	return sdk.NewContext(
-		cacheMS,
+		cacheMS.CacheMultiStore(),
 		true,
 		servercmtlog.WrapSDKLogger(s.logger),
 	), nil
 }
 
+// catchUpRetainHeight bounds the advance of the retain height over the last
+// commit by retainCatchUpBlocks. Once pruning is enabled on a node which
+// retained all blocks, the backlog is thereby pruned progressively over
+// several commits instead of stalling a single one, until the retain height
+// catches up with the retention window.
+func (s *Service[_]) catchUpRetainHeight(retainHeight int64) int64 {
+	if retainHeight == 0 || s.retainCatchUpBlocks == 0 {
+		return retainHeight
+	}
+
+	// The first retain height since the node started advances from the
+	// lowest height retained.
+	if s.retainHeight == 0 {
+		s.retainHeight = s.blockStoreBase()
+	}
+
+	//#nosec:G701 // bet.
+	limit := s.retainHeight + int64(s.retainCatchUpBlocks)
+	if retainHeight > limit {
+		s.logger.Info(
+			"Pruning backlog of blocks",
+			"retain_height", limit,
+			"target_retain_height", retainHeight,
+		)
+		retainHeight = limit
+	}
+	s.retainHeight = max(s.retainHeight, retainHeight)
+	return retainHeight
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this
 // height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
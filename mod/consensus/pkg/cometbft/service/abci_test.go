@@ -0,0 +1,986 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/params"
+	statem "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/state"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessProposalMaxReorgDepth(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxReorgDepth uint64
+		height        int64
+		expected      cmtabci.ProcessProposalStatus
+	}{
+		{
+			name:          "next block",
+			maxReorgDepth: 2,
+			height:        11,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		{
+			name:          "parent lagging within the depth",
+			maxReorgDepth: 2,
+			height:        9,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		{
+			name:          "parent lagging beyond the depth",
+			maxReorgDepth: 2,
+			height:        4,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		{
+			name:          "parent lagging with guard disabled",
+			maxReorgDepth: 0,
+			height:        4,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := &testMiddleware{}
+			s := newTestService(
+				t, mw, SetMaxReorgDepth[*testLogger](tt.maxReorgDepth),
+			)
+			commitBlocks(t, s, 10)
+
+			resp, err := s.ProcessProposal(
+				context.Background(),
+				&cmtabci.ProcessProposalRequest{Height: tt.height},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp.Status)
+
+			// Rejected reorgs must not reach the middleware.
+			if tt.expected == cmtabci.PROCESS_PROPOSAL_STATUS_REJECT {
+				require.Zero(t, mw.processProposalCalls)
+			}
+		})
+	}
+}
+
+func TestPrepareProposalDeadline(t *testing.T) {
+	tests := []struct {
+		name         string
+		slotDuration time.Duration
+		buildDelay   time.Duration
+		expected     [][]byte
+	}{
+		{
+			name:         "no deadline",
+			slotDuration: 0,
+			buildDelay:   50 * time.Millisecond,
+			expected:     [][]byte{[]byte("block"), []byte("sidecars")},
+		},
+		{
+			name:         "within deadline",
+			slotDuration: 10 * time.Second,
+			buildDelay:   0,
+			expected:     [][]byte{[]byte("block"), []byte("sidecars")},
+		},
+		{
+			name:         "slow builder exceeds deadline",
+			slotDuration: 100 * time.Millisecond,
+			buildDelay:   10 * time.Second,
+			expected:     [][]byte{[]byte("tx")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(
+				t,
+				&testMiddleware{prepareProposalDelay: tt.buildDelay},
+				withSlotDuration(tt.slotDuration),
+			)
+
+			res, err := s.PrepareProposal(
+				context.Background(),
+				&cmtabci.PrepareProposalRequest{
+					Height: 1,
+					Txs:    [][]byte{[]byte("tx")},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res.Txs)
+		})
+	}
+}
+
+func TestProcessProposalBudget(t *testing.T) {
+	tests := []struct {
+		name          string
+		slotDuration  time.Duration
+		budgetPercent uint64
+		verifyDelay   time.Duration
+		expected      cmtabci.ProcessProposalStatus
+	}{
+		{
+			name:          "no slot duration",
+			slotDuration:  0,
+			budgetPercent: 100,
+			verifyDelay:   50 * time.Millisecond,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		{
+			name:          "budget disabled",
+			slotDuration:  10 * time.Millisecond,
+			budgetPercent: 0,
+			verifyDelay:   50 * time.Millisecond,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		{
+			name:          "within budget",
+			slotDuration:  10 * time.Second,
+			budgetPercent: 100,
+			verifyDelay:   0,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		{
+			name:          "slow verifier exceeds budget",
+			slotDuration:  100 * time.Millisecond,
+			budgetPercent: 50,
+			verifyDelay:   10 * time.Second,
+			expected:      cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := &testMiddleware{processProposalDelay: tt.verifyDelay}
+			s := newTestService(
+				t, mw,
+				withSlotDuration(tt.slotDuration),
+				SetProcessProposalBudgetPercent[*testLogger](
+					tt.budgetPercent,
+				),
+			)
+
+			start := time.Now()
+			resp, err := s.ProcessProposal(
+				context.Background(),
+				&cmtabci.ProcessProposalRequest{Height: 1},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp.Status)
+			require.Equal(t, 1, mw.processProposalCalls)
+
+			// Verification is aborted rather than run to completion.
+			require.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+// testPeerCounter is a PeerCounter of a fixed number of peers.
+type testPeerCounter struct {
+	peers uint64
+}
+
+func (c *testPeerCounter) NumPeers() uint64 {
+	return c.peers
+}
+
+func TestMinPeers(t *testing.T) {
+	var (
+		mw    = &testMiddleware{}
+		peers = &testPeerCounter{peers: 2}
+		s     = newTestService(
+			t, mw,
+			SetMinPeers[*testLogger](3),
+			SetPeerCounter[*testLogger](peers),
+		)
+		proposal = [][]byte{[]byte("block"), []byte("sidecars")}
+	)
+
+	participate := func() ([][]byte, cmtabci.ProcessProposalStatus) {
+		prepared, err := s.PrepareProposal(
+			context.Background(),
+			&cmtabci.PrepareProposalRequest{
+				Height: 1,
+				Txs:    [][]byte{[]byte("tx")},
+			},
+		)
+		require.NoError(t, err)
+		processed, err := s.ProcessProposal(
+			context.Background(),
+			&cmtabci.ProcessProposalRequest{Height: 1},
+		)
+		require.NoError(t, err)
+		return prepared.Txs, processed.Status
+	}
+
+	// Too few peers, so the node abstains from both proposing and accepting
+	// proposals, without reaching the middleware.
+	txs, status := participate()
+	require.Equal(t, [][]byte{[]byte("tx")}, txs)
+	require.Equal(t, cmtabci.PROCESS_PROPOSAL_STATUS_REJECT, status)
+	require.Zero(t, mw.processProposalCalls)
+
+	// Once enough peers are connected, the node participates.
+	peers.peers = 3
+	txs, status = participate()
+	require.Equal(t, proposal, txs)
+	require.Equal(t, cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT, status)
+	require.Equal(t, 1, mw.processProposalCalls)
+}
+
+func TestInitChainChainIDPrefix(t *testing.T) {
+	initChain := func(
+		chainID string, opts ...func(*Service[*testLogger]),
+	) error {
+		s := newUninitializedTestService(&testMiddleware{}, opts...)
+		_, err := s.InitChain(
+			context.Background(), &cmtabci.InitChainRequest{
+				ChainId:       chainID,
+				InitialHeight: 1,
+				AppStateBytes: []byte(`{"beacon":{}}`),
+			},
+		)
+		return err
+	}
+	withPrefix := SetChainIDPrefix[*testLogger]("beacond-ephemeral-")
+
+	// Without a prefix only the exact chain ID is accepted.
+	require.NoError(t, initChain(testChainID))
+	require.Error(t, initChain("beacond-ephemeral-1234"))
+
+	// With a prefix, matching chain IDs are accepted as well.
+	require.NoError(t, initChain(testChainID, withPrefix))
+	require.NoError(t, initChain("beacond-ephemeral-1234", withPrefix))
+	require.Error(t, initChain("beacond-other-1234", withPrefix))
+}
+
+func TestInitChainMaxGenesisValidators(t *testing.T) {
+	// Three validators, the first of which is topped up.
+	genesis := []byte(`{"beacon":{
+		"fork_version":"0x04000000",
+		"deposits":[
+			{"pubkey":"0x01","amount":"0x1"},
+			{"pubkey":"0x02","amount":"0x1"},
+			{"pubkey":"0x03","amount":"0x1"},
+			{"pubkey":"0x01","amount":"0x1"}
+		]
+	}}`)
+	initChain := func(maxValidators uint64) (*testMiddleware, error) {
+		mw := &testMiddleware{}
+		s := newUninitializedTestService(
+			mw, SetMaxGenesisValidators[*testLogger](maxValidators),
+		)
+		_, err := s.InitChain(
+			context.Background(), &cmtabci.InitChainRequest{
+				ChainId:       testChainID,
+				InitialHeight: 1,
+				AppStateBytes: genesis,
+			},
+		)
+		return mw, err
+	}
+
+	for _, maxValidators := range []uint64{0, 3, 4} {
+		mw, err := initChain(maxValidators)
+		require.NoError(t, err)
+		require.Equal(t, 1, mw.initGenesisCalls)
+	}
+
+	// The genesis is rejected before the validators are built.
+	mw, err := initChain(2)
+	require.ErrorIs(t, err, errTooManyGenesisValidators)
+	require.Equal(t, 0, mw.initGenesisCalls)
+}
+
+func TestInitChainGenesisChecksum(t *testing.T) {
+	genesis := []byte(`{"beacon":{}}`)
+	initChain := func(checksum []byte) (*testMiddleware, error) {
+		mw := &testMiddleware{}
+		s := newUninitializedTestService(
+			mw, SetGenesisChecksum[*testLogger](checksum),
+		)
+		_, err := s.InitChain(
+			context.Background(), &cmtabci.InitChainRequest{
+				ChainId:       testChainID,
+				InitialHeight: 1,
+				AppStateBytes: genesis,
+			},
+		)
+		return mw, err
+	}
+
+	// Without a checksum, or with a matching one, the genesis is accepted.
+	sum := sha256.Sum256(genesis)
+	for _, checksum := range [][]byte{nil, sum[:]} {
+		mw, err := initChain(checksum)
+		require.NoError(t, err)
+		require.Equal(t, 1, mw.initGenesisCalls)
+	}
+
+	// A mismatched genesis is rejected before it is decoded.
+	mismatched := sha256.Sum256([]byte(`{"beacon":{"edited":true}}`))
+	mw, err := initChain(mismatched[:])
+	require.ErrorIs(t, err, errGenesisChecksumMismatch)
+	require.Equal(t, 0, mw.initGenesisCalls)
+}
+
+// failingMultiStore is a CommitMultiStore which fails to be branched while
+// fail is set, and fails the next failCommits commits. If failPersisted is
+// set, the next commit fails once persisted, leaving the last commit ID
+// behind until the store is reloaded.
+type failingMultiStore struct {
+	storetypes.CommitMultiStore
+	fail          bool
+	failCommits   int
+	failPersisted bool
+	stale         *storetypes.CommitID
+}
+
+func (cms *failingMultiStore) Commit() storetypes.CommitID {
+	if cms.failCommits > 0 {
+		cms.failCommits--
+		panic("disk unavailable")
+	}
+	if cms.failPersisted {
+		cms.failPersisted = false
+		stale := cms.CommitMultiStore.LastCommitID()
+		cms.stale = &stale
+		cms.CommitMultiStore.Commit()
+		panic("disk unavailable")
+	}
+	return cms.CommitMultiStore.Commit()
+}
+
+func (cms *failingMultiStore) LastCommitID() storetypes.CommitID {
+	if cms.stale != nil {
+		return *cms.stale
+	}
+	return cms.CommitMultiStore.LastCommitID()
+}
+
+func (cms *failingMultiStore) LoadLatestVersion() error {
+	cms.stale = nil
+	return cms.CommitMultiStore.LoadLatestVersion()
+}
+
+func (cms *failingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	if cms.fail {
+		panic("store unavailable")
+	}
+	return cms.CommitMultiStore.CacheMultiStore()
+}
+
+func TestResetStateFailure(t *testing.T) {
+	mw := &testMiddleware{}
+	s := newTestService(t, mw)
+	commitBlocks(t, s, 2)
+
+	cms := &failingMultiStore{
+		CommitMultiStore: s.sm.CommitMultiStore(),
+		fail:             true,
+	}
+	s.sm = statem.NewManager(
+		dbm.NewMemDB(),
+		servercmtlog.WrapSDKLogger(s.logger),
+		statem.WithCommitMultiStore(cms),
+	)
+
+	// The proposal is rejected without being verified, and no half-reset
+	// state is left behind.
+	resp, err := s.ProcessProposal(
+		context.Background(), &cmtabci.ProcessProposalRequest{Height: 3},
+	)
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.PROCESS_PROPOSAL_STATUS_REJECT, resp.Status)
+	require.Equal(t, 0, mw.processProposalCalls)
+	require.Nil(t, s.processProposalState)
+	require.Nil(t, s.finalizeBlockState)
+
+	// No block is built.
+	prepareResp, err := s.PrepareProposal(
+		context.Background(), &cmtabci.PrepareProposalRequest{Height: 3},
+	)
+	require.NoError(t, err)
+	require.Empty(t, prepareResp.Txs)
+	require.Nil(t, s.prepareProposalState)
+
+	// The block cannot be finalized.
+	_, err = s.FinalizeBlock(
+		context.Background(), &cmtabci.FinalizeBlockRequest{Height: 3},
+	)
+	require.ErrorIs(t, err, errResetState)
+	require.Equal(t, 2, mw.finalizeBlockCalls)
+
+	// Once the store recovers, proposals are processed again.
+	cms.fail = false
+	resp, err = s.ProcessProposal(
+		context.Background(), &cmtabci.ProcessProposalRequest{Height: 3},
+	)
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT, resp.Status)
+	commitBlocks(t, s, 3)
+}
+
+func TestCommitRetry(t *testing.T) {
+	tests := []struct {
+		name          string
+		retries       uint64
+		failCommits   int
+		failPersisted bool
+		committed     bool
+	}{
+		{
+			name:        "succeeds on second attempt",
+			retries:     2,
+			failCommits: 1,
+			committed:   true,
+		},
+		{
+			name:        "retries exhausted",
+			retries:     1,
+			failCommits: 2,
+			committed:   false,
+		},
+		{
+			name:        "retries disabled",
+			retries:     0,
+			failCommits: 1,
+			committed:   false,
+		},
+		{
+			name:          "fails once persisted",
+			retries:       0,
+			failPersisted: true,
+			committed:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			s := newTestServiceWithDB(
+				t, db, &testMiddleware{},
+				SetCommitRetries[*testLogger](tt.retries),
+				SetCommitRetryBackoff[*testLogger](time.Millisecond),
+			)
+			commitBlocks(t, s, 2)
+
+			cms := &failingMultiStore{
+				CommitMultiStore: s.sm.CommitMultiStore(),
+				failCommits:      tt.failCommits,
+				failPersisted:    tt.failPersisted,
+			}
+			s.sm = statem.NewManager(
+				db,
+				servercmtlog.WrapSDKLogger(s.logger),
+				statem.WithCommitMultiStore(cms),
+			)
+
+			_, err := s.FinalizeBlock(
+				context.Background(), &cmtabci.FinalizeBlockRequest{Height: 3},
+			)
+			require.NoError(t, err)
+			_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+			if !tt.committed {
+				require.ErrorIs(t, err, errCommitFailed)
+				require.Equal(t, int64(2), s.LastBlockHeight())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(3), s.LastBlockHeight())
+			require.Zero(t, cms.failCommits)
+		})
+	}
+}
+
+// testBlockStore is a BlockStore retaining blocks from a fixed height.
+type testBlockStore struct {
+	base int64
+}
+
+func (bs *testBlockStore) Base() int64 {
+	return bs.base
+}
+
+func TestRetainCatchUpBlocks(t *testing.T) {
+	s := newTestService(
+		t, &testMiddleware{},
+		SetRetainCatchUpBlocks[*testLogger](8),
+		SetBlockStore[*testLogger](&testBlockStore{base: 1}),
+	)
+
+	// The evidence age does not bound the retention window.
+	cp := cmttypes.DefaultConsensusParams()
+	cp.Evidence.MaxAgeNumBlocks = 1
+	s.paramStore = params.NewConsensusParamsStore(chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{CometValues: cp},
+	))
+
+	// A backlog of blocks is retained while pruning is disabled.
+	commitBlocks(t, s, 50)
+
+	// Once pruning is enabled, the retain height advances by at most 8
+	// blocks per commit until it catches up with the last 10 blocks.
+	s.setMinRetainBlocks(10)
+	var retainHeights []int64
+	for h := int64(51); h <= 57; h++ {
+		_, err := s.FinalizeBlock(
+			context.Background(), &cmtabci.FinalizeBlockRequest{Height: h},
+		)
+		require.NoError(t, err)
+		res, err := s.Commit(context.Background(), &cmtabci.CommitRequest{})
+		require.NoError(t, err)
+		retainHeights = append(retainHeights, res.RetainHeight)
+	}
+	require.Equal(t, []int64{9, 17, 25, 33, 41, 46, 47}, retainHeights)
+}
+
+func TestLastAppHash(t *testing.T) {
+	s := newTestService(t, &testMiddleware{})
+	commitBlocks(t, s, 3)
+
+	info, err := s.Info(context.Background(), &cmtabci.InfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), info.LastBlockHeight)
+	require.NotEmpty(t, s.LastAppHash())
+	require.Equal(t, info.LastBlockAppHash, s.LastAppHash())
+}
+
+// heightMiddleware is a testMiddleware that stores the height of every
+// finalized block.
+type heightMiddleware struct {
+	testMiddleware
+}
+
+var heightKey = []byte("height")
+
+func (m *heightMiddleware) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	//#nosec:G115 // test heights are positive.
+	sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey).Set(
+		heightKey, binary.BigEndian.AppendUint64(nil, uint64(req.Height)),
+	)
+	return m.testMiddleware.FinalizeBlock(ctx, req, slot)
+}
+
+func TestInMemoryStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	services := []*Service[*testLogger]{
+		newUninitializedTestServiceWithDB(
+			db, &heightMiddleware{}, SetInMemoryStore[*testLogger](),
+		),
+		newUninitializedTestService(&heightMiddleware{}),
+	}
+	for _, s := range services {
+		_, err := s.InitChain(
+			context.Background(), &cmtabci.InitChainRequest{
+				ChainId:       testChainID,
+				InitialHeight: 1,
+				AppStateBytes: []byte(`{"beacon":{}}`),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// Both services go through the same lifecycle, yielding the same app
+	// hashes.
+	var appHashes [][]byte
+	for height := int64(1); height <= 3; height++ {
+		var appHash []byte
+		for _, s := range services {
+			resp, err := s.ProcessProposal(
+				context.Background(),
+				&cmtabci.ProcessProposalRequest{Height: height},
+			)
+			require.NoError(t, err)
+			require.Equal(
+				t, cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT, resp.Status,
+			)
+			finalized, err := s.FinalizeBlock(
+				context.Background(),
+				&cmtabci.FinalizeBlockRequest{Height: height},
+			)
+			require.NoError(t, err)
+			_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+			require.NoError(t, err)
+			require.Equal(t, finalized.AppHash, s.LastAppHash())
+
+			if appHash == nil {
+				appHash = s.LastAppHash()
+			}
+			require.Equal(t, appHash, s.LastAppHash())
+		}
+		require.NotContains(t, appHashes, appHash)
+		appHashes = append(appHashes, appHash)
+	}
+
+	// Past heights can be queried.
+	ctx, err := services[0].CreateQueryContext(2, false)
+	require.NoError(t, err)
+	require.Equal(t,
+		binary.BigEndian.AppendUint64(nil, 2),
+		ctx.KVStore(testStoreKey).Get(heightKey),
+	)
+
+	// Nothing was written to the database of the in-memory service.
+	iter, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
+
+func TestCreateQueryContextBranches(t *testing.T) {
+	s := newTestService(t, &heightMiddleware{})
+	commitBlocks(t, s, 2)
+
+	// The contexts of the same height share the loaded version, but the
+	// writes of one query are not seen by the others.
+	written, err := s.CreateQueryContext(2, false)
+	require.NoError(t, err)
+	written.KVStore(testStoreKey).Set(heightKey, []byte("written"))
+
+	ctx, err := s.CreateQueryContext(2, false)
+	require.NoError(t, err)
+	require.Equal(t,
+		binary.BigEndian.AppendUint64(nil, 2),
+		ctx.KVStore(testStoreKey).Get(heightKey),
+	)
+	require.Equal(t, 1, s.queryContexts.contexts.Len())
+}
+
+func BenchmarkCreateQueryContext(b *testing.B) {
+	s := newTestService(b, &testMiddleware{})
+	commitBlocks(b, s, 8)
+
+	b.Run("cached", func(b *testing.B) {
+		for range b.N {
+			if _, err := s.CreateQueryContext(4, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		for range b.N {
+			s.queryContexts.contexts.Purge()
+			if _, err := s.CreateQueryContext(4, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// genesisKey is written to the store by the InitGenesis of the
+// genesisStateMiddleware.
+var genesisKey = []byte("genesis")
+
+// genesisStateMiddleware is a testMiddleware which writes genesisKey on
+// InitGenesis, and records the block heights of the contexts it is called
+// with and whether they carry the genesis state.
+type genesisStateMiddleware struct {
+	testMiddleware
+	heights        []int64
+	missingGenesis []int64
+}
+
+func (m *genesisStateMiddleware) record(ctx context.Context, height int64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	m.heights = append(m.heights, sdkCtx.BlockHeight())
+	if !sdkCtx.KVStore(testStoreKey).Has(genesisKey) {
+		m.missingGenesis = append(m.missingGenesis, height)
+	}
+}
+
+func (m *genesisStateMiddleware) InitGenesis(
+	ctx context.Context, bz []byte,
+) (transition.ValidatorUpdates, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey).Set(genesisKey, bz)
+	return m.testMiddleware.InitGenesis(ctx, bz)
+}
+
+func (m *genesisStateMiddleware) PrepareProposal(
+	ctx context.Context,
+	slotData *types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo],
+) ([]byte, []byte, error) {
+	//#nosec:G115 // test heights are small.
+	m.record(ctx, int64(slotData.GetSlot().Unwrap()))
+	return m.testMiddleware.PrepareProposal(ctx, slotData)
+}
+
+func (m *genesisStateMiddleware) ProcessProposal(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+	slot math.Slot,
+) (*cmtabci.ProcessProposalResponse, error) {
+	m.record(ctx, req.Height)
+	return m.testMiddleware.ProcessProposal(ctx, req, slot)
+}
+
+func (m *genesisStateMiddleware) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.record(ctx, req.Height)
+	return m.testMiddleware.FinalizeBlock(ctx, req, slot)
+}
+
+func TestInitialHeight(t *testing.T) {
+	for _, initialHeight := range []int64{0, 1, 2, 100} {
+		t.Run(fmt.Sprintf("initial height %d", initialHeight), func(
+			t *testing.T,
+		) {
+			mw := &genesisStateMiddleware{}
+			s := newUninitializedTestService(mw)
+			_, err := s.InitChain(
+				context.Background(), &cmtabci.InitChainRequest{
+					ChainId:       testChainID,
+					InitialHeight: initialHeight,
+					AppStateBytes: []byte(`{"beacon":{}}`),
+				},
+			)
+			require.NoError(t, err)
+			require.Zero(t, s.LastBlockHeight())
+
+			first := max(initialHeight, 1)
+			for _, height := range []int64{first, first + 1} {
+				// A proposal is built and verified, and verified once more
+				// in a subsequent round, before being finalized.
+				_, err = s.PrepareProposal(
+					context.Background(),
+					&cmtabci.PrepareProposalRequest{Height: height},
+				)
+				require.NoError(t, err)
+				for range 2 {
+					resp, err := s.ProcessProposal(
+						context.Background(),
+						&cmtabci.ProcessProposalRequest{Height: height},
+					)
+					require.NoError(t, err)
+					require.Equal(
+						t, cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+						resp.Status,
+					)
+				}
+
+				// Only the next height may be finalized.
+				for _, invalid := range []int64{height - 1, height + 1} {
+					_, err = s.FinalizeBlock(
+						context.Background(),
+						&cmtabci.FinalizeBlockRequest{Height: invalid},
+					)
+					require.Error(t, err)
+				}
+				_, err = s.FinalizeBlock(
+					context.Background(),
+					&cmtabci.FinalizeBlockRequest{Height: height},
+				)
+				require.NoError(t, err)
+
+				_, err = s.Commit(
+					context.Background(), &cmtabci.CommitRequest{},
+				)
+				require.NoError(t, err)
+				require.Equal(t, height, s.LastBlockHeight())
+			}
+
+			// Every call saw the genesis state, at the height of its block.
+			require.Empty(t, mw.missingGenesis)
+			require.Equal(t, []int64{
+				first, first, first, first,
+				first + 1, first + 1, first + 1, first + 1,
+			}, mw.heights)
+		})
+	}
+}
+
+func TestCommitPublishesBlockFinalized(t *testing.T) {
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithDropOldestEvent[async.Event[BlockFinalized]](
+			async.BlockFinalized,
+		),
+	)
+	require.NoError(t, err)
+	finalized := make(chan async.Event[BlockFinalized], 1)
+	require.NoError(t, d.Subscribe(async.BlockFinalized, finalized))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, d.Start(ctx))
+
+	mw := &testMiddleware{
+		validatorUpdates: transition.ValidatorUpdates{
+			{EffectiveBalance: 1}, {EffectiveBalance: 2},
+		},
+	}
+	s := newTestService(t, mw, SetDispatcher[*testLogger](d))
+
+	req := &cmtabci.FinalizeBlockRequest{
+		Height:             1,
+		Time:               time.Unix(1_700_000_000, 0).UTC(),
+		Hash:               []byte{1},
+		NextValidatorsHash: []byte{2},
+		ProposerAddress:    []byte{3},
+	}
+	parentAppHash := s.sm.CommitMultiStore().LastCommitID().Hash
+	_, err = s.FinalizeBlock(context.Background(), req)
+	require.NoError(t, err)
+
+	// Nothing is published before the block is committed.
+	require.Empty(t, finalized)
+
+	_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+	require.NoError(t, err)
+	var event BlockFinalized
+	select {
+	case e := <-finalized:
+		event = e.Data()
+	case <-time.After(time.Second):
+		t.Fatal("block finalized event not published")
+	}
+	require.Equal(t, testChainID, event.Header.ChainID)
+	require.Equal(t, req.Height, event.Header.Height)
+	require.Equal(t, req.Time, event.Header.Time)
+	require.Equal(t, req.NextValidatorsHash, event.Header.NextValidatorsHash)
+	require.Equal(t, req.ProposerAddress, event.Header.ProposerAddress)
+	require.Equal(t, parentAppHash, event.Header.AppHash)
+	require.Equal(t, req.Hash, event.Hash)
+	require.Equal(t, s.sm.CommitMultiStore().LastCommitID().Hash, event.AppHash)
+	require.Equal(t, 2, event.NumValidatorUpdates)
+}
+
+// transitionKey is written to the store by the transitionMiddleware once it
+// transitioned the state of a block.
+var transitionKey = []byte("transition")
+
+// transitionMiddleware is a testMiddleware which transitions the state of
+// every block it verifies on ProcessProposal, and of every block it finalizes
+// on a state which was not transitioned already.
+type transitionMiddleware struct {
+	testMiddleware
+	transitions int
+}
+
+func (m *transitionMiddleware) transition(ctx context.Context) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey)
+	if !store.Has(transitionKey) {
+		m.transitions++
+		store.Set(transitionKey, []byte{0x01})
+	}
+}
+
+func (m *transitionMiddleware) ProcessProposal(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+	slot math.Slot,
+) (*cmtabci.ProcessProposalResponse, error) {
+	m.transition(ctx)
+	return m.testMiddleware.ProcessProposal(ctx, req, slot)
+}
+
+func (m *transitionMiddleware) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.transition(ctx)
+	sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey).Delete(transitionKey)
+	return m.testMiddleware.FinalizeBlock(ctx, req, slot)
+}
+
+func TestReuseProposalState(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		reuse         bool
+		finalizedHash []byte
+		transitions   int
+	}{
+		{"disabled", false, []byte("proposal"), 2},
+		{"same proposal", true, []byte("proposal"), 1},
+		{"other proposal", true, []byte("other"), 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mw := &transitionMiddleware{}
+			s := newTestService(
+				t, mw, SetReuseProposalState[*testLogger](tc.reuse),
+			)
+			// Proposals of the initial height are never reused.
+			commitBlocks(t, s, 1)
+			mw.transitions = 0
+
+			resp, err := s.ProcessProposal(
+				context.Background(),
+				&cmtabci.ProcessProposalRequest{
+					Height: 2, Hash: []byte("proposal"),
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(
+				t, cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT, resp.Status,
+			)
+			_, err = s.FinalizeBlock(
+				context.Background(),
+				&cmtabci.FinalizeBlockRequest{
+					Height: 2, Hash: tc.finalizedHash,
+				},
+			)
+			require.NoError(t, err)
+			_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.transitions, mw.transitions)
+
+			// The state of the proposal does not outlive its height.
+			commitBlocks(t, s, 3)
+			require.Equal(t, tc.transitions+1, mw.transitions)
+		})
+	}
+}
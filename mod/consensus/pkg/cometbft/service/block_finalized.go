@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	cmtabci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// BlockFinalized is published through the dispatcher, as the
+// async.BlockFinalized event, once a block has been committed. Its broker is
+// expected to drop the oldest events of a subscriber which does not keep up,
+// such that publishing never holds back the commit.
+type BlockFinalized struct {
+	// Header is the header of the committed block, as of its
+	// FinalizeBlockRequest. Its AppHash is the app hash the block was
+	// executed on.
+	Header cmtproto.Header
+	// Hash is the hash of the committed block.
+	Hash []byte
+	// AppHash is the app hash resulting from committing the block.
+	AppHash []byte
+	// NumValidatorUpdates is the number of validator updates of the block.
+	NumValidatorUpdates int
+}
+
+// finalizeBlockHeader returns the header of the block of the request, on the
+// given app hash of its parent.
+func (s *Service[_]) finalizeBlockHeader(
+	req *cmtabci.FinalizeBlockRequest,
+	parentAppHash []byte,
+) cmtproto.Header {
+	return cmtproto.Header{
+		ChainID:            s.chainID,
+		Height:             req.Height,
+		Time:               req.Time,
+		NextValidatorsHash: req.NextValidatorsHash,
+		AppHash:            parentAppHash,
+		ProposerAddress:    req.ProposerAddress,
+	}
+}
+
+// publishBlockFinalized publishes the BlockFinalized event of the block
+// committed last, if a dispatcher is set. It is best effort: a failure is
+// logged but does not fail the commit.
+func (s *Service[_]) publishBlockFinalized(ctx context.Context) {
+	event := s.finalized
+	s.finalized = nil
+	if s.dispatcher == nil || event == nil {
+		return
+	}
+
+	event.AppHash = s.sm.CommitMultiStore().LastCommitID().Hash
+	if err := s.dispatcher.Publish(
+		async.NewEvent(ctx, async.BlockFinalized, *event),
+	); err != nil {
+		s.logger.Error(
+			"Failed to publish block finalized event",
+			"height", event.Header.Height,
+			"error", err,
+		)
+	}
+}
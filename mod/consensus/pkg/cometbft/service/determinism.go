@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"slices"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// errNondeterministicState is returned when executing a block twice yields
+// different state, hence a different app hash.
+var errNondeterministicState = errors.New(
+	"state transition is not deterministic")
+
+const (
+	// writeOpSet marks a set in the digest of a store's writes.
+	writeOpSet byte = iota
+	// writeOpDelete marks a delete in the digest of a store's writes.
+	writeOpDelete
+)
+
+// writeRecorder is a KVStore digesting the writes flushed into it by the
+// cache above it. The writes are forwarded to the store it wraps, unless
+// they are discarded.
+type writeRecorder struct {
+	storetypes.KVStore
+	// digest is the running hash of the writes.
+	digest hash.Hash
+	// discard determines whether the writes are dropped after being
+	// digested.
+	discard bool
+}
+
+// Set digests and, unless discarding, sets the key.
+func (r *writeRecorder) Set(key, value []byte) {
+	r.record(writeOpSet, key, value)
+	if !r.discard {
+		r.KVStore.Set(key, value)
+	}
+}
+
+// Delete digests and, unless discarding, deletes the key.
+func (r *writeRecorder) Delete(key []byte) {
+	r.record(writeOpDelete, key, nil)
+	if !r.discard {
+		r.KVStore.Delete(key)
+	}
+}
+
+// record writes the length-prefixed operation to the digest.
+func (r *writeRecorder) record(op byte, key, value []byte) {
+	r.digest.Write([]byte{op})
+	r.digest.Write(binary.BigEndian.AppendUint64(nil, uint64(len(key))))
+	r.digest.Write(key)
+	r.digest.Write(binary.BigEndian.AppendUint64(nil, uint64(len(value))))
+	r.digest.Write(value)
+}
+
+// recordedBranch is a branch of a MultiStore digesting the writes made to
+// it once written. As the branch flushes the writes of every store in key
+// order, and the app hash is a function of the branched state and the
+// writes, equal digests of two branches of the same state imply equal app
+// hashes.
+type recordedBranch struct {
+	// ms is the branch of the MultiStore.
+	ms storetypes.CacheMultiStore
+	// recorders are the write recorders of the stores, by store name.
+	recorders map[string]*writeRecorder
+}
+
+// newRecordedBranch branches the mounted stores of the given MultiStore,
+// forwarding the writes to them unless discarding.
+func (s *Service[_]) newRecordedBranch(
+	ms storetypes.MultiStore, discard bool,
+) *recordedBranch {
+	var (
+		stores = make(
+			map[storetypes.StoreKey]storetypes.CacheWrapper, len(s.storeTypes),
+		)
+		recorders = make(map[string]*writeRecorder, len(s.storeTypes))
+	)
+	for key := range s.storeTypes {
+		recorder := &writeRecorder{
+			KVStore: ms.GetKVStore(key),
+			digest:  sha256.New(),
+			discard: discard,
+		}
+		stores[key] = recorder
+		recorders[key.Name()] = recorder
+	}
+	return &recordedBranch{
+		ms: cachemulti.NewFromKVStore(
+			dbadapter.Store{DB: dbm.NewMemDB()}, stores, nil, nil, nil,
+		),
+		recorders: recorders,
+	}
+}
+
+// digest returns the digest of the writes made to every store of the
+// branch, hashed in store name order.
+func (b *recordedBranch) digest() []byte {
+	names := make([]string, 0, len(b.recorders))
+	for name := range b.recorders {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	digest := sha256.New()
+	for _, name := range names {
+		digest.Write([]byte(name))
+		digest.Write(b.recorders[name].digest.Sum(nil))
+	}
+	return digest.Sum(nil)
+}
+
+// finalizeBlockVerified replays the state transition of the block on a
+// cache-wrapped copy of the finalizeBlock state whose writes are discarded,
+// then finalizes the block on the finalizeBlock state itself, failing if the
+// two executions write different state. Only the state transition runs
+// twice, hence the remaining effects of finalizing the block, e.g. on the
+// execution client, happen once.
+func (s *Service[_]) finalizeBlockVerified(
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	var (
+		ctx      = s.finalizeBlockState.Context()
+		ms       = s.finalizeBlockState.ms
+		replayed = s.newRecordedBranch(ms, true)
+		written  = s.newRecordedBranch(ms, false)
+	)
+	if _, err := s.Middleware.ReplayFinalizeBlock(
+		ctx.WithMultiStore(replayed.ms), req, slot,
+	); err != nil {
+		return nil, err
+	}
+	replayed.ms.Write()
+
+	valUpdates, err := s.Middleware.FinalizeBlock(
+		ctx.WithMultiStore(written.ms), req, slot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	written.ms.Write()
+
+	if !bytes.Equal(replayed.digest(), written.digest()) {
+		s.logger.Error(
+			"Block finalized to different states, the app hash is not "+
+				"deterministic ‼️",
+			"height", req.Height,
+		)
+		return nil, fmt.Errorf(
+			"%w: block at height %d", errNondeterministicState, req.Height,
+		)
+	}
+	return valUpdates, nil
+}
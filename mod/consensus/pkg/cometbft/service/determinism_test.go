@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// counterMiddleware is a testMiddleware that increments a counter in the
+// state on every finalized block.
+type counterMiddleware struct {
+	testMiddleware
+}
+
+var counterKey = []byte("counter")
+
+func (m *counterMiddleware) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.increment(ctx)
+	return m.testMiddleware.FinalizeBlock(ctx, req, slot)
+}
+
+func (m *counterMiddleware) ReplayFinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.increment(ctx)
+	return m.testMiddleware.ReplayFinalizeBlock(ctx, req, slot)
+}
+
+// increment increments the counter in the state.
+func (*counterMiddleware) increment(ctx context.Context) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey)
+	var counter uint64
+	if bz := store.Get(counterKey); bz != nil {
+		counter = binary.BigEndian.Uint64(bz)
+	}
+	store.Set(counterKey, binary.BigEndian.AppendUint64(nil, counter+1))
+}
+
+// nondeterministicMiddleware is a testMiddleware whose writes depend on the
+// number of times it finalized or replayed a block, rather than on the
+// block.
+type nondeterministicMiddleware struct {
+	testMiddleware
+	// extraKey determines whether the nondeterminism is a key written only
+	// by some executions, rather than a value differing between them.
+	extraKey bool
+}
+
+func (m *nondeterministicMiddleware) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.write(ctx)
+	return m.testMiddleware.FinalizeBlock(ctx, req, slot)
+}
+
+func (m *nondeterministicMiddleware) ReplayFinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.write(ctx)
+	return m.testMiddleware.ReplayFinalizeBlock(ctx, req, slot)
+}
+
+// write writes to the state depending on the number of calls so far.
+func (m *nondeterministicMiddleware) write(ctx context.Context) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey)
+	//#nosec:G115 // test call counts are positive.
+	calls := uint64(m.finalizeBlockCalls + m.replayCalls)
+	switch {
+	case !m.extraKey:
+		store.Set(counterKey, binary.BigEndian.AppendUint64(nil, calls))
+	case calls%2 == 1:
+		store.Set(heightKey, []byte("extra"))
+	}
+}
+
+func TestVerifyAppHash(t *testing.T) {
+	var (
+		verifying = &counterMiddleware{}
+		services  = []*Service[*testLogger]{
+			newTestService(t, verifying, SetVerifyAppHash[*testLogger](true)),
+			newTestService(t, &counterMiddleware{}),
+		}
+	)
+	for height := int64(1); height <= 3; height++ {
+		var appHashes [][]byte
+		for _, s := range services {
+			finalized, err := s.FinalizeBlock(
+				context.Background(),
+				&cmtabci.FinalizeBlockRequest{Height: height},
+			)
+			require.NoError(t, err)
+			_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+			require.NoError(t, err)
+			appHashes = append(appHashes, finalized.AppHash)
+		}
+
+		// Verifying does not change the app hash.
+		require.Equal(t, appHashes[0], appHashes[1])
+	}
+
+	// Every block was finalized once and replayed once, but only written
+	// once.
+	require.Equal(t, 3, verifying.finalizeBlockCalls)
+	require.Equal(t, 3, verifying.replayCalls)
+	ctx, err := services[0].CreateQueryContext(3, false)
+	require.NoError(t, err)
+	require.Equal(t,
+		binary.BigEndian.AppendUint64(nil, 3),
+		ctx.KVStore(testStoreKey).Get(counterKey),
+	)
+}
+
+func TestVerifyAppHashNondeterministic(t *testing.T) {
+	for _, extraKey := range []bool{false, true} {
+		mw := &nondeterministicMiddleware{extraKey: extraKey}
+
+		// Without verification, the nondeterminism goes unnoticed.
+		s := newTestService(t, mw)
+		_, err := s.FinalizeBlock(
+			context.Background(), &cmtabci.FinalizeBlockRequest{Height: 1},
+		)
+		require.NoError(t, err)
+
+		s = newTestService(t, mw, SetVerifyAppHash[*testLogger](true))
+		_, err = s.FinalizeBlock(
+			context.Background(), &cmtabci.FinalizeBlockRequest{Height: 1},
+		)
+		require.ErrorIs(t, err, errNondeterministicState)
+	}
+}
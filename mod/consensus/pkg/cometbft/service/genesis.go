@@ -21,12 +21,20 @@
 package cometbft
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"fmt"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	statem "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/state"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -44,16 +52,50 @@ func (s *Service[_]) DefaultGenesis() map[string]json.RawMessage {
 	return gen
 }
 
-// ValidateGenesis validates the provided genesis state.
+// ValidateGenesis validates the provided genesis state. The genesis state is
+// not dry-run here as its re-encoding has a checksum other than the one of the
+// genesis file: the genesis file is dry-run by the genesis validate command.
 func (s *Service[_]) ValidateGenesis(
 	_ map[string]json.RawMessage,
 ) error {
-	// Implement the validation logic for the provided genesis state.
-	// This should validate the genesis state for each module in the
-	// application.
 	return nil
 }
 
+// DryRunGenesis runs the genesis initialization of the given app state
+// against an ephemeral store, which is discarded afterwards, such that the
+// state of the node is left untouched. It returns the number of genesis
+// validators and the app hash of the resulting state.
+func (s *Service[_]) DryRunGenesis(
+	appStateBytes []byte,
+) (int, common.Root, error) {
+	sm := statem.NewManager(
+		dbm.NewMemDB(), servercmtlog.WrapSDKLogger(s.logger),
+	)
+	for key, typ := range s.storeTypes {
+		sm.CommitMultiStore().MountStoreWithDB(key, typ, nil)
+	}
+	if err := sm.LoadLatestVersion(); err != nil {
+		return 0, common.Root{}, err
+	}
+
+	ms, err := sm.CacheMultiStore()
+	if err != nil {
+		return 0, common.Root{}, err
+	}
+	validators, err := s.initChainer(
+		sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(s.logger)),
+		appStateBytes,
+	)
+	if err != nil {
+		return 0, common.Root{}, err
+	}
+	ms.Write()
+
+	var stateRoot common.Root
+	copy(stateRoot[:], sm.CommitMultiStore().WorkingHash())
+	return len(validators), stateRoot, nil
+}
+
 // GetGenDocProvider returns a function which returns the genesis doc from the
 // genesis file.
 func GetGenDocProvider(
@@ -94,3 +136,85 @@ func GetGenDocProvider(
 		}, nil
 	}
 }
+
+// checkGenesisChecksum rejects app state bytes whose SHA-256 differs from the
+// configured genesis checksum, if any.
+func (s *Service[_]) checkGenesisChecksum(appStateBytes []byte) error {
+	if len(s.genesisChecksum) == 0 {
+		return nil
+	}
+
+	sum := sha256.Sum256(appStateBytes)
+	if !bytes.Equal(sum[:], s.genesisChecksum) {
+		return fmt.Errorf(
+			"%w: expected %x, got %x",
+			errGenesisChecksumMismatch, s.genesisChecksum, sum,
+		)
+	}
+	return nil
+}
+
+// checkGenesisValidators rejects a beacon genesis whose deposits create more
+// than the maximum number of validators. The deposits are streamed one at a
+// time, so that an oversized genesis is rejected without being decoded in
+// full.
+func (s *Service[_]) checkGenesisValidators(beaconGenesis []byte) error {
+	if s.maxGenesisValidators == 0 || len(beaconGenesis) == 0 {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(beaconGenesis))
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key != "deposits" {
+			var skipped json.RawMessage
+			if err = dec.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = expectDelim(dec, '['); err != nil {
+			return err
+		}
+		// Top-ups of a pubkey do not create another validator.
+		pubkeys := make(map[string]struct{})
+		for dec.More() {
+			var deposit struct {
+				Pubkey string `json:"pubkey"`
+			}
+			if err = dec.Decode(&deposit); err != nil {
+				return err
+			}
+			pubkeys[strings.ToLower(deposit.Pubkey)] = struct{}{}
+			if uint64(len(pubkeys)) > s.maxGenesisValidators {
+				return fmt.Errorf(
+					"%w: genesis exceeds the maximum of %d",
+					errTooManyGenesisValidators, s.maxGenesisValidators,
+				)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// expectDelim consumes the next token of the decoder, which must be the given
+// delimiter.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("invalid beacon genesis: expected %v, got %v",
+			delim, token)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisValidatorsMiddleware is a genesisStateMiddleware whose beacon
+// genesis is a list of validator pubkeys, each of which creates a validator.
+type genesisValidatorsMiddleware struct {
+	genesisStateMiddleware
+}
+
+func (m *genesisValidatorsMiddleware) InitGenesis(
+	ctx context.Context, bz []byte,
+) (transition.ValidatorUpdates, error) {
+	var pubkeys []crypto.BLSPubkey
+	if err := json.Unmarshal(bz, &pubkeys); err != nil {
+		return nil, err
+	}
+	if _, err := m.genesisStateMiddleware.InitGenesis(ctx, bz); err != nil {
+		return nil, err
+	}
+
+	updates := make(transition.ValidatorUpdates, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		updates[i] = &transition.ValidatorUpdate{
+			Pubkey:           pubkey,
+			EffectiveBalance: 32e9,
+		}
+	}
+	return updates, nil
+}
+
+func TestDryRunGenesis(t *testing.T) {
+	genesis := func(pubkeys ...crypto.BLSPubkey) []byte {
+		bz, err := json.Marshal(map[string]any{"beacon": pubkeys})
+		require.NoError(t, err)
+		return bz
+	}
+	mw := &genesisValidatorsMiddleware{}
+	s := newUninitializedTestService(mw)
+
+	t.Run("valid", func(t *testing.T) {
+		count, root, err := s.DryRunGenesis(
+			genesis(crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x02}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.NotEqual(t, common.Root{}, root)
+
+		// The same genesis always results in the same state.
+		_, again, err := s.DryRunGenesis(
+			genesis(crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x02}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, root, again)
+
+		// A different genesis results in a different state.
+		count, other, err := s.DryRunGenesis(
+			genesis(crypto.BLSPubkey{0x01}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.NotEqual(t, root, other)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		for _, bz := range [][]byte{
+			[]byte(`{"beacon":`),
+			[]byte(`{"beacon":{"deposits":[]}}`),
+		} {
+			_, _, err := s.DryRunGenesis(bz)
+			require.Error(t, err)
+		}
+	})
+
+	// The state of the node is left untouched, such that it can still be
+	// initialized.
+	require.Zero(t, s.LastBlockHeight())
+	require.False(t, s.CommitMultiStore().CacheMultiStore().
+		GetKVStore(testStoreKey).Has(genesisKey))
+	initGenesisCalls := mw.initGenesisCalls
+
+	resp, err := s.InitChain(
+		context.Background(), &cmtabci.InitChainRequest{
+			ChainId:       testChainID,
+			InitialHeight: 1,
+			AppStateBytes: genesis(crypto.BLSPubkey{0x01}),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Validators, 1)
+	require.Equal(t, initGenesisCalls+1, mw.initGenesisCalls)
+}
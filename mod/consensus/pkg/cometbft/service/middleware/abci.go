@@ -165,13 +165,22 @@ func (h *ABCIMiddleware[
 }
 
 // handleBuiltBeaconBlockAndSidecars gossips the built beacon block and blob
-// sidecars to the network.
+// sidecars to the network, failing if the sidecars do not carry the header
+// of the block, as they would then be rejected by every other validator.
 func (h *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, _, _,
 ]) handleBuiltBeaconBlockAndSidecars(
 	bb BeaconBlockT,
 	sc BlobSidecarsT,
 ) ([]byte, []byte, error) {
+	root, err := bb.ComputeBlockRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = sc.ValidateBlockRoot(root); err != nil {
+		return nil, nil, err
+	}
+
 	bbBz, bbErr := bb.MarshalSSZ()
 	if bbErr != nil {
 		return nil, nil, bbErr
@@ -188,12 +197,14 @@ func (h *ABCIMiddleware[
 /* -------------------------------------------------------------------------- */
 
 // ProcessProposal processes the proposal for the ABCI middleware.
-// It handles both the beacon block and blob sidecars concurrently.
+// It handles both the beacon block and blob sidecars concurrently, rejecting
+// a beacon block which is not of the given slot.
 func (h *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, _, _,
 ]) ProcessProposal(
 	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
+	slot math.Slot,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	var (
 		err              error
@@ -221,11 +232,16 @@ func (h *ABCIMiddleware[
 	// Request the beacon block.
 	if blk, err = encoding.
 		UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
-		req, 0, h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
+		req, 0, h.chainSpec.ActiveForkVersionForSlot(slot),
 	); err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
+	// reject a beacon block which is not of the slot of the proposal.
+	if err = verifySlot(blk, slot); err != nil {
+		return h.createProcessProposalResponse(err)
+	}
+
 	// notify that the beacon block has been received.
 	if err = h.dispatcher.Publish(
 		async.NewEvent(ctx, async.BeaconBlockReceived, blk),
@@ -241,6 +257,15 @@ func (h *ABCIMiddleware[
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
+	// reject sidecars which do not belong to the proposed block.
+	root, err := blk.ComputeBlockRoot()
+	if err != nil {
+		return h.createProcessProposalResponse(err)
+	}
+	if err = sidecars.ValidateBlockRoot(root); err != nil {
+		return h.createProcessProposalResponse(err)
+	}
+
 	// notify that the sidecars have been received.
 	if err = h.dispatcher.Publish(
 		async.NewEvent(ctx, async.SidecarsReceived, sidecars),
@@ -308,11 +333,12 @@ func (*ABCIMiddleware[
 /*                                FinalizeBlock                               */
 /* -------------------------------------------------------------------------- */
 
-// EndBlock returns the validator set updates from the beacon state.
+// EndBlock returns the validator set updates from the beacon state, failing
+// if the beacon block is not of the given slot.
 func (h *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, _, _,
 ]) FinalizeBlock(
-	ctx context.Context, req *cmtabci.FinalizeBlockRequest,
+	ctx context.Context, req *cmtabci.FinalizeBlockRequest, slot math.Slot,
 ) (transition.ValidatorUpdates, error) {
 	var (
 		err              error
@@ -333,13 +359,28 @@ func (h *ABCIMiddleware[
 		req,
 		BeaconBlockTxIndex,
 		BlobSidecarsTxIndex,
-		h.chainSpec.ActiveForkVersionForSlot(
-			math.Slot(req.Height),
-		))
+		h.chainSpec.ActiveForkVersionForSlot(slot),
+	)
 	if err != nil {
 		// If we don't have a block, we can't do anything.
 		return nil, nil
 	}
+	if err = verifySlot(blk, slot); err != nil {
+		return nil, err
+	}
+
+	// sidecars which do not belong to the block must not be persisted.
+	root, err := blk.ComputeBlockRoot()
+	if err != nil {
+		return nil, err
+	}
+	if err = blobs.ValidateBlockRoot(root); err != nil {
+		h.logger.Error(
+			"Discarding blob sidecars of finalized block",
+			"reason", err,
+		)
+		blobs = blobs.Empty()
+	}
 
 	// notify that the final beacon block has been received.
 	if err = h.dispatcher.Publish(
@@ -373,3 +414,65 @@ func (h *ABCIMiddleware[
 		return event.Data(), event.Error()
 	}
 }
+
+// ReplayFinalizeBlock runs the state transition of the block of the request
+// again, without any of the other effects of finalizing it, and returns the
+// resulting validator updates.
+func (h *ABCIMiddleware[
+	BeaconBlockT, BlobSidecarsT, _, _,
+]) ReplayFinalizeBlock(
+	ctx context.Context, req *cmtabci.FinalizeBlockRequest, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	awaitCtx, cancel := context.WithTimeout(ctx, AwaitTimeout)
+	defer cancel()
+	// flush the channel to ensure that we are not handling old data.
+	if numMsgs := async.ClearChan(h.subReplayValidatorUpdates); numMsgs > 0 {
+		h.logger.Error(
+			"WARNING: messages remaining in replay validator updates channel",
+			"num_msgs", numMsgs)
+	}
+
+	// The block is decoded as on FinalizeBlock, such that a block it skips
+	// is skipped here too.
+	blk, _, err := encoding.
+		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](
+		req,
+		BeaconBlockTxIndex,
+		BlobSidecarsTxIndex,
+		h.chainSpec.ActiveForkVersionForSlot(slot),
+	)
+	if err != nil {
+		return nil, nil
+	}
+	if err = verifySlot(blk, slot); err != nil {
+		return nil, err
+	}
+
+	// notify that the beacon block to replay has been received.
+	if err = h.dispatcher.Publish(
+		async.NewEvent(ctx, async.ReplayBeaconBlockReceived, blk),
+	); err != nil {
+		return nil, err
+	}
+
+	// wait for the replayed validator updates.
+	select {
+	case <-awaitCtx.Done():
+		return nil, ErrFinalValidatorUpdatesTimeout(awaitCtx.Err())
+	case event := <-h.subReplayValidatorUpdates:
+		return event.Data(), event.Error()
+	}
+}
+
+// verifySlot returns ErrUnexpectedSlot unless the beacon block is of the
+// slot derived for the height of the request carrying it.
+func verifySlot[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	blk BeaconBlockT, slot math.Slot,
+) error {
+	if blk.GetSlot() != slot {
+		return errors.Wrapf(
+			ErrUnexpectedSlot, "expected %d, got %d", slot, blk.GetSlot(),
+		)
+	}
+	return nil
+}
@@ -27,6 +27,10 @@ var (
 	// ErrUnexpectedEvent is returned when an unexpected event is encountered.
 	ErrUnexpectedEvent = errors.New("unexpected event")
 
+	// ErrUnexpectedSlot is returned when a block is not of the slot derived
+	// for its height.
+	ErrUnexpectedSlot = errors.New("block of unexpected slot")
+
 	ErrInitGenesisTimeout = func(errTimeout error) error {
 		return errors.Wrapf(errTimeout,
 			"A timeout occurred while waiting for genesis data processing",
@@ -58,6 +58,9 @@ type ABCIMiddleware[
 	// subFinalValidatorUpdates is the channel to hold
 	// FinalValidatorUpdatesProcessed events.
 	subFinalValidatorUpdates chan async.Event[validatorUpdates]
+	// subReplayValidatorUpdates is the channel to hold
+	// ReplayValidatorUpdatesProcessed events.
+	subReplayValidatorUpdates chan async.Event[validatorUpdates]
 }
 
 // NewABCIMiddleware creates a new instance of the Handler struct.
@@ -87,6 +90,9 @@ func NewABCIMiddleware[
 		subBBVerified:            make(chan async.Event[BeaconBlockT]),
 		subSCVerified:            make(chan async.Event[BlobSidecarsT]),
 		subFinalValidatorUpdates: make(chan async.Event[validatorUpdates]),
+		subReplayValidatorUpdates: make(
+			chan async.Event[validatorUpdates],
+		),
 	}
 }
 
@@ -125,6 +131,11 @@ func (am *ABCIMiddleware[_, _, _, _]) Start(
 	); err != nil {
 		return err
 	}
+	if err = am.dispatcher.Subscribe(
+		async.ReplayValidatorUpdatesProcessed, am.subReplayValidatorUpdates,
+	); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23,7 +23,9 @@ package middleware
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -33,6 +35,10 @@ type BeaconBlock[SelfT any] interface {
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	NewFromSSZ([]byte, uint32) (SelfT, error)
+	// ComputeBlockRoot returns the canonical root of the block, as
+	// referenced by the parent root of the next block.
+	ComputeBlockRoot() (common.Root, error)
+	GetSlot() math.Slot
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -44,6 +50,9 @@ type TelemetrySink interface {
 type BlobSidecars[T any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[T]
+	// ValidateBlockRoot checks that every sidecar carries the header of the
+	// block with the given root.
+	ValidateBlockRoot(common.Root) error
 }
 
 type validatorUpdates = transition.ValidatorUpdates
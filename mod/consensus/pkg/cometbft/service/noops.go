@@ -26,13 +26,10 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 )
 
-func (Service[_]) Query(
-	context.Context,
-	*abci.QueryRequest,
-) (*abci.QueryResponse, error) {
-	return &abci.QueryResponse{}, nil
-}
-
+// State sync is not supported, hence no snapshots are listed, served or
+// restored. Blob sidecars are kept by the availability store on the file
+// system rather than in the multistore, such that a snapshot of the
+// multistore would not include them either.
 func (Service[_]) ListSnapshots(
 	context.Context,
 	*abci.ListSnapshotsRequest,
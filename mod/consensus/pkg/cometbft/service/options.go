@@ -21,8 +21,11 @@
 package cometbft
 
 import (
+	"time"
+
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	storetypes "cosmossdk.io/store/types"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -36,6 +39,37 @@ func SetPruning[
 	return func(bs *Service[LoggerT]) { bs.sm.CommitMultiStore().SetPruning(opts) }
 }
 
+// SetFinalizeWAL returns a Service option function that keeps a write-ahead
+// log of the finalized block at the given path until it is committed, such
+// that a crash in between is detected on restart. An empty path disables the
+// log.
+func SetFinalizeWAL[
+	LoggerT log.AdvancedLogger[LoggerT],
+](path string) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setFinalizeWAL(path) }
+}
+
+// SetVerifyAppHash returns a Service option function that makes the Service
+// replay the state transition of every block on a cache-wrapped copy of the
+// state before finalizing it, failing if the two executions disagree on the
+// state, hence the app hash. It doubles the cost of the state transition and
+// is meant for debugging.
+func SetVerifyAppHash[
+	LoggerT log.AdvancedLogger[LoggerT],
+](verify bool) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setVerifyAppHash(verify) }
+}
+
+// SetInMemoryStore returns a Service option function that makes the Service
+// keep all state in memory, leaving its database untouched. It replaces the
+// multistore, hence must precede the options configuring it. It is meant for
+// tests driving the ABCI lifecycle.
+func SetInMemoryStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+]() func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setInMemoryStore() }
+}
+
 // SetMinRetainBlocks returns a Service option function that sets the minimum
 // block retention height value when determining which heights to prune during
 // ABCI Commit.
@@ -45,6 +79,103 @@ func SetMinRetainBlocks[
 	return func(bs *Service[LoggerT]) { bs.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetRetainCatchUpBlocks returns a Service option function that sets the
+// maximum number of blocks the retain height may advance by per commit, which
+// bounds the pruning of the blocks retained before pruning was enabled.
+func SetRetainCatchUpBlocks[
+	LoggerT log.AdvancedLogger[LoggerT],
+](retainCatchUpBlocks uint64) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) {
+		bs.setRetainCatchUpBlocks(retainCatchUpBlocks)
+	}
+}
+
+// SetBlockStore returns a Service option function that sets the source of the
+// lowest height retained, from which the retain height catches up. It
+// defaults to the block store of the CometBFT node.
+func SetBlockStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+](blockStore BlockStore) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setBlockStore(blockStore) }
+}
+
+// SetValidatorSetStore returns a Service option function that sets the source
+// of the validator sets from which the proposer duties are derived. It
+// defaults to the state store of the CometBFT node.
+func SetValidatorSetStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+](validatorSets ValidatorSetStore) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) {
+		bs.setValidatorSetStore(validatorSets)
+	}
+}
+
+// SetMaxReorgDepth returns a Service option function that sets the maximum
+// number of blocks the parent of a proposal may lag behind the last committed
+// block before the proposal is rejected in ProcessProposal. CometBFT finalizes
+// every block and only proposes at the height after the last committed one,
+// so this only guards against a misbehaving consensus engine, not reorgs.
+func SetMaxReorgDepth[
+	LoggerT log.AdvancedLogger[LoggerT],
+](maxReorgDepth uint64) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setMaxReorgDepth(maxReorgDepth) }
+}
+
+// SetProcessProposalBudgetPercent returns a Service option function that sets
+// the percentage of the slot duration ProcessProposal may spend verifying a
+// proposal before rejecting it.
+func SetProcessProposalBudgetPercent[
+	LoggerT log.AdvancedLogger[LoggerT],
+](percent uint64) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) {
+		bs.setProcessProposalBudgetPercent(percent)
+	}
+}
+
+// SetMinPeers returns a Service option function that sets the number of peers
+// the node must be connected to before it proposes or accepts proposals.
+func SetMinPeers[
+	LoggerT log.AdvancedLogger[LoggerT],
+](minPeers uint64) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setMinPeers(minPeers) }
+}
+
+// SetPeerCounter returns a Service option function that sets the source of
+// the peer count checked against the minimum number of peers. It defaults to
+// the peers of the CometBFT node.
+func SetPeerCounter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](counter PeerCounter) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setPeerCounter(counter) }
+}
+
+// SetDispatcher returns a Service option function that sets the dispatcher
+// through which a BlockFinalized event is published once each block is
+// committed, as the async.BlockFinalized event.
+func SetDispatcher[
+	LoggerT log.AdvancedLogger[LoggerT],
+](dispatcher asynctypes.EventDispatcher) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setDispatcher(dispatcher) }
+}
+
+// SetSlotClock returns a Service option function that sets the clock deriving
+// the time of slots. It defaults to the wall clock, starting slots of the
+// duration of the chain spec at the genesis time.
+func SetSlotClock[
+	LoggerT log.AdvancedLogger[LoggerT],
+](clock SlotClock) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setSlotClock(clock) }
+}
+
+// SetSkipEmptySlots returns a Service option function that sets whether the
+// slot of a block is derived from its time, such that the slots in which no
+// block was produced are skipped, rather than equal to its height.
+func SetSkipEmptySlots[
+	LoggerT log.AdvancedLogger[LoggerT],
+](skip bool) func(*Service[LoggerT]) {
+	return func(bs *Service[LoggerT]) { bs.setSkipEmptySlots(skip) }
+}
+
 // SetIAVLCacheSize provides a Service option function that sets the size of
 // IAVL cache.
 func SetIAVLCacheSize[
@@ -81,3 +212,63 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetChainIDPrefix returns a Service option function that makes InitChain
+// accept any chain ID with the given prefix. It must only be used on devnets.
+func SetChainIDPrefix[
+	LoggerT log.AdvancedLogger[LoggerT],
+](prefix string) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setChainIDPrefix(prefix) }
+}
+
+// SetMaxGenesisValidators returns a Service option function that sets the
+// maximum number of validators the genesis may create.
+func SetMaxGenesisValidators[
+	LoggerT log.AdvancedLogger[LoggerT],
+](maxValidators uint64) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setMaxGenesisValidators(maxValidators) }
+}
+
+// SetGenesisChecksum returns a Service option function that makes InitChain
+// reject app state bytes whose SHA-256 differs from the given checksum. An
+// empty checksum disables the check.
+func SetGenesisChecksum[
+	LoggerT log.AdvancedLogger[LoggerT],
+](checksum []byte) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setGenesisChecksum(checksum) }
+}
+
+// SetCommitRetries returns a Service option function that sets the number of
+// times a failed commit is retried before the node halts.
+func SetCommitRetries[
+	LoggerT log.AdvancedLogger[LoggerT],
+](retries uint64) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setCommitRetries(retries) }
+}
+
+// SetCommitRetryBackoff returns a Service option function that sets the time
+// waited before the first retry of a failed commit, which doubles after each
+// retry.
+func SetCommitRetryBackoff[
+	LoggerT log.AdvancedLogger[LoggerT],
+](backoff time.Duration) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setCommitRetryBackoff(backoff) }
+}
+
+// SetReuseProposalState returns a Service option function that makes the
+// Service finalize the proposal it accepted last on the state it was verified
+// on, such that the state transitioned while verifying it is reused.
+func SetReuseProposalState[
+	LoggerT log.AdvancedLogger[LoggerT],
+](reuse bool) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setReuseProposalState(reuse) }
+}
+
+// SetQueryRateLimits returns a Service option function that limits the rate
+// of queries of every source with the limit of its class. Sources of a class
+// without a limit are not limited.
+func SetQueryRateLimits[
+	LoggerT log.AdvancedLogger[LoggerT],
+](limits map[string]QueryRateLimit) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setQueryRateLimits(limits) }
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ErrProposerDutiesUnavailable is returned by ProposerDuties when the
+// proposers of the requested epoch cannot be derived.
+var ErrProposerDutiesUnavailable = errors.New("proposer duties unavailable")
+
+// ProposerDuty is the duty of a validator to propose the block of a slot.
+type ProposerDuty struct {
+	// Slot is the slot of the block.
+	Slot math.Slot
+	// Address is the CometBFT address of the validator proposing the block.
+	Address []byte
+}
+
+// ProposerDuties returns the proposer of every slot of the given epoch, as
+// served by /eth/v1/validator/duties/proposer/{epoch}. Proposers are those of
+// the first round of every height, selected by CometBFT from the proposer
+// priorities of its validator set. The validator sets are known up to the
+// height after next, later heights are derived from the last one as if it
+// did not change, hence only the current epoch and the next one are served.
+func (s *Service[_]) ProposerDuties(
+	epoch math.Epoch,
+) ([]ProposerDuty, error) {
+	// Slots without a block are skipped, such that the height of later
+	// slots is not known in advance.
+	if s.skipEmptySlots {
+		return nil, errors.Wrap(
+			ErrProposerDutiesUnavailable, "empty slots are skipped",
+		)
+	}
+	if s.validatorSets == nil || s.slotsPerEpoch == 0 {
+		return nil, errors.Wrap(
+			ErrProposerDutiesUnavailable, "node not started",
+		)
+	}
+
+	var (
+		lastHeight = s.LastBlockHeight()
+		//#nosec:G115 // heights are not negative.
+		current = math.Epoch(uint64(lastHeight+1) / s.slotsPerEpoch)
+	)
+	if epoch < current || epoch > current+1 {
+		return nil, errors.Wrapf(
+			ErrProposerDutiesUnavailable,
+			"epoch: %d, current epoch: %d", epoch, current,
+		)
+	}
+
+	//#nosec:G115 // epochs of heights do not overflow.
+	var (
+		start = max(int64(epoch.Unwrap()*s.slotsPerEpoch), s.initialHeight, 1)
+		end   = int64((epoch.Unwrap() + 1) * s.slotsPerEpoch)
+		known = lastHeight + 2
+	)
+	duties := make([]ProposerDuty, 0, s.slotsPerEpoch)
+	var vals *cmttypes.ValidatorSet
+	for height := start; height < end; height++ {
+		if height <= known || vals == nil {
+			var err error
+			if vals, err = s.validatorSets.LoadValidators(
+				min(height, known),
+			); err != nil {
+				return nil, err
+			}
+			if height > known {
+				//#nosec:G115 // within the epochs served.
+				vals.IncrementProposerPriority(int32(height - known))
+			}
+		} else {
+			vals.IncrementProposerPriority(1)
+		}
+
+		duties = append(duties, ProposerDuty{
+			//#nosec:G115 // heights are not negative.
+			Slot:    math.Slot(height),
+			Address: vals.GetProposer().Address,
+		})
+	}
+	return duties, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testValidatorSetStore is a ValidatorSetStore of the validator sets of the
+// heights it holds.
+type testValidatorSetStore map[int64]*cmttypes.ValidatorSet
+
+func (s testValidatorSetStore) LoadValidators(
+	height int64,
+) (*cmttypes.ValidatorSet, error) {
+	vals, ok := s[height]
+	if !ok {
+		return nil, ErrProposerDutiesUnavailable
+	}
+	return vals.Copy(), nil
+}
+
+func TestProposerDuties(t *testing.T) {
+	newValidator := func(power int64) *cmttypes.Validator {
+		return cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), power)
+	}
+
+	// The validator sets up to the height after next, the last ones of
+	// which include a validator added at height 6.
+	var (
+		vals  = []*cmttypes.Validator{newValidator(10), newValidator(20)}
+		store = make(testValidatorSetStore)
+		set   = cmttypes.NewValidatorSet(vals)
+	)
+	for height := int64(1); height <= 7; height++ {
+		if height == 6 {
+			vals = append(vals, newValidator(30))
+			require.NoError(t, set.UpdateWithChangeSet(vals[2:]))
+		}
+		store[height] = set.Copy()
+		set.IncrementProposerPriority(1)
+	}
+
+	s := newTestService(
+		t, &testMiddleware{},
+		SetValidatorSetStore[*testLogger](store),
+	)
+	s.slotsPerEpoch = 4
+	commitBlocks(t, s, 5)
+
+	// The proposers of the heights known are those of their validator set,
+	// those of later heights are selected as if the last one did not change.
+	for epoch, heights := range map[math.Epoch][]int64{
+		1: {4, 5, 6, 7},
+		2: {8, 9, 10, 11},
+	} {
+		duties, err := s.ProposerDuties(epoch)
+		require.NoError(t, err)
+		require.Len(t, duties, len(heights))
+		for i, height := range heights {
+			expected := store[min(height, 7)].Copy()
+			if height > 7 {
+				expected.IncrementProposerPriority(int32(height - 7))
+			}
+			require.Equal(t, math.Slot(height), duties[i].Slot)
+			require.Equal(
+				t, []byte(expected.GetProposer().Address), duties[i].Address,
+			)
+		}
+	}
+
+	// Past epochs, and epochs past the next one, are not served.
+	for _, epoch := range []math.Epoch{0, 3} {
+		_, err := s.ProposerDuties(epoch)
+		require.ErrorIs(t, err, ErrProposerDutiesUnavailable)
+	}
+
+	// Nor are any epochs once empty slots are skipped.
+	s.skipEmptySlots = true
+	_, err := s.ProposerDuties(1)
+	require.ErrorIs(t, err, ErrProposerDutiesUnavailable)
+}
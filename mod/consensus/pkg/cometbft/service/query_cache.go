@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// queryContextCacheSize is the number of recently loaded versions of the
+// state that are kept for the query contexts to branch.
+const queryContextCacheSize = 16
+
+// queryContextCache is an LRU of the versions of the committed state loaded
+// for queries, keyed by height. Loading a version is the costly part of
+// creating a query context, hence every query context branches the cached
+// version instead, such that the writes of a query are never seen by the
+// others at the same height.
+type queryContextCache struct {
+	contexts *lru.Cache[int64, storetypes.CacheMultiStore]
+}
+
+// newQueryContextCache creates a new queryContextCache holding up to size
+// versions.
+func newQueryContextCache(size int) *queryContextCache {
+	contexts, err := lru.New[int64, storetypes.CacheMultiStore](size)
+	if err != nil {
+		panic(err)
+	}
+	return &queryContextCache{contexts: contexts}
+}
+
+// get returns the cached version of the state at the given height, if any.
+func (c *queryContextCache) get(
+	height int64,
+) (storetypes.CacheMultiStore, bool) {
+	return c.contexts.Get(height)
+}
+
+// add caches the version of the state at the given height.
+func (c *queryContextCache) add(
+	height int64, ms storetypes.CacheMultiStore,
+) {
+	c.contexts.Add(height, ms)
+}
+
+// onCommit evicts the versions of every height that may be pruned by the
+// given pruning options once latestHeight has been committed.
+func (c *queryContextCache) onCommit(
+	latestHeight int64,
+	opts pruningtypes.PruningOptions,
+) {
+	if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+		return
+	}
+	//#nosec:G701 // keep recent is bounded by the chain height in practice.
+	retainFrom := latestHeight - int64(opts.KeepRecent)
+	for _, height := range c.contexts.Keys() {
+		if height < retainFrom {
+			c.contexts.Remove(height)
+		}
+	}
+}
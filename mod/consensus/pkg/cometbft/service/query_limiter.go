@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// maxQuerySources is the number of sources whose token buckets are tracked.
+// Once reached, the buckets which refilled completely are dropped, as a new
+// bucket is no different. If none did, new sources of a class share the
+// overflow bucket of their class, such that a flood of sources neither grows
+// the buckets unbounded nor resets the buckets of the sources still limited.
+const maxQuerySources = 4096
+
+const (
+	// DefaultQuerySourceClass is the class of queries whose context carries
+	// no source.
+	DefaultQuerySourceClass = "default"
+	// PeerQuerySourceClass is the class of the queries CometBFT makes to
+	// filter its peers, whose source is the peer.
+	PeerQuerySourceClass = "peer"
+)
+
+// peerFilterQueryPrefixes are the path prefixes of the queries CometBFT makes
+// to filter a peer by its address or ID, followed by that address or ID.
+//
+//nolint:gochecknoglobals // constant.
+var peerFilterQueryPrefixes = []string{"/p2p/filter/addr/", "/p2p/filter/id/"}
+
+var (
+	// ErrQueryRateLimited is returned by Query when the source of a query has
+	// exhausted its rate limit. It is meant to be surfaced to RPC clients as
+	// a 429 Too Many Requests.
+	ErrQueryRateLimited = errors.New("query rate limit exceeded")
+
+	// ErrInvalidQueryRateLimits is returned when query rate limits fail to
+	// parse.
+	ErrInvalidQueryRateLimits = errors.New("invalid query rate limits")
+)
+
+// QueryRateLimit is the token bucket limiting the queries of every source of
+// a class.
+type QueryRateLimit struct {
+	// Rate is the number of queries per second a source is allowed to make.
+	Rate float64
+	// Burst is the maximum number of queries a source may make at once.
+	Burst uint64
+}
+
+// ParseQueryRateLimits parses comma separated class=rate:burst limits, e.g.
+// "peer=10:20,default=100:200". An empty string sets no limits.
+func ParseQueryRateLimits(limits string) (map[string]QueryRateLimit, error) {
+	parsed := make(map[string]QueryRateLimit)
+	for _, entry := range strings.Split(limits, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		class, limit, ok := strings.Cut(entry, "=")
+		if !ok || class == "" {
+			return nil, errors.Wrapf(ErrInvalidQueryRateLimits, "%q", entry)
+		}
+		rate, burst, ok := strings.Cut(limit, ":")
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidQueryRateLimits, "%q", entry)
+		}
+		var (
+			l   QueryRateLimit
+			err error
+		)
+		l.Rate, err = strconv.ParseFloat(rate, 64)
+		if err != nil || l.Rate < 0 {
+			return nil, errors.Wrapf(ErrInvalidQueryRateLimits, "%q", entry)
+		}
+		if l.Burst, err = strconv.ParseUint(burst, 10, 64); err != nil {
+			return nil, errors.Wrapf(ErrInvalidQueryRateLimits, "%q", entry)
+		}
+		parsed[class] = l
+	}
+	return parsed, nil
+}
+
+// QuerySource identifies the caller of a query. Sources of the same class
+// are limited independently of each other by the limit of their class.
+type QuerySource struct {
+	Class string
+	ID    string
+}
+
+// querySourceKey is the context key of the QuerySource of a query.
+type querySourceKey struct{}
+
+// ContextWithQuerySource returns a copy of ctx carrying the source of the
+// query it is passed to.
+func ContextWithQuerySource(
+	ctx context.Context,
+	source QuerySource,
+) context.Context {
+	return context.WithValue(ctx, querySourceKey{}, source)
+}
+
+// querySourceFromContext returns the source carried by ctx, defaulting to
+// the anonymous source of DefaultQuerySourceClass.
+func querySourceFromContext(ctx context.Context) QuerySource {
+	if source, ok := ctx.Value(querySourceKey{}).(QuerySource); ok {
+		return source
+	}
+	return QuerySource{Class: DefaultQuerySourceClass}
+}
+
+// contextWithRequestSource returns ctx carrying the source of the request,
+// unless it carries a source already. The peer filter queries of CometBFT
+// come from the peer they filter, other requests have no source.
+func contextWithRequestSource(
+	ctx context.Context,
+	req *cmtabci.QueryRequest,
+) context.Context {
+	if _, ok := ctx.Value(querySourceKey{}).(QuerySource); ok || req == nil {
+		return ctx
+	}
+	for _, prefix := range peerFilterQueryPrefixes {
+		if peer, ok := strings.CutPrefix(req.GetPath(), prefix); ok {
+			return ContextWithQuerySource(ctx, QuerySource{
+				Class: PeerQuerySourceClass, ID: peer,
+			})
+		}
+	}
+	return ctx
+}
+
+// tokenBucket holds the queries a source may still make.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accrued since the bucket was last used, returning
+// whether the bucket is full.
+func (b *tokenBucket) refill(limit QueryRateLimit, now time.Time) bool {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(
+			float64(limit.Burst),
+			b.tokens+elapsed.Seconds()*limit.Rate,
+		)
+		b.last = now
+	}
+	return b.tokens >= float64(limit.Burst)
+}
+
+// queryRateLimiter limits the rate of queries per source with a token bucket
+// per source. Sources of a class without a limit are not limited. A nil
+// queryRateLimiter does not limit queries.
+type queryRateLimiter struct {
+	mu      sync.Mutex
+	limits  map[string]QueryRateLimit
+	buckets map[QuerySource]*tokenBucket
+	// overflow holds the bucket per class shared by the sources which are
+	// not tracked once maxSources is reached.
+	overflow   map[string]*tokenBucket
+	maxSources int
+	now        func() time.Time
+}
+
+// newQueryRateLimiter creates a new queryRateLimiter applying the given
+// limits per source class. An empty set of limits disables the limiter.
+func newQueryRateLimiter(
+	limits map[string]QueryRateLimit,
+) *queryRateLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	return &queryRateLimiter{
+		limits:     limits,
+		buckets:    make(map[QuerySource]*tokenBucket),
+		overflow:   make(map[string]*tokenBucket),
+		maxSources: maxQuerySources,
+		now:        time.Now,
+	}
+}
+
+// allow takes a token from the bucket of the source of the query, returning
+// ErrQueryRateLimited if the bucket is empty.
+func (l *queryRateLimiter) allow(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	source := querySourceFromContext(ctx)
+	limit, ok := l.limits[source.Class]
+	if !ok {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	bucket := l.bucket(source, limit, now)
+	bucket.refill(limit, now)
+	if bucket.tokens < 1 {
+		return errors.Wrapf(
+			ErrQueryRateLimited, "source %s of class %s",
+			source.ID, source.Class,
+		)
+	}
+	bucket.tokens--
+	return nil
+}
+
+// bucket returns the bucket of the source, tracking a full one for a new
+// source if there is room for it, or else the overflow bucket of its class.
+func (l *queryRateLimiter) bucket(
+	source QuerySource,
+	limit QueryRateLimit,
+	now time.Time,
+) *tokenBucket {
+	if bucket, ok := l.buckets[source]; ok {
+		return bucket
+	}
+
+	// Drop the buckets which refilled, as they are no different from the
+	// full bucket of a new source.
+	if len(l.buckets) >= l.maxSources {
+		for s, bucket := range l.buckets {
+			if bucket.refill(l.limits[s.Class], now) {
+				delete(l.buckets, s)
+			}
+		}
+	}
+
+	full := &tokenBucket{tokens: float64(limit.Burst), last: now}
+	if len(l.buckets) < l.maxSources {
+		l.buckets[source] = full
+		return full
+	}
+	if bucket, ok := l.overflow[source.Class]; ok {
+		return bucket
+	}
+	l.overflow[source.Class] = full
+	return full
+}
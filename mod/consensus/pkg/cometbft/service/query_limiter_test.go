@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryRateLimit(t *testing.T) {
+	const peerClass = PeerQuerySourceClass
+	s := newUninitializedTestService(
+		&testMiddleware{},
+		SetQueryRateLimits[*testLogger](map[string]QueryRateLimit{
+			peerClass: {Rate: 1, Burst: 3},
+		}),
+	)
+	now := time.Unix(1_700_000_000, 0)
+	s.queryRateLimiter.now = func() time.Time { return now }
+
+	query := func(source QuerySource) error {
+		_, err := s.Query(
+			ContextWithQuerySource(context.Background(), source),
+			&cmtabci.QueryRequest{},
+		)
+		return err
+	}
+	abusive := QuerySource{Class: peerClass, ID: "peer-1"}
+	other := QuerySource{Class: peerClass, ID: "peer-2"}
+
+	// The abusive peer exhausts its bucket.
+	for range 3 {
+		require.NoError(t, query(abusive))
+	}
+	require.ErrorIs(t, query(abusive), ErrQueryRateLimited)
+
+	// Another peer of the same class is unaffected.
+	for range 3 {
+		require.NoError(t, query(other))
+	}
+	require.ErrorIs(t, query(other), ErrQueryRateLimited)
+
+	// Sources of a class without a limit, and queries without a source, are
+	// not limited.
+	for range 10 {
+		require.NoError(t, query(QuerySource{Class: "operator"}))
+		_, err := s.Query(context.Background(), &cmtabci.QueryRequest{})
+		require.NoError(t, err)
+	}
+
+	// The bucket refills at the configured rate.
+	now = now.Add(time.Second)
+	require.NoError(t, query(abusive))
+	require.ErrorIs(t, query(abusive), ErrQueryRateLimited)
+}
+
+func TestQueryRateLimitPeerFilter(t *testing.T) {
+	s := newUninitializedTestService(
+		&testMiddleware{},
+		SetQueryRateLimits[*testLogger](map[string]QueryRateLimit{
+			PeerQuerySourceClass: {Rate: 1, Burst: 2},
+		}),
+	)
+	now := time.Unix(1_700_000_000, 0)
+	s.queryRateLimiter.now = func() time.Time { return now }
+
+	query := func(path string) error {
+		_, err := s.Query(
+			context.Background(), &cmtabci.QueryRequest{Path: path},
+		)
+		return err
+	}
+
+	// The peer filter queries of CometBFT are limited per peer.
+	for range 2 {
+		require.NoError(t, query("/p2p/filter/id/peer-1"))
+	}
+	require.ErrorIs(t, query("/p2p/filter/id/peer-1"), ErrQueryRateLimited)
+	for range 2 {
+		require.NoError(t, query("/p2p/filter/addr/127.0.0.1:26656"))
+	}
+	require.ErrorIs(
+		t, query("/p2p/filter/addr/127.0.0.1:26656"), ErrQueryRateLimited,
+	)
+
+	// Other paths have no source, whose class has no limit.
+	for range 10 {
+		require.NoError(t, query("/store/bank/key"))
+	}
+}
+
+func TestQueryRateLimitEviction(t *testing.T) {
+	const maxSources = 8
+	s := newUninitializedTestService(
+		&testMiddleware{},
+		SetQueryRateLimits[*testLogger](map[string]QueryRateLimit{
+			PeerQuerySourceClass: {Rate: 1, Burst: 2},
+		}),
+	)
+	now := time.Unix(1_700_000_000, 0)
+	s.queryRateLimiter.now = func() time.Time { return now }
+	s.queryRateLimiter.maxSources = maxSources
+
+	query := func(id string) error {
+		_, err := s.Query(
+			ContextWithQuerySource(context.Background(), QuerySource{
+				Class: PeerQuerySourceClass, ID: id,
+			}),
+			&cmtabci.QueryRequest{},
+		)
+		return err
+	}
+
+	// The abusive peer exhausts its bucket, then floods the limiter with new
+	// sources, which must not reset its bucket.
+	for range 2 {
+		require.NoError(t, query("abusive"))
+	}
+	require.ErrorIs(t, query("abusive"), ErrQueryRateLimited)
+	var limited int
+	for i := range 4 * maxSources {
+		if err := query(fmt.Sprintf("flood-%d", i)); err != nil {
+			require.ErrorIs(t, err, ErrQueryRateLimited)
+			limited++
+		}
+	}
+	require.Positive(t, limited)
+	require.ErrorIs(t, query("abusive"), ErrQueryRateLimited)
+	require.LessOrEqual(t, len(s.queryRateLimiter.buckets), maxSources)
+
+	// Once the buckets refill, they are dropped to make room for new
+	// sources, which get buckets of their own.
+	now = now.Add(2 * time.Second)
+	for range 2 {
+		require.NoError(t, query("new"))
+	}
+	require.ErrorIs(t, query("new"), ErrQueryRateLimited)
+	require.NoError(t, query("abusive"))
+}
+
+func TestParseQueryRateLimits(t *testing.T) {
+	limits, err := ParseQueryRateLimits(" peer=10:20, default=0.5:1 ")
+	require.NoError(t, err)
+	require.Equal(t, map[string]QueryRateLimit{
+		PeerQuerySourceClass:    {Rate: 10, Burst: 20},
+		DefaultQuerySourceClass: {Rate: 0.5, Burst: 1},
+	}, limits)
+
+	limits, err = ParseQueryRateLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	for _, invalid := range []string{
+		"peer", "=1:1", "peer=1", "peer=x:1", "peer=1:-1", "peer=-1:1",
+	} {
+		_, err = ParseQueryRateLimits(invalid)
+		require.ErrorIs(t, err, ErrInvalidQueryRateLimits, invalid)
+	}
+}
@@ -21,16 +21,21 @@
 package cometbft
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/params"
 	statem "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/state"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
@@ -77,12 +82,136 @@ type Service[
 
 	interBlockCache storetypes.MultiStorePersistentCache
 	paramStore      *params.ConsensusParamsStore
+	queryContexts   *queryContextCache
+
+	// queryRateLimiter limits the rate of queries per source. If nil,
+	// queries are not limited.
+	queryRateLimiter *queryRateLimiter
+
+	// storeTypes holds the type of every store mounted on the multistore.
+	storeTypes map[storetypes.StoreKey]storetypes.StoreType
 
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
 
+	// retainCatchUpBlocks is the maximum number of blocks the retain height
+	// may advance by per commit, such that a backlog of blocks accumulated
+	// while pruning was disabled is pruned over several commits. A value of
+	// 0 disables the bound.
+	retainCatchUpBlocks uint64
+
+	// retainHeight is the retain height returned by the last commit, or 0 if
+	// no blocks have been pruned since the node started.
+	retainHeight int64
+
+	// blockStore tells the lowest height retained. If nil, the block store of
+	// the CometBFT node is used.
+	blockStore BlockStore
+
+	// validatorSets loads the validator sets from which the proposers of
+	// upcoming heights are derived. If nil, the state store of the CometBFT
+	// node is used once it starts.
+	validatorSets ValidatorSetStore
+	// slotsPerEpoch is the number of slots per epoch of the chain spec.
+	slotsPerEpoch uint64
+
+	// maxReorgDepth is the maximum number of blocks that the parent of a
+	// proposal may lag behind the last committed block. A well-behaved
+	// consensus engine never lags, so this only guards against a misbehaving
+	// one. A value of 0 disables the check.
+	maxReorgDepth uint64
+
+	// slotDuration is the target duration of a slot of the chain spec, used
+	// to bound the time spent building a proposal in PrepareProposal. A value
+	// of 0 disables the deadline.
+	slotDuration time.Duration
+
+	// clock derives the time of slots. If nil, the wall clock starting
+	// genesisSlot genesisDelay after genesisTime is used.
+	clock SlotClock
+
+	// genesisSlot is the slot of the genesis state, starting genesisDelay
+	// after genesisTime. The slot of the initial height follows it, one slot
+	// duration later.
+	genesisSlot  math.Slot
+	genesisTime  time.Time
+	genesisDelay time.Duration
+
+	// skipEmptySlots determines whether the slot of a block is derived from
+	// its time, skipping the slots in which no block was produced, rather
+	// than equal to its height. The slot of the block finalized last is then
+	// kept in the store of storeKey, as part of the consensus state.
+	skipEmptySlots bool
+	storeKey       storetypes.StoreKey
+
+	// processProposalBudgetPercent is the percentage of the slot duration
+	// that ProcessProposal may spend verifying a proposal. A value of 0
+	// disables the budget.
+	processProposalBudgetPercent uint64
+
+	// minPeers is the number of peers the node must be connected to before
+	// it participates in consensus, such that it does not propose or vote on
+	// a stale view. A value of 0 disables the check.
+	minPeers uint64
+
+	// peerCounter counts the peers of the node. If nil, the peers of the
+	// CometBFT node are counted.
+	peerCounter PeerCounter
+
 	chainID string
+
+	// chainIDPrefix, if set, makes InitChain accept any chain ID beginning
+	// with it in addition to chainID. It is intended for devnets whose chain
+	// IDs are generated.
+	chainIDPrefix string
+
+	// maxGenesisValidators is the maximum number of validators the genesis
+	// may create. A value of 0 disables the check.
+	maxGenesisValidators uint64
+
+	// genesisChecksum, if set, is the expected SHA-256 of the app state
+	// bytes passed to InitChain.
+	genesisChecksum []byte
+
+	// commitRetries is the number of times a failed commit is retried before
+	// the node halts. A value of 0 disables retries.
+	commitRetries uint64
+
+	// commitRetryBackoff is the time waited before the first retry of a
+	// failed commit. It doubles after each retry.
+	commitRetryBackoff time.Duration
+
+	// wal logs the block finalized last until it is committed. If nil, no
+	// write-ahead log is kept.
+	wal *finalizeWAL
+
+	// verifyAppHash determines whether the state transition of every block
+	// is run twice to verify that it deterministically yields the same app
+	// hash.
+	verifyAppHash bool
+
+	// reuseProposalState determines whether FinalizeBlock runs on the state
+	// of the proposal accepted by ProcessProposal at the same height when it
+	// finalizes that very proposal, such that the state transitioned while
+	// verifying it is reused.
+	reuseProposalState bool
+
+	// acceptedProposalHash is the hash of the proposal accepted by the last
+	// ProcessProposal, if any.
+	acceptedProposalHash []byte
+
+	// walPending is the block recovered from the write-ahead log, which was
+	// finalized but not committed before the node stopped.
+	walPending *walEntry
+
+	// dispatcher publishes a BlockFinalized event for every block committed.
+	// If nil, no event is published.
+	dispatcher asynctypes.EventDispatcher
+
+	// finalized is the event of the block finalized last, published once it
+	// is committed.
+	finalized *BlockFinalized
 }
 
 func NewService[
@@ -102,9 +231,17 @@ func NewService[
 			db,
 			servercmtlog.WrapSDKLogger(logger),
 		),
-		Middleware: middleware,
-		cmtCfg:     cmtCfg,
-		paramStore: params.NewConsensusParamsStore(cs),
+		Middleware:    middleware,
+		storeKey:      storeKey,
+		cmtCfg:        cmtCfg,
+		paramStore:    params.NewConsensusParamsStore(cs),
+		queryContexts: newQueryContextCache(queryContextCacheSize),
+		storeTypes:    make(map[storetypes.StoreKey]storetypes.StoreType),
+		//#nosec:G115 // the delay does not overflow durations in practice.
+		genesisDelay: time.Duration(cs.GenesisDelay()) * time.Second,
+		//#nosec:G115 // the slot does not overflow durations in practice.
+		slotDuration:  time.Duration(cs.SecondsPerSlot()) * time.Second,
+		slotsPerEpoch: cs.SlotsPerEpoch(),
 	}
 
 	s.MountStore(storeKey, storetypes.StoreTypeIAVL)
@@ -122,6 +259,13 @@ func NewService[
 		panic(err)
 	}
 
+	// Detect a block left in between FinalizeBlock and Commit by a crash.
+	if s.wal != nil {
+		if err := s.recoverFinalizeWAL(); err != nil {
+			panic(err)
+		}
+	}
+
 	return s
 }
 
@@ -129,12 +273,26 @@ func NewService[
 func (s *Service[_]) Start(
 	ctx context.Context,
 ) error {
+	// Blocks must not be built on top of a corrupted state.
+	if err := s.VerifyStoreIntegrity(); err != nil {
+		return err
+	}
+
 	cfg := s.cmtCfg
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return err
 	}
 
+	// InitChain is only called on the first start, so the slot clock is
+	// anchored on the genesis file on every start.
+	genDocProvider := GetGenDocProvider(cfg)
+	genDoc, err := genDocProvider()
+	if err != nil {
+		return err
+	}
+	s.setGenesis(genDoc.GenesisDoc.InitialHeight, genDoc.GenesisDoc.GenesisTime)
+
 	s.node, err = node.NewNode(
 		ctx,
 		cfg,
@@ -144,7 +302,7 @@ func (s *Service[_]) Start(
 		),
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
-		GetGenDocProvider(cfg),
+		genDocProvider,
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
@@ -153,6 +311,15 @@ func (s *Service[_]) Start(
 		return err
 	}
 
+	// The RPC environment exposes the state store of the node, from which
+	// the validator sets are loaded.
+	if s.validatorSets == nil {
+		env, err := s.node.ConfigureRPC()
+		if err != nil {
+			return err
+		}
+		s.validatorSets = env.StateStore
+	}
 	return s.node.Start()
 }
 
@@ -200,6 +367,7 @@ func (s *Service[_]) MountStore(
 	typ storetypes.StoreType,
 ) {
 	s.sm.CommitMultiStore().MountStoreWithDB(key, typ, nil)
+	s.storeTypes[key] = typ
 }
 
 // LastBlockHeight returns the last committed block height.
@@ -207,10 +375,172 @@ func (s *Service[_]) LastBlockHeight() int64 {
 	return s.sm.CommitMultiStore().LastCommitID().Version
 }
 
+// LastAppHash returns the app hash of the last committed block.
+func (s *Service[_]) LastAppHash() []byte {
+	return s.sm.CommitMultiStore().LastCommitID().Hash
+}
+
+// VerifyStoreIntegrity verifies that the state loaded from the multistore
+// hashes to the app hash recorded by its latest commit, which an unclean
+// shutdown or a corrupted disk may leave inconsistent. If it fails, the node
+// must not build on the state, but recover it, e.g. by state sync.
+func (s *Service[_]) VerifyStoreIntegrity() error {
+	cms := s.sm.CommitMultiStore()
+	lastCommitID := cms.LastCommitID()
+	if lastCommitID.Version == 0 {
+		// Nothing has been committed yet.
+		return nil
+	}
+
+	if workingHash := cms.WorkingHash(); !bytes.Equal(
+		workingHash, lastCommitID.Hash,
+	) {
+		return fmt.Errorf(
+			"%w: state at height %d hashes to %X, but was committed as %X",
+			errStoreIntegrity, lastCommitID.Version,
+			workingHash, lastCommitID.Hash,
+		)
+	}
+	return nil
+}
+
 func (s *Service[_]) setMinRetainBlocks(minRetainBlocks uint64) {
 	s.minRetainBlocks = minRetainBlocks
 }
 
+func (s *Service[_]) setRetainCatchUpBlocks(retainCatchUpBlocks uint64) {
+	s.retainCatchUpBlocks = retainCatchUpBlocks
+}
+
+func (s *Service[_]) setBlockStore(blockStore BlockStore) {
+	s.blockStore = blockStore
+}
+
+func (s *Service[_]) setValidatorSetStore(validatorSets ValidatorSetStore) {
+	s.validatorSets = validatorSets
+}
+
+// blockStoreBase returns the lowest height retained by the block store.
+func (s *Service[_]) blockStoreBase() int64 {
+	if s.blockStore != nil {
+		return s.blockStore.Base()
+	}
+	if s.node == nil {
+		return 0
+	}
+	return s.node.BlockStore().Base()
+}
+
+func (s *Service[_]) setMaxReorgDepth(maxReorgDepth uint64) {
+	s.maxReorgDepth = maxReorgDepth
+}
+
+func (s *Service[_]) setSlotClock(clock SlotClock) {
+	s.clock = clock
+}
+
+func (s *Service[_]) setSkipEmptySlots(skip bool) {
+	s.skipEmptySlots = skip
+}
+
+// slotClock returns the clock deriving the time of slots.
+func (s *Service[_]) slotClock() SlotClock {
+	if s.clock != nil {
+		return s.clock
+	}
+	return newWallClock(
+		s.genesisSlot, s.genesisTime.Add(s.genesisDelay), s.slotDuration,
+	)
+}
+
+// setGenesis anchors the wall clock on the genesis time of the chain, at the
+// slot preceding its initial height.
+func (s *Service[_]) setGenesis(initialHeight int64, genesisTime time.Time) {
+	//#nosec:G115 // checked for positivity.
+	s.genesisSlot = math.Slot(max(initialHeight, 1) - 1)
+	s.genesisTime = genesisTime
+}
+
+func (s *Service[_]) setProcessProposalBudgetPercent(percent uint64) {
+	s.processProposalBudgetPercent = percent
+}
+
+func (s *Service[_]) setMinPeers(minPeers uint64) {
+	s.minPeers = minPeers
+}
+
+func (s *Service[_]) setDispatcher(dispatcher asynctypes.EventDispatcher) {
+	s.dispatcher = dispatcher
+}
+
+func (s *Service[_]) setPeerCounter(counter PeerCounter) {
+	s.peerCounter = counter
+}
+
+// numPeers returns the number of peers the node is connected to.
+func (s *Service[_]) numPeers() uint64 {
+	if s.peerCounter != nil {
+		return s.peerCounter.NumPeers()
+	}
+	if s.node == nil {
+		return 0
+	}
+	outbound, inbound, _ := s.node.Switch().NumPeers()
+	//#nosec:G701 // peer counts are never negative.
+	return uint64(outbound + inbound)
+}
+
+func (s *Service[_]) setChainIDPrefix(prefix string) {
+	s.chainIDPrefix = prefix
+}
+
+func (s *Service[_]) setMaxGenesisValidators(maxValidators uint64) {
+	s.maxGenesisValidators = maxValidators
+}
+
+func (s *Service[_]) setGenesisChecksum(checksum []byte) {
+	s.genesisChecksum = checksum
+}
+
+func (s *Service[_]) setCommitRetries(retries uint64) {
+	s.commitRetries = retries
+}
+
+func (s *Service[_]) setCommitRetryBackoff(backoff time.Duration) {
+	s.commitRetryBackoff = backoff
+}
+
+func (s *Service[_]) setFinalizeWAL(path string) {
+	if path == "" {
+		s.wal = nil
+		return
+	}
+	s.wal = &finalizeWAL{path: path}
+}
+
+func (s *Service[_]) setVerifyAppHash(verify bool) {
+	s.verifyAppHash = verify
+}
+
+func (s *Service[_]) setReuseProposalState(reuse bool) {
+	s.reuseProposalState = reuse
+}
+
+func (s *Service[_]) setInMemoryStore() {
+	s.sm = statem.NewManager(
+		nil,
+		servercmtlog.WrapSDKLogger(s.logger),
+		statem.WithInMemoryStore(),
+	)
+	for key, typ := range s.storeTypes {
+		s.sm.CommitMultiStore().MountStoreWithDB(key, typ, nil)
+	}
+}
+
+func (s *Service[_]) setQueryRateLimits(limits map[string]QueryRateLimit) {
+	s.queryRateLimiter = newQueryRateLimiter(limits)
+}
+
 func (s *Service[_]) setInterBlockCache(
 	cache storetypes.MultiStorePersistentCache,
 ) {
@@ -220,13 +550,17 @@ func (s *Service[_]) setInterBlockCache(
 // resetState provides a fresh state which can be used to reset
 // prepareProposal/processProposal/finalizeBlock State.
 // A state is explicitly returned to avoid false positives from
-// nilaway tool.
-func (s *Service[LoggerT]) resetState() *state {
-	ms := s.sm.CommitMultiStore().CacheMultiStore()
+// nilaway tool. An error is returned if the committed state cannot be
+// branched, in which case the state must not be used.
+func (s *Service[LoggerT]) resetState() (*state, error) {
+	ms, err := s.sm.CacheMultiStore()
+	if err != nil {
+		return nil, errors.Join(errResetState, err)
+	}
 	return &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(s.logger)),
-	}
+	}, nil
 }
 
 // convertValidatorUpdate abstracts the conversion of a
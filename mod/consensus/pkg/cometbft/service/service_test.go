@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "beacond-test"
+
+// testStoreKey is the key of the store mounted by the test services.
+var testStoreKey = storetypes.NewKVStoreKey("beacon")
+
+// testLogger is a no-op logger that satisfies log.AdvancedLogger.
+type testLogger struct {
+	noop.Logger[*testLogger]
+}
+
+// With returns the logger itself.
+func (l *testLogger) With(...any) *testLogger {
+	return l
+}
+
+// testMiddleware is a MiddlewareI that accepts every proposal and records
+// the calls made to it.
+type testMiddleware struct {
+	// prepareProposalDelay is the time PrepareProposal takes to build a
+	// proposal.
+	prepareProposalDelay time.Duration
+	// processProposalDelay is the time ProcessProposal takes to verify a
+	// proposal.
+	processProposalDelay time.Duration
+	initGenesisCalls     int
+	processProposalCalls int
+	finalizeBlockCalls   int
+	replayCalls          int
+	// validatorUpdates are the validator updates FinalizeBlock returns.
+	validatorUpdates transition.ValidatorUpdates
+}
+
+func (m *testMiddleware) InitGenesis(
+	context.Context, []byte,
+) (transition.ValidatorUpdates, error) {
+	m.initGenesisCalls++
+	return nil, nil
+}
+
+func (m *testMiddleware) PrepareProposal(
+	ctx context.Context,
+	_ *types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo],
+) ([]byte, []byte, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case <-time.After(m.prepareProposalDelay):
+		return []byte("block"), []byte("sidecars"), nil
+	}
+}
+
+func (m *testMiddleware) ProcessProposal(
+	ctx context.Context, _ *cmtabci.ProcessProposalRequest, _ math.Slot,
+) (*cmtabci.ProcessProposalResponse, error) {
+	m.processProposalCalls++
+	select {
+	case <-ctx.Done():
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, ctx.Err()
+	case <-time.After(m.processProposalDelay):
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		}, nil
+	}
+}
+
+func (m *testMiddleware) FinalizeBlock(
+	context.Context, *cmtabci.FinalizeBlockRequest, math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.finalizeBlockCalls++
+	return m.validatorUpdates, nil
+}
+
+func (m *testMiddleware) ReplayFinalizeBlock(
+	context.Context, *cmtabci.FinalizeBlockRequest, math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.replayCalls++
+	return m.validatorUpdates, nil
+}
+
+// withSlotDuration returns a Service option function that sets the slot
+// duration, which the chain spec only sets in whole seconds.
+func withSlotDuration(
+	slotDuration time.Duration,
+) func(*Service[*testLogger]) {
+	return func(s *Service[*testLogger]) { s.slotDuration = slotDuration }
+}
+
+// newTestService creates a Service backed by an in-memory database which has
+// been initialized with an empty genesis.
+func newTestService(
+	t testing.TB,
+	middleware MiddlewareI,
+	opts ...func(*Service[*testLogger]),
+) *Service[*testLogger] {
+	t.Helper()
+	return newTestServiceWithDB(t, dbm.NewMemDB(), middleware, opts...)
+}
+
+// newTestServiceWithDB returns an initialized Service backed by the given
+// database.
+func newTestServiceWithDB(
+	t testing.TB,
+	db dbm.DB,
+	middleware MiddlewareI,
+	opts ...func(*Service[*testLogger]),
+) *Service[*testLogger] {
+	t.Helper()
+	s := newUninitializedTestServiceWithDB(db, middleware, opts...)
+	_, err := s.InitChain(context.Background(), &cmtabci.InitChainRequest{
+		ChainId:       testChainID,
+		InitialHeight: 1,
+		AppStateBytes: []byte(`{"beacon":{}}`),
+	})
+	require.NoError(t, err)
+	return s
+}
+
+// newUninitializedTestService returns a Service on which InitChain has not
+// yet been called.
+func newUninitializedTestService(
+	middleware MiddlewareI,
+	opts ...func(*Service[*testLogger]),
+) *Service[*testLogger] {
+	return newUninitializedTestServiceWithDB(dbm.NewMemDB(), middleware, opts...)
+}
+
+// newUninitializedTestServiceWithDB returns a Service backed by the given
+// database on which InitChain has not yet been called.
+func newUninitializedTestServiceWithDB(
+	db dbm.DB,
+	middleware MiddlewareI,
+	opts ...func(*Service[*testLogger]),
+) *Service[*testLogger] {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			CometValues: cmttypes.DefaultConsensusParams(),
+		},
+	)
+	return NewService(
+		testStoreKey,
+		&testLogger{},
+		db,
+		middleware,
+		cmtcfg.DefaultConfig(),
+		cs,
+		append([]func(*Service[*testLogger]){
+			SetChainID[*testLogger](testChainID),
+		}, opts...)...,
+	)
+}
+
+// commitBlocks finalizes and commits blocks until the given height has been
+// committed.
+func commitBlocks(t testing.TB, s *Service[*testLogger], height int64) {
+	t.Helper()
+	for h := s.LastBlockHeight() + 1; h <= height; h++ {
+		_, err := s.FinalizeBlock(
+			context.Background(),
+			&cmtabci.FinalizeBlockRequest{Height: h},
+		)
+		require.NoError(t, err)
+		_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, height, s.LastBlockHeight())
+}
+
+func TestVerifyStoreIntegrity(t *testing.T) {
+	db := dbm.NewMemDB()
+	s := newUninitializedTestServiceWithDB(db, &heightMiddleware{})
+
+	// An empty store has nothing to verify.
+	require.NoError(t, s.VerifyStoreIntegrity())
+
+	_, err := s.InitChain(context.Background(), &cmtabci.InitChainRequest{
+		ChainId:       testChainID,
+		InitialHeight: 1,
+		AppStateBytes: []byte(`{"beacon":{}}`),
+	})
+	require.NoError(t, err)
+	commitBlocks(t, s, 3)
+	require.NoError(t, s.VerifyStoreIntegrity())
+
+	// The store reloads consistently.
+	s = newUninitializedTestServiceWithDB(db, &heightMiddleware{})
+	require.Equal(t, int64(3), s.LastBlockHeight())
+	require.NoError(t, s.VerifyStoreIntegrity())
+
+	// Corrupt the app hash recorded by the latest commit.
+	commitInfo, err := s.sm.CommitMultiStore().(*rootmulti.Store).
+		GetCommitInfo(3)
+	require.NoError(t, err)
+	for i := range commitInfo.StoreInfos {
+		hash := commitInfo.StoreInfos[i].CommitId.Hash
+		hash[0] ^= 0xff
+	}
+	bz, err := commitInfo.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("s/3"), bz))
+
+	s = newUninitializedTestServiceWithDB(db, &heightMiddleware{})
+	require.Equal(t, int64(3), s.LastBlockHeight())
+	require.ErrorIs(t, s.VerifyStoreIntegrity(), errStoreIntegrity)
+	require.ErrorIs(t, s.Start(context.Background()), errStoreIntegrity)
+}
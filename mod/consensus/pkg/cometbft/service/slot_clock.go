@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// wallClock is a SlotClock following the wall time, on which slots of a fixed
+// duration follow each other from the genesis time on.
+type wallClock struct {
+	// genesisSlot is the slot starting at the genesis time.
+	genesisSlot math.Slot
+	// genesisTime is the time at which the genesis slot starts.
+	genesisTime time.Time
+	// slotDuration is the duration of a slot. A value of 0 starts all slots
+	// at the genesis time.
+	slotDuration time.Duration
+	// now returns the current time.
+	now func() time.Time
+}
+
+// newWallClock creates a new wall clock starting the genesis slot at the
+// genesis time.
+func newWallClock(
+	genesisSlot math.Slot,
+	genesisTime time.Time,
+	slotDuration time.Duration,
+) *wallClock {
+	return &wallClock{
+		genesisSlot:  genesisSlot,
+		genesisTime:  genesisTime,
+		slotDuration: slotDuration,
+		now:          time.Now,
+	}
+}
+
+// CurrentSlot returns the slot in progress at the current wall time.
+func (c *wallClock) CurrentSlot() math.Slot {
+	return c.SlotAt(c.now())
+}
+
+// SlotAt returns the slot in progress at the given wall time.
+func (c *wallClock) SlotAt(t time.Time) math.Slot {
+	elapsed := t.Sub(c.genesisTime)
+	if c.slotDuration <= 0 || elapsed < 0 {
+		return c.genesisSlot
+	}
+	//#nosec:G115 // elapsed is not negative.
+	return c.genesisSlot + math.Slot(elapsed/c.slotDuration)
+}
+
+// SlotStartTime returns the wall time at which the slot starts.
+func (c *wallClock) SlotStartTime(slot math.Slot) time.Time {
+	if slot <= c.genesisSlot {
+		return c.genesisTime
+	}
+	//#nosec:G115 // slots do not overflow durations in practice.
+	return c.genesisTime.Add(time.Duration(slot-c.genesisSlot) * c.slotDuration)
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testSlotClock is a SlotClock whose slots start at fixed times.
+type testSlotClock struct {
+	slot   math.Slot
+	starts map[math.Slot]time.Time
+}
+
+func (c *testSlotClock) CurrentSlot() math.Slot {
+	return c.slot
+}
+
+func (c *testSlotClock) SlotAt(time.Time) math.Slot {
+	return c.slot
+}
+
+func (c *testSlotClock) SlotStartTime(slot math.Slot) time.Time {
+	return c.starts[slot]
+}
+
+func TestWallClock(t *testing.T) {
+	var (
+		genesis = time.Unix(1_700_000_000, 0)
+		now     = genesis.Add(-time.Second)
+		clock   = newWallClock(5, genesis, 2*time.Second)
+	)
+	clock.now = func() time.Time { return now }
+
+	// The genesis slot is in progress until the genesis time.
+	require.Equal(t, math.Slot(5), clock.CurrentSlot())
+
+	// Slots advance exactly at their start time.
+	for slot := math.Slot(5); slot < 10; slot++ {
+		start := clock.SlotStartTime(slot)
+		require.Equal(t, genesis.Add(time.Duration(slot-5)*2*time.Second), start)
+
+		now = start
+		require.Equal(t, slot, clock.CurrentSlot())
+		now = start.Add(2*time.Second - time.Nanosecond)
+		require.Equal(t, slot, clock.CurrentSlot())
+	}
+	now = clock.SlotStartTime(10)
+	require.Equal(t, math.Slot(10), clock.CurrentSlot())
+
+	// Slots before the genesis slot start at the genesis time.
+	require.Equal(t, genesis, clock.SlotStartTime(2))
+}
+
+func TestWallClockGenesisDelay(t *testing.T) {
+	const genesisDelay = 30
+	var (
+		genesis = time.Unix(1_700_000_000, 0)
+		cs      = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				CometValues:    cmttypes.DefaultConsensusParams(),
+				GenesisDelay:   genesisDelay,
+				SecondsPerSlot: 2,
+			},
+		)
+		s = NewService(
+			testStoreKey,
+			&testLogger{},
+			dbm.NewMemDB(),
+			&testMiddleware{},
+			cmtcfg.DefaultConfig(),
+			cs,
+		)
+	)
+	s.setGenesis(1, genesis)
+
+	// The genesis slot starts after the delay, and the slots following it,
+	// from that of the initial height on, are shifted by as much.
+	start := genesis.Add(genesisDelay * time.Second)
+	for slot := range math.Slot(5) {
+		require.Equal(t, start, s.slotClock().SlotStartTime(slot))
+		start = start.Add(2 * time.Second)
+	}
+}
+
+func TestPrepareProposalSlotClock(t *testing.T) {
+	const (
+		slotDuration = 250 * time.Millisecond
+		buildDelay   = 200 * time.Millisecond
+	)
+	prepareProposal := func(clock SlotClock) [][]byte {
+		s := newTestService(
+			t,
+			&testMiddleware{prepareProposalDelay: buildDelay},
+			withSlotDuration(slotDuration),
+			SetSlotClock[*testLogger](clock),
+		)
+		res, err := s.PrepareProposal(
+			context.Background(),
+			&cmtabci.PrepareProposalRequest{
+				Height: 2,
+				Txs:    [][]byte{[]byte("tx")},
+			},
+		)
+		require.NoError(t, err)
+		return res.Txs
+	}
+
+	// The slot already started, so the builder only gets the budget.
+	require.Equal(t, [][]byte{[]byte("tx")}, prepareProposal(
+		&testSlotClock{slot: 2, starts: map[math.Slot]time.Time{
+			2: time.Now().Add(-time.Second),
+		}},
+	))
+
+	// The slot starts later on, which extends the budget up to its start.
+	require.Equal(
+		t,
+		[][]byte{[]byte("block"), []byte("sidecars")},
+		prepareProposal(&testSlotClock{slot: 1, starts: map[math.Slot]time.Time{
+			2: time.Now().Add(time.Second),
+		}}),
+	)
+}
+
+func TestProposalDeadline(t *testing.T) {
+	var (
+		start = time.Now().Add(-time.Minute)
+		clock = &testSlotClock{slot: 2, starts: map[math.Slot]time.Time{
+			2: start,
+		}}
+		s = newTestService(
+			t, &testMiddleware{}, SetSlotClock[*testLogger](clock),
+		)
+	)
+
+	// The budget of the slot in progress counts from its start.
+	require.Equal(t, start.Add(time.Second), s.proposalDeadline(2, time.Second))
+
+	// Once the clock moved past the slot, the budget counts from now.
+	clock.slot = 3
+	now := time.Now()
+	require.False(t, s.proposalDeadline(2, time.Second).Before(
+		now.Add(time.Second),
+	))
+}
+
+// slotMiddleware is a testMiddleware recording the slot of the last
+// proposal it was asked to build, and of the last block it finalized.
+type slotMiddleware struct {
+	testMiddleware
+	slot          math.Slot
+	finalizedSlot math.Slot
+}
+
+func (m *slotMiddleware) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	m.finalizedSlot = slot
+	return m.testMiddleware.FinalizeBlock(ctx, req, slot)
+}
+
+func (m *slotMiddleware) PrepareProposal(
+	ctx context.Context,
+	slotData *types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo],
+) ([]byte, []byte, error) {
+	m.slot = slotData.GetSlot()
+	return m.testMiddleware.PrepareProposal(ctx, slotData)
+}
+
+func TestSkipEmptySlots(t *testing.T) {
+	genesis := time.Unix(1_700_000_000, 0)
+	newService := func(skip bool) (*Service[*testLogger], *slotMiddleware) {
+		mw := &slotMiddleware{}
+		s := newUninitializedTestService(
+			mw,
+			withSlotDuration(2*time.Second),
+			SetSkipEmptySlots[*testLogger](skip),
+		)
+		_, err := s.InitChain(context.Background(), &cmtabci.InitChainRequest{
+			ChainId:       testChainID,
+			InitialHeight: 1,
+			Time:          genesis,
+			AppStateBytes: []byte(`{"beacon":{}}`),
+		})
+		require.NoError(t, err)
+		return s, mw
+	}
+	finalize := func(s *Service[*testLogger], height int64, at time.Time) {
+		_, err := s.FinalizeBlock(
+			context.Background(),
+			&cmtabci.FinalizeBlockRequest{Height: height, Time: at},
+		)
+		require.NoError(t, err)
+		_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+		require.NoError(t, err)
+	}
+	prepare := func(
+		s *Service[*testLogger], mw *slotMiddleware, at time.Time,
+	) math.Slot {
+		_, err := s.PrepareProposal(
+			context.Background(),
+			&cmtabci.PrepareProposalRequest{
+				Height: s.LastBlockHeight() + 1,
+				Time:   at,
+			},
+		)
+		require.NoError(t, err)
+		return mw.slot
+	}
+
+	lastSlot := func(s *Service[*testLogger]) math.Slot {
+		st, err := s.resetState()
+		require.NoError(t, err)
+		slot, err := s.lastSlot(st.Context(), s.LastBlockHeight())
+		require.NoError(t, err)
+		return slot
+	}
+
+	s, mw := newService(true)
+	// The slot of the genesis equals its height.
+	require.Equal(t, math.Slot(0), lastSlot(s))
+	finalize(s, 1, genesis.Add(time.Second))
+	require.Equal(t, math.Slot(1), mw.finalizedSlot)
+
+	// The proposer of slot 2 was offline, so the block at height 2 is only
+	// proposed in slot 3.
+	require.Equal(t, math.Slot(3), prepare(s, mw, genesis.Add(7*time.Second)))
+	finalize(s, 2, genesis.Add(7*time.Second))
+	require.Equal(t, math.Slot(3), mw.finalizedSlot)
+	require.Equal(t, math.Slot(3), lastSlot(s))
+
+	// A block is never proposed before the slot following its parent, even
+	// if the time has not reached it.
+	require.Equal(t, math.Slot(4), prepare(s, mw, genesis.Add(5*time.Second)))
+
+	// Without skipping empty slots, the slot remains the height.
+	s, mw = newService(false)
+	finalize(s, 1, genesis.Add(time.Second))
+	require.Equal(t, math.Slot(2), prepare(s, mw, genesis.Add(5*time.Second)))
+}
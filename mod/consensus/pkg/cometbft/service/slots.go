@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// lastSlotKey is the key under which the slot of the block finalized last is
+// kept in the consensus state. Unlike the keys of the beacon state, which
+// start with a single byte prefix, it is not a prefix of any other key.
+var lastSlotKey = []byte("consensus/last-slot")
+
+// slotSize is the size of a slot kept in the consensus state, in bytes.
+const slotSize = 8
+
+// slotForHeight derives the slot of the block at the height, proposed at the
+// given time on the state of the context. Unless skipEmptySlots is set, the
+// slot equals the height. Otherwise, it is the slot in progress at the time
+// of the block, skipping the slots in which no block was produced, but always
+// past the slot of its parent.
+func (s *Service[_]) slotForHeight(
+	ctx sdk.Context,
+	height int64,
+	proposalTime time.Time,
+) (math.Slot, error) {
+	if !s.skipEmptySlots {
+		//#nosec:G115 // heights are not negative.
+		return math.Slot(height), nil
+	}
+
+	parentSlot, err := s.lastSlot(ctx, height-1)
+	if err != nil {
+		return 0, err
+	}
+	return max(parentSlot+1, s.slotClock().SlotAt(proposalTime)), nil
+}
+
+// lastSlot returns the slot of the block finalized last on the state of the
+// context, at the given height. The slot of a block finalized before the
+// slots were kept, including the genesis, equals its height.
+func (s *Service[_]) lastSlot(
+	ctx sdk.Context,
+	height int64,
+) (math.Slot, error) {
+	bz := ctx.MultiStore().GetKVStore(s.storeKey).Get(lastSlotKey)
+	switch len(bz) {
+	case 0:
+		//#nosec:G115 // heights are not negative.
+		return math.Slot(max(height, 0)), nil
+	case slotSize:
+		return math.Slot(binary.BigEndian.Uint64(bz)), nil
+	default:
+		return 0, fmt.Errorf(
+			"%w: slot of %d bytes at height %d",
+			errInvalidLastSlot, len(bz), height,
+		)
+	}
+}
+
+// setLastSlot keeps the slot of the block finalized on the state of the
+// context, as part of the state committed with the block.
+func (s *Service[_]) setLastSlot(ctx sdk.Context, slot math.Slot) {
+	ctx.MultiStore().GetKVStore(s.storeKey).Set(
+		lastSlotKey, binary.BigEndian.AppendUint64(nil, slot.Unwrap()),
+	)
+}
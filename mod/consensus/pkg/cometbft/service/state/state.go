@@ -26,13 +26,15 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	storetypes "cosmossdk.io/store/types"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
 type Manager struct {
-	db  dbm.DB
-	cms storetypes.CommitMultiStore
+	db     dbm.DB
+	cms    storetypes.CommitMultiStore
+	logger log.Logger
 }
 
 // NewManager creates a new Manager.
@@ -48,6 +50,7 @@ func NewManager(
 			logger,
 			storemetrics.NewNoOpMetrics(),
 		),
+		logger: logger,
 	}
 
 	for _, opt := range opts {
@@ -87,6 +90,61 @@ func (sm *Manager) Close() error {
 	return sm.db.Close()
 }
 
+// WithCommitMultiStore makes the Manager use the given CommitMultiStore.
+func WithCommitMultiStore(cms storetypes.CommitMultiStore) func(*Manager) {
+	return func(sm *Manager) {
+		sm.cms = cms
+	}
+}
+
+// WithInMemoryStore makes the Manager keep all state in memory rather than
+// in the database it is created with, which is left untouched. The
+// CommitMultiStore is versioned as usual, such that past versions can be
+// branched with CacheMultiStoreWithVersion. It is meant for tests.
+func WithInMemoryStore() func(*Manager) {
+	return func(sm *Manager) {
+		sm.db = dbm.NewMemDB()
+		sm.cms = store.NewCommitMultiStore(
+			sm.db,
+			sm.logger,
+			storemetrics.NewNoOpMetrics(),
+		)
+	}
+}
+
+// CacheMultiStore branches the latest committed state of the
+// CommitMultiStore. The underlying stores panic if they cannot be branched,
+// which is returned as an error instead.
+func (sm *Manager) CacheMultiStore() (
+	cms storetypes.CacheMultiStore, err error,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			cms, err = nil, fmt.Errorf("failed to cache multistore: %v", r)
+		}
+	}()
+	return sm.cms.CacheMultiStore(), nil
+}
+
+// Commit commits the CommitMultiStore. The underlying stores panic if they
+// cannot be committed, which is returned as an error instead.
+func (sm *Manager) Commit() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to commit multistore: %v", r)
+		}
+	}()
+	sm.cms.Commit()
+	return nil
+}
+
+// LatestVersion returns the latest version of the CommitMultiStore persisted
+// in the database. It is ahead of the last commit ID of the CommitMultiStore
+// if a commit failed only after being persisted.
+func (sm *Manager) LatestVersion() int64 {
+	return rootmulti.GetLatestVersion(sm.db)
+}
+
 // CommitMultiStore returns the CommitMultiStore of the Manager.
 // TODO:REMOVE
 func (sm *Manager) CommitMultiStore() storetypes.CommitMultiStore {
@@ -22,6 +22,7 @@ package cometbft
 
 import (
 	"context"
+	"time"
 
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
@@ -29,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // AttestationData is an interface for accessing the attestation data.
@@ -49,6 +51,35 @@ type BeaconState interface {
 	HashTreeRoot() common.Root
 }
 
+// SlotClock tells when the slots of the chain take place.
+type SlotClock interface {
+	// CurrentSlot returns the slot in progress.
+	CurrentSlot() math.Slot
+	// SlotAt returns the slot in progress at the given time.
+	SlotAt(t time.Time) math.Slot
+	// SlotStartTime returns the time at which the slot starts.
+	SlotStartTime(slot math.Slot) time.Time
+}
+
+// PeerCounter tells the number of peers the node is connected to.
+type PeerCounter interface {
+	// NumPeers returns the number of connected peers.
+	NumPeers() uint64
+}
+
+// ValidatorSetStore loads the validator sets of CometBFT.
+type ValidatorSetStore interface {
+	// LoadValidators returns the validator set of the height, whose
+	// proposer is that of its first round.
+	LoadValidators(height int64) (*cmttypes.ValidatorSet, error)
+}
+
+// BlockStore tells the lowest height retained by the block store of the node.
+type BlockStore interface {
+	// Base returns the lowest retained height.
+	Base() int64
+}
+
 type MiddlewareI interface {
 	InitGenesis(
 		ctx context.Context, bz []byte,
@@ -57,11 +88,19 @@ type MiddlewareI interface {
 		*ctypes.AttestationData,
 		*ctypes.SlashingInfo]) ([]byte, []byte, error)
 	ProcessProposal(
-		ctx context.Context, req *cmtabci.ProcessProposalRequest,
+		ctx context.Context,
+		req *cmtabci.ProcessProposalRequest,
+		slot math.Slot,
 	) (*cmtabci.ProcessProposalResponse, error)
 	FinalizeBlock(
 		ctx context.Context,
 		req *cmtabci.FinalizeBlockRequest,
+		slot math.Slot,
+	) (transition.ValidatorUpdates, error)
+	ReplayFinalizeBlock(
+		ctx context.Context,
+		req *cmtabci.FinalizeBlockRequest,
+		slot math.Slot,
 	) (transition.ValidatorUpdates, error)
 }
 
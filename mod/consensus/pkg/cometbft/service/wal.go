@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+
+	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// walFileMode is the mode of the write-ahead log file.
+const walFileMode = 0o600
+
+// errWALAppHashMismatch is returned when a block recovered from the
+// write-ahead log does not finalize to the app hash it was logged with.
+var errWALAppHashMismatch = errors.New(
+	"app hash differs from write-ahead log")
+
+// walEntry is the block recorded in the write-ahead log, finalized but not
+// yet committed.
+type walEntry struct {
+	// Height is the height of the block.
+	Height int64 `json:"height"`
+	// BlockHash is the hash of the block.
+	BlockHash []byte `json:"block_hash"`
+	// AppHash is the app hash the block was finalized to.
+	AppHash []byte `json:"app_hash"`
+}
+
+// finalizeWAL is a write-ahead log holding the block finalized last, until
+// its state is committed. A crash between FinalizeBlock and Commit leaves the
+// block in the log, such that it is detected on restart.
+type finalizeWAL struct {
+	// path is the file the log is kept in.
+	path string
+}
+
+// read returns the block in the log, or nil if the log is empty.
+func (w *finalizeWAL) read() (*walEntry, error) {
+	bz, err := os.ReadFile(w.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	entry := new(walEntry)
+	if err = json.Unmarshal(bz, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// write replaces the block in the log. The log is swapped in by renaming, so
+// a crash halfway through leaves either the previous block or the new one.
+func (w *finalizeWAL) write(entry *walEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp := w.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, walFileMode)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err = f.Sync(); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, w.path)
+}
+
+// remove empties the log.
+func (w *finalizeWAL) remove() error {
+	if err := os.Remove(w.path); err != nil &&
+		!errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// recoverFinalizeWAL inspects the block left in the write-ahead log by a
+// previous run. A block whose commit completed is discarded, while a block
+// which was finalized but not committed is resumed: CometBFT replays it, and
+// it must then finalize to the logged app hash.
+func (s *Service[_]) recoverFinalizeWAL() error {
+	entry, err := s.wal.read()
+	if err != nil || entry == nil {
+		return err
+	}
+
+	lastCommitID := s.sm.CommitMultiStore().LastCommitID()
+	switch {
+	case entry.Height == lastCommitID.Version &&
+		bytes.Equal(entry.AppHash, lastCommitID.Hash):
+		s.logger.Info(
+			"Discarding write-ahead log of committed block",
+			"height", entry.Height,
+		)
+		return s.wal.remove()
+	case entry.Height == lastCommitID.Version:
+		return errorsmod.Wrapf(
+			errWALAppHashMismatch, "committed height %d: expected %X, got %X",
+			entry.Height, entry.AppHash, lastCommitID.Hash,
+		)
+	case entry.Height == lastCommitID.Version+1:
+		s.logger.Warn(
+			"Resuming block finalized but not committed before stopping",
+			"height", entry.Height,
+		)
+		s.walPending = entry
+		return nil
+	default:
+		s.logger.Warn(
+			"Discarding stale write-ahead log",
+			"height", entry.Height,
+			"last_block_height", lastCommitID.Version,
+		)
+		return s.wal.remove()
+	}
+}
+
+// logFinalizedBlock records the finalized block in the write-ahead log. A
+// replay of the block recovered from the log must finalize to the same app
+// hash, unless a different block was finalized at its height.
+func (s *Service[_]) logFinalizedBlock(
+	req *cmtabci.FinalizeBlockRequest,
+	appHash []byte,
+) error {
+	if pending := s.walPending; pending != nil && pending.Height == req.Height {
+		s.walPending = nil
+		if bytes.Equal(pending.BlockHash, req.Hash) &&
+			!bytes.Equal(pending.AppHash, appHash) {
+			return errorsmod.Wrapf(
+				errWALAppHashMismatch, "height %d: expected %X, got %X",
+				req.Height, pending.AppHash, appHash,
+			)
+		}
+	}
+
+	return s.wal.write(&walEntry{
+		Height:    req.Height,
+		BlockHash: req.Hash,
+		AppHash:   appHash,
+	})
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizeWAL(t *testing.T) {
+	// start returns a service on the database, as started after a crash,
+	// which has committed the given height.
+	start := func(
+		t *testing.T, db dbm.DB, path string,
+	) *Service[*testLogger] {
+		t.Helper()
+		s := newUninitializedTestServiceWithDB(
+			db, &heightMiddleware{}, SetFinalizeWAL[*testLogger](path),
+		)
+		if s.LastBlockHeight() == 0 {
+			_, err := s.InitChain(
+				context.Background(), &cmtabci.InitChainRequest{
+					ChainId:       testChainID,
+					InitialHeight: 1,
+					AppStateBytes: []byte(`{"beacon":{}}`),
+				},
+			)
+			require.NoError(t, err)
+		}
+		return s
+	}
+	finalize := func(
+		s *Service[*testLogger], height int64,
+	) (*cmtabci.FinalizeBlockResponse, error) {
+		return s.FinalizeBlock(
+			context.Background(),
+			&cmtabci.FinalizeBlockRequest{
+				Height: height, Hash: []byte{byte(height)},
+			},
+		)
+	}
+
+	t.Run("crash before commit", func(t *testing.T) {
+		var (
+			db   = dbm.NewMemDB()
+			path = filepath.Join(t.TempDir(), "finalize.wal")
+			s    = start(t, db, path)
+		)
+		commitBlocks(t, s, 2)
+		require.NoFileExists(t, path)
+
+		// The block is finalized, then the node crashes before Commit.
+		res, err := finalize(s, 3)
+		require.NoError(t, err)
+		entry, err := s.wal.read()
+		require.NoError(t, err)
+		require.Equal(t, &walEntry{
+			Height: 3, BlockHash: []byte{3}, AppHash: res.AppHash,
+		}, entry)
+
+		// On restart, the block is resumed and replayed to the same app
+		// hash, after which the log is removed by the commit.
+		s = start(t, db, path)
+		require.Equal(t, int64(2), s.LastBlockHeight())
+		require.Equal(t, entry, s.walPending)
+
+		replayed, err := finalize(s, 3)
+		require.NoError(t, err)
+		require.Equal(t, res.AppHash, replayed.AppHash)
+		require.Nil(t, s.walPending)
+		_, err = s.Commit(context.Background(), &cmtabci.CommitRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int64(3), s.LastBlockHeight())
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("crash after commit", func(t *testing.T) {
+		var (
+			db   = dbm.NewMemDB()
+			path = filepath.Join(t.TempDir(), "finalize.wal")
+			s    = start(t, db, path)
+		)
+		commitBlocks(t, s, 2)
+
+		// The commit completed, but the log was left behind.
+		require.NoError(t, s.wal.write(&walEntry{
+			Height: 2, BlockHash: []byte{2}, AppHash: s.LastAppHash(),
+		}))
+
+		// On restart, the committed block is discarded.
+		s = start(t, db, path)
+		require.Equal(t, int64(2), s.LastBlockHeight())
+		require.Nil(t, s.walPending)
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("replay to different app hash", func(t *testing.T) {
+		var (
+			db   = dbm.NewMemDB()
+			path = filepath.Join(t.TempDir(), "finalize.wal")
+			s    = start(t, db, path)
+		)
+		commitBlocks(t, s, 2)
+		require.NoError(t, s.wal.write(&walEntry{
+			Height: 3, BlockHash: []byte{3}, AppHash: []byte("app hash"),
+		}))
+
+		s = start(t, db, path)
+		_, err := finalize(s, 3)
+		require.ErrorIs(t, err, errWALAppHashMismatch)
+	})
+
+	t.Run("committed state differs", func(t *testing.T) {
+		var (
+			db   = dbm.NewMemDB()
+			path = filepath.Join(t.TempDir(), "finalize.wal")
+			s    = start(t, db, path)
+		)
+		commitBlocks(t, s, 2)
+		require.NoError(t, s.wal.write(&walEntry{
+			Height: 2, BlockHash: []byte{2}, AppHash: []byte("app hash"),
+		}))
+
+		require.Panics(t, func() { start(t, db, path) })
+	})
+}
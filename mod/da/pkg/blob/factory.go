@@ -137,6 +137,34 @@ func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 	return append(commitmentsProof, bodyProof...), nil
 }
 
+// BlobCommitmentsRootAndProof returns the root of the blob KZG commitments
+// of the body, i.e. the root of its blob_kzg_commitments subtree, along with
+// the proof of that root against the root of the body. Producers append the
+// proof to the proof of a commitment within the list to obtain its inclusion
+// proof, and verifiers check proofs rooted at either.
+func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BlobCommitmentsRootAndProof(
+	body BeaconBlockBodyT,
+) (common.Root, []common.Root, error) {
+	leaves := body.GetBlobKzgCommitments().Leafify()
+	if len(leaves) == 0 {
+		// The tree of an empty list holds a single zero leaf, which is not
+		// counted when mixing in the length.
+		leaves = []common.Root{{}}
+	}
+	commitmentsTree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		leaves, f.chainSpec.MaxBlobCommitmentsPerBlock(),
+	)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+
+	proof, err := f.BuildBlockBodyProof(body)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	return commitmentsTree.HashTreeRoot(), proof, nil
+}
+
 // BuildBlockBodyProof builds a block body proof.
 func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildBlockBodyProof(
 	body BeaconBlockBodyT,
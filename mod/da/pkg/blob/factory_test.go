@@ -20,6 +20,20 @@
 
 package blob_test
 
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
 // TODO: Create a mock such that core/types doesn't need
 // to be imported here.
 
@@ -126,3 +140,43 @@ func (m *MockSpec) MaxBlobCommitmentsPerBlock() uint64 {
 // 		},
 // 	}
 // }
+
+// noopSink is a TelemetrySink discarding all metrics.
+type noopSink struct{}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func TestBlobCommitmentsRootAndProof(t *testing.T) {
+	factory := blob.NewSidecarFactory[
+		*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+	](&MockSpec{}, types.KZGPositionDeneb, noopSink{})
+
+	for _, numBlobs := range []int{0, 1, 3} {
+		body := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		commitments := make(
+			eip4844.KZGCommitments[common.ExecutionHash], numBlobs,
+		)
+		for i := range commitments {
+			commitments[i] = eip4844.KZGCommitment{byte(i + 1)}
+		}
+		body.SetBlobKzgCommitments(commitments)
+
+		root, proof, err := factory.BlobCommitmentsRootAndProof(body)
+		require.NoError(t, err)
+		require.True(t, merkle.VerifyProof(
+			body.HashTreeRoot(), root, types.KZGPositionDeneb, proof,
+		), "blobs: %d", numBlobs)
+
+		// The proof to a commitment within the list is rooted at the root
+		// of the commitments.
+		for i, commitment := range commitments {
+			commitmentProof, err := factory.BuildCommitmentProof(
+				body, math.U64(i),
+			)
+			require.NoError(t, err)
+			require.True(t, merkle.VerifyProof(
+				root, commitment.HashTreeRoot(), uint64(i), commitmentProof,
+			))
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+var errBadKZGProof = errors.New("bad kzg proof")
+
+// badProof is the KZG proof rejected by fakeProofVerifier.
+var badProof = eip4844.KZGProof{0xff}
+
+// fakeProofVerifier is a BlobProofVerifier rejecting badProof only.
+type fakeProofVerifier struct{}
+
+func (fakeProofVerifier) GetImplementation() string { return "fake" }
+
+func (fakeProofVerifier) VerifyBlobProof(
+	_ *eip4844.Blob, proof eip4844.KZGProof, _ eip4844.KZGCommitment,
+) error {
+	if proof == badProof {
+		return errBadKZGProof
+	}
+	return nil
+}
+
+func (v fakeProofVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	for i, proof := range args.Proofs {
+		if err := v.VerifyBlobProof(
+			args.Blobs[i], proof, args.Commitments[i],
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildSidecars builds the sidecars of a Deneb block carrying the given
+// blobs bundle.
+func buildSidecars(
+	t testing.TB,
+	bundle *engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	],
+) *datypes.BlobSidecars {
+	t.Helper()
+	factory := blob.NewSidecarFactory[
+		*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+	](&MockSpec{}, types.KZGPositionDeneb, noopSink{})
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		1, 0, common.Root{}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body = blk.Body.Empty(version.Deneb)
+	blk.Body.SetBlobKzgCommitments(
+		eip4844.KZGCommitments[common.ExecutionHash](bundle.Commitments),
+	)
+
+	sidecars, err := factory.BuildSidecars(blk, bundle)
+	require.NoError(t, err)
+	return sidecars
+}
+
+// fakeBundle returns a bundle of numBlobs blobs with made up commitments and
+// proofs, accepted by fakeProofVerifier.
+func fakeBundle(numBlobs int) *engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+] {
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for i := range numBlobs {
+		bundle.Commitments = append(
+			bundle.Commitments, eip4844.KZGCommitment{byte(i + 1)},
+		)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof{byte(i + 1)})
+		bundle.Blobs = append(bundle.Blobs, &eip4844.Blob{byte(i + 1)})
+	}
+	return bundle
+}
+
+// realBundle returns a bundle of numBlobs blobs with their actual
+// commitments and proofs, computed from the testing trusted setup.
+func realBundle(
+	t testing.TB,
+	ts *gokzg4844.JSONTrustedSetup,
+	numBlobs int,
+) *engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+] {
+	t.Helper()
+	ctx, err := gokzg4844.NewContext4096(ts)
+	require.NoError(t, err)
+
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for i := range numBlobs {
+		// Every field element is kept below the modulus by leaving its most
+		// significant byte zero.
+		b := &eip4844.Blob{}
+		for j := 1; j < len(b); j += 32 {
+			b[j] = byte(i + j)
+		}
+		commitment, err := ctx.BlobToKZGCommitment((*gokzg4844.Blob)(b), 0)
+		require.NoError(t, err)
+		proof, err := ctx.ComputeBlobKZGProof(
+			(*gokzg4844.Blob)(b), commitment, 0,
+		)
+		require.NoError(t, err)
+		bundle.Commitments = append(
+			bundle.Commitments, eip4844.KZGCommitment(commitment),
+		)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof(proof))
+		bundle.Blobs = append(bundle.Blobs, b)
+	}
+	return bundle
+}
+
+// loadTrustedSetup loads the testing trusted setup.
+func loadTrustedSetup(t testing.TB) *gokzg4844.JSONTrustedSetup {
+	t.Helper()
+	data, err := os.ReadFile(
+		filepath.Join("../../../../testing/files", "kzg-trusted-setup.json"),
+	)
+	require.NoError(t, err)
+	var ts gokzg4844.JSONTrustedSetup
+	require.NoError(t, json.Unmarshal(data, &ts))
+	return &ts
+}
+
+func TestVerifySidecars(t *testing.T) {
+	kzgOffset := types.KZGMerkleIndexDeneb * (&MockSpec{}).
+		MaxBlobCommitmentsPerBlock()
+	verifier := blob.NewVerifier[
+		*types.BeaconBlockHeader,
+		*datypes.BlobSidecar,
+		*datypes.BlobSidecars,
+	](fakeProofVerifier{}, noopSink{})
+
+	for _, numBlobs := range []int{1, 3} {
+		t.Run(fmt.Sprintf("blobs=%d/valid", numBlobs), func(t *testing.T) {
+			require.NoError(t, verifier.VerifySidecars(
+				buildSidecars(t, fakeBundle(numBlobs)), kzgOffset,
+			))
+		})
+
+		t.Run(
+			fmt.Sprintf("blobs=%d/invalid inclusion proof", numBlobs),
+			func(t *testing.T) {
+				sidecars := buildSidecars(t, fakeBundle(numBlobs))
+				sidecars.Sidecars[numBlobs-1].InclusionProof[0] = common.
+					Root{0xff}
+				require.ErrorIs(t, verifier.VerifySidecars(
+					sidecars, kzgOffset,
+				), datypes.ErrInvalidInclusionProof)
+			},
+		)
+
+		t.Run(
+			fmt.Sprintf("blobs=%d/invalid kzg proof", numBlobs),
+			func(t *testing.T) {
+				sidecars := buildSidecars(t, fakeBundle(numBlobs))
+				sidecars.Sidecars[numBlobs-1].KzgProof = badProof
+				require.ErrorIs(t, verifier.VerifySidecars(
+					sidecars, kzgOffset,
+				), errBadKZGProof)
+			},
+		)
+	}
+}
+
+func TestVerifySidecarsGoKZG(t *testing.T) {
+	kzgOffset := types.KZGMerkleIndexDeneb * (&MockSpec{}).
+		MaxBlobCommitmentsPerBlock()
+	ts := loadTrustedSetup(t)
+	proofVerifier, err := kzg.NewBlobProofVerifier(gokzg.Implementation, ts)
+	require.NoError(t, err)
+	verifier := blob.NewVerifier[
+		*types.BeaconBlockHeader,
+		*datypes.BlobSidecar,
+		*datypes.BlobSidecars,
+	](proofVerifier, noopSink{})
+
+	for _, numBlobs := range []int{1, 3} {
+		sidecars := buildSidecars(t, realBundle(t, ts, numBlobs))
+		require.NoError(t, verifier.VerifySidecars(sidecars, kzgOffset))
+
+		// A proof of another blob is rejected.
+		sidecars.Sidecars[0].KzgProof = realBundle(t, ts, 2).Proofs[1]
+		require.Error(t, verifier.VerifySidecars(sidecars, kzgOffset))
+	}
+}
+
+func BenchmarkVerifySidecars(b *testing.B) {
+	kzgOffset := types.KZGMerkleIndexDeneb * (&MockSpec{}).
+		MaxBlobCommitmentsPerBlock()
+	ts := loadTrustedSetup(b)
+
+	for _, impl := range []string{gokzg.Implementation, ckzg.Implementation} {
+		proofVerifier, err := kzg.NewBlobProofVerifier(impl, ts)
+		require.NoError(b, err)
+		verifier := blob.NewVerifier[
+			*types.BeaconBlockHeader,
+			*datypes.BlobSidecar,
+			*datypes.BlobSidecars,
+		](proofVerifier, noopSink{})
+
+		for _, numBlobs := range []int{1, 3, 6} {
+			sidecars := buildSidecars(b, realBundle(b, ts, numBlobs))
+			b.Run(fmt.Sprintf("%s/blobs=%d", impl, numBlobs), func(
+				b *testing.B,
+			) {
+				err = verifier.VerifySidecars(sidecars, kzgOffset)
+				if errors.Is(err, ckzg.ErrCGONotEnabled) {
+					b.Skip("c-kzg requires the ckzg build tag")
+				}
+				require.NoError(b, err)
+				for range b.N {
+					if err = verifier.VerifySidecars(
+						sidecars, kzgOffset,
+					); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -125,7 +125,8 @@ func (s *Service[_, _]) eventLoop(ctx context.Context) {
 
 // handleFinalSidecarsReceived handles the BlobSidecarsProcessRequest
 // event.
-// It processes the sidecars and publishes a BlobSidecarsProcessed event.
+// It processes the sidecars and publishes a FinalSidecarsProcessed event
+// with the number of sidecars stored.
 func (s *Service[_, BlobSidecarsT]) handleFinalSidecarsReceived(
 	msg async.Event[BlobSidecarsT],
 ) {
@@ -135,6 +136,15 @@ func (s *Service[_, BlobSidecarsT]) handleFinalSidecarsReceived(
 			"error",
 			err,
 		)
+		return
+	}
+
+	if err := s.dispatcher.Publish(
+		async.NewEvent(
+			msg.Context(), async.FinalSidecarsProcessed, msg.Data().Len(),
+		),
+	); err != nil {
+		s.logger.Error("failed to publish event", "err", err)
 	}
 }
 
@@ -31,6 +31,9 @@ const (
 type Config struct {
 	// TrustedSetupPath is the path to the trusted setup.
 	TrustedSetupPath string `mapstructure:"trusted-setup-path"`
+	// TrustedSetupHash is the hex-encoded SHA-256 of the trusted setup file,
+	// verified at startup. The check is skipped if empty.
+	TrustedSetupHash string `mapstructure:"trusted-setup-hash"`
 	// Implementation is the KZG implementation to use.
 	Implementation string `mapstructure:"implementation"`
 }
@@ -28,4 +28,12 @@ var (
 	ErrUnsupportedKzgImplementation = errors.New(
 		"unsupported KZG implementation",
 	)
+
+	// ErrInvalidTrustedSetupHash is returned when the expected trusted setup
+	// hash is not a hex-encoded SHA-256.
+	ErrInvalidTrustedSetupHash = errors.New("invalid trusted setup hash")
+
+	// ErrTrustedSetupHashMismatch is returned when the hash of the trusted
+	// setup does not match the expected hash.
+	ErrTrustedSetupHashMismatch = errors.New("trusted setup hash mismatch")
 )
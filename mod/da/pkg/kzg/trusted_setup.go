@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// VerifyTrustedSetupHash verifies that the SHA-256 of the given trusted setup
+// file matches the hex-encoded expected hash. The check is skipped if no hash
+// is expected.
+func VerifyTrustedSetupHash(bz []byte, expectedHash string) error {
+	if expectedHash == "" {
+		return nil
+	}
+
+	expected, err := hex.DecodeString(expectedHash)
+	if err != nil || len(expected) != sha256.Size {
+		return errors.Wrapf(ErrInvalidTrustedSetupHash, "%s", expectedHash)
+	}
+
+	if actual := sha256.Sum256(bz); actual != [sha256.Size]byte(expected) {
+		return errors.Wrapf(
+			ErrTrustedSetupHashMismatch,
+			"expected: %s, actual: %x",
+			expectedHash, actual,
+		)
+	}
+	return nil
+}
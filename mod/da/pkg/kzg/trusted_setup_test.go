@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+// trustedSetupHash is the SHA-256 of the trusted setup in the testing files.
+const trustedSetupHash = "0229b43f4fac9b17374809520eb621b5ee1a7f74547e7d36918e7d4b122e178d"
+
+func TestVerifyTrustedSetupHash(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join(baseDir, "kzg-trusted-setup.json"))
+	require.NoError(t, err)
+
+	t.Run("matching setup", func(t *testing.T) {
+		require.NoError(t, kzg.VerifyTrustedSetupHash(bz, trustedSetupHash))
+	})
+
+	t.Run("tampered setup", func(t *testing.T) {
+		tampered := make([]byte, len(bz))
+		copy(tampered, bz)
+		tampered[len(tampered)/2] ^= 0x01
+
+		err = kzg.VerifyTrustedSetupHash(tampered, trustedSetupHash)
+		require.ErrorIs(t, err, kzg.ErrTrustedSetupHashMismatch)
+	})
+
+	t.Run("no expected hash", func(t *testing.T) {
+		require.NoError(t, kzg.VerifyTrustedSetupHash([]byte("setup"), ""))
+	})
+
+	t.Run("invalid expected hash", func(t *testing.T) {
+		for _, hash := range []string{"not-hex", trustedSetupHash[:32]} {
+			err = kzg.VerifyTrustedSetupHash(bz, hash)
+			require.ErrorIs(t, err, kzg.ErrInvalidTrustedSetupHash)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// encodingRaw marks a sidecar stored as plain SSZ.
+	encodingRaw byte = iota
+	// encodingZstd marks a sidecar stored as zstd compressed SSZ.
+	encodingZstd
+)
+
+//nolint:gochecknoglobals // the zstd encoder and decoder are safe to share.
+var (
+	zstdEncoder = mustNewZstdEncoder()
+	zstdDecoder = mustNewZstdDecoder()
+)
+
+// encodeSidecar marshals the sidecar and prefixes it with a header byte
+// describing its encoding, compressing the payload if requested.
+func encodeSidecar(sc *types.BlobSidecar, compress bool) ([]byte, error) {
+	bz, err := sc.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	if !compress {
+		return append([]byte{encodingRaw}, bz...), nil
+	}
+	return zstdEncoder.EncodeAll(bz, []byte{encodingZstd}), nil
+}
+
+// decodeSidecar decodes a sidecar written by encodeSidecar. Values written
+// before the header byte was introduced are plain SSZ of the fixed sidecar
+// size and are decoded as such, so existing stores remain readable.
+func decodeSidecar(bz []byte) (*types.BlobSidecar, error) {
+	sc := new(types.BlobSidecar)
+	if len(bz) == int(sc.SizeSSZ()) {
+		return sc, sc.UnmarshalSSZ(bz)
+	}
+	if len(bz) == 0 {
+		return nil, ErrUnknownSidecarEncoding
+	}
+
+	switch bz[0] {
+	case encodingRaw:
+		return sc, sc.UnmarshalSSZ(bz[1:])
+	case encodingZstd:
+		raw, err := zstdDecoder.DecodeAll(bz[1:], nil)
+		if err != nil {
+			return nil, err
+		}
+		return sc, sc.UnmarshalSSZ(raw)
+	default:
+		return nil, errors.Wrapf(
+			ErrUnknownSidecarEncoding, "header byte %d", bz[0],
+		)
+	}
+}
+
+func mustNewZstdEncoder() *zstd.Encoder {
+	enc, err := zstd.NewWriter(nil)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+func mustNewZstdDecoder() *zstd.Decoder {
+	dec, err := zstd.NewReader(nil)
+	if err != nil {
+		panic(err)
+	}
+	return dec
+}
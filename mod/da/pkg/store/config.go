@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+// Config is the configuration for the availability store.
+type Config struct {
+	// CompressSidecars enables zstd compression of persisted blob sidecars.
+	CompressSidecars bool `mapstructure:"compress-sidecars"`
+	// MaxConcurrentPersists bounds the number of sidecars concurrently
+	// written to disk, across all the blocks being persisted. 0 disables the
+	// bound.
+	MaxConcurrentPersists uint64 `mapstructure:"max-concurrent-persists"`
+	// FailWhenPersistBusy makes persisting sidecars fail with ErrPersistBusy
+	// rather than wait when MaxConcurrentPersists writes are already in
+	// flight.
+	FailWhenPersistBusy bool `mapstructure:"fail-when-persist-busy"`
+	// MaxRetainedSlots caps the number of most recent slots whose sidecars
+	// are retained, pruning them before the end of the DA window if stricter.
+	// 0 disables the cap.
+	MaxRetainedSlots uint64 `mapstructure:"max-retained-slots"`
+}
+
+// DefaultConfig returns the default configuration for the availability
+// store.
+func DefaultConfig() Config {
+	return Config{
+		CompressSidecars:      false,
+		MaxConcurrentPersists: 0,
+		FailWhenPersistBusy:   false,
+		MaxRetainedSlots:      0,
+	}
+}
@@ -34,4 +34,16 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrSidecarNotFound is returned when a sidecar is not stored, either
+	// because it was never stored or because it has been pruned.
+	ErrSidecarNotFound = errors.New("sidecar not found")
+
+	// ErrUnknownSidecarEncoding is returned when a stored sidecar has an
+	// unrecognised encoding header.
+	ErrUnknownSidecarEncoding = errors.New("unknown sidecar encoding")
+
+	// ErrPersistBusy is returned when sidecars cannot be persisted because
+	// the maximum number of concurrent sidecar writes is already in flight.
+	ErrPersistBusy = errors.New("too many concurrent sidecar writes")
 )
@@ -25,15 +25,26 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
+// BuildPruneRangeFn builds the function returning the range of slots whose
+// sidecars are pruned on a finalized block. Sidecars are retained for the DA
+// window, or for the maxRetainedSlots most recent slots if that is stricter.
+// A maxRetainedSlots of 0 only applies the DA window.
 func BuildPruneRangeFn[BeaconBlockT BeaconBlock](
 	cs common.ChainSpec,
+	maxRetainedSlots uint64,
 ) func(async.Event[BeaconBlockT]) (uint64, uint64) {
 	return func(event async.Event[BeaconBlockT]) (uint64, uint64) {
-		window := cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
-		if event.Data().GetSlot().Unwrap() < window {
-			return 0, 0
+		var (
+			slot   = event.Data().GetSlot().Unwrap()
+			window = cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
+			end    uint64
+		)
+		if slot > window {
+			end = slot - window
 		}
-
-		return 0, event.Data().GetSlot().Unwrap() - window
+		if maxRetainedSlots > 0 && slot+1 > maxRetainedSlots {
+			end = max(end, slot+1-maxRetainedSlots)
+		}
+		return 0, end
 	}
 }
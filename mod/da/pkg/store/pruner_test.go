@@ -116,7 +116,7 @@ func TestBuildPruneRangeFn(t *testing.T) {
 				},
 			)
 			pruneFn := store.BuildPruneRangeFn[MockBeaconBlock](
-				cs,
+				cs, 0,
 			)
 			event := async.NewEvent[MockBeaconBlock](
 				context.Background(),
@@ -143,3 +143,37 @@ func TestBuildPruneRangeFn(t *testing.T) {
 		})
 	}
 }
+
+// TestBuildPruneRangeFnMaxRetainedSlots tests that the stricter of the DA
+// window and the cap on the retained slots wins.
+func TestBuildPruneRangeFnMaxRetainedSlots(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    32,
+			MinEpochsForBlobsSidecarsRequest: 5,
+		},
+	)
+	prune := func(maxRetainedSlots uint64, slot math.U64) uint64 {
+		start, end := store.BuildPruneRangeFn[MockBeaconBlock](
+			cs, maxRetainedSlots,
+		)(async.NewEvent(
+			context.Background(), async.EventID("mock"),
+			MockBeaconBlock{slot: slot},
+		))
+		require.Zero(t, start)
+		return end
+	}
+
+	// A cap stricter than the window of 160 slots retains the 100 most
+	// recent slots.
+	require.Equal(t, uint64(101), prune(100, 200))
+	require.Equal(t, uint64(1), prune(100, 100))
+	require.Zero(t, prune(100, 99))
+
+	// A cap looser than the window leaves the window in effect.
+	require.Equal(t, uint64(40), prune(1000, 200))
+	require.Equal(t, uint64(40), prune(161, 200))
+	require.Equal(t, uint64(40), prune(0, 200))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/sourcegraph/conc/iter"
 )
@@ -39,6 +40,16 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// compress determines whether sidecars are compressed before being
+	// persisted.
+	compress bool
+	// writes holds a token for each sidecar being written to disk, bounding
+	// the concurrent writes across all persists. It is nil if they are
+	// unbounded.
+	writes chan struct{}
+	// failWhenBusy determines whether persisting sidecars fails rather than
+	// waits while writes is full.
+	failWhenBusy bool
 }
 
 // New creates a new instance of the AvailabilityStore.
@@ -46,12 +57,19 @@ func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
 	logger log.Logger,
 	chainSpec common.ChainSpec,
+	cfg Config,
 ) *Store[BeaconBlockT] {
-	return &Store[BeaconBlockT]{
-		IndexDB:   db,
-		chainSpec: chainSpec,
-		logger:    logger,
+	s := &Store[BeaconBlockT]{
+		IndexDB:      db,
+		chainSpec:    chainSpec,
+		logger:       logger,
+		compress:     cfg.CompressSidecars,
+		failWhenBusy: cfg.FailWhenPersistBusy,
 	}
+	if cfg.MaxConcurrentPersists > 0 {
+		s.writes = make(chan struct{}, cfg.MaxConcurrentPersists)
+	}
+	return s
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
@@ -71,6 +89,48 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// GetBlobSidecar returns the sidecar stored for the given slot and
+// commitment, decompressing it if required.
+func (s *Store[BeaconBlockBodyT]) GetBlobSidecar(
+	slot math.Slot,
+	commitment eip4844.KZGCommitment,
+) (*types.BlobSidecar, error) {
+	bz, err := s.IndexDB.Get(slot.Unwrap(), commitment[:])
+	if err != nil {
+		return nil, err
+	}
+	return decodeSidecar(bz)
+}
+
+// GetBlobSidecars returns the sidecars stored for the given slot of every
+// commitment of the body, in the order of the commitments. It errors if any
+// of them is missing, e.g. because it has been pruned.
+func (s *Store[BeaconBlockBodyT]) GetBlobSidecars(
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) (*types.BlobSidecars, error) {
+	commitments := body.GetBlobKzgCommitments()
+	sidecars := make([]*types.BlobSidecar, 0, len(commitments))
+	for _, commitment := range commitments {
+		stored, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
+		if err != nil {
+			return nil, err
+		} else if !stored {
+			return nil, errors.Wrapf(
+				ErrSidecarNotFound, "slot: %d, commitment: %#x",
+				slot, commitment[:],
+			)
+		}
+
+		sidecar, err := s.GetBlobSidecar(slot, commitment)
+		if err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
@@ -94,18 +154,29 @@ func (s *Store[BeaconBlockT]) Persist(
 		return nil
 	}
 
-	// Store each sidecar in parallel.
-	if err := errors.Join(iter.Map(
+	// Store each sidecar in parallel, with no more goroutines than the
+	// writes they may wait for.
+	mapper := iter.Mapper[*types.BlobSidecar, error]{
+		MaxGoroutines: cap(s.writes),
+	}
+	if err := errors.Join(mapper.Map(
 		sidecars.Sidecars,
 		func(sidecar **types.BlobSidecar) error {
 			if *sidecar == nil {
 				return ErrAttemptedToStoreNilSidecar
 			}
 			sc := *sidecar
-			bz, err := sc.MarshalSSZ()
+			bz, err := encodeSidecar(sc, s.compress)
+			if err != nil {
+				return err
+			}
+
+			// Wait for, or fail without, a free write to bound the disk IO.
+			release, err := s.acquireWrite()
 			if err != nil {
 				return err
 			}
+			defer release()
 			return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
 		},
 	)...); err != nil {
@@ -117,3 +188,25 @@ func (s *Store[BeaconBlockT]) Persist(
 	)
 	return nil
 }
+
+// acquireWrite takes a token from the writes semaphore, returning the
+// function giving it back. It waits for a token unless failWhenBusy is set,
+// in which case it returns ErrPersistBusy if none is free.
+func (s *Store[BeaconBlockT]) acquireWrite() (func(), error) {
+	if s.writes == nil {
+		return func() {}, nil
+	}
+
+	if s.failWhenBusy {
+		select {
+		case s.writes <- struct{}{}:
+		default:
+			return nil, errors.Wrapf(
+				ErrPersistBusy, "max: %d", cap(s.writes),
+			)
+		}
+	} else {
+		s.writes <- struct{}{}
+	}
+	return func() { <-s.writes }, nil
+}
@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memIndexDB is an in-memory IndexDB used for testing.
+type memIndexDB struct {
+	values map[uint64]map[string][]byte
+}
+
+func newMemIndexDB() *memIndexDB {
+	return &memIndexDB{values: make(map[uint64]map[string][]byte)}
+}
+
+func (db *memIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	return db.values[index][string(key)], nil
+}
+
+func (db *memIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db.values[index][string(key)]
+	return ok, nil
+}
+
+func (db *memIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db.values[index] == nil {
+		db.values[index] = make(map[string][]byte)
+	}
+	db.values[index][string(key)] = value
+	return nil
+}
+
+func (db *memIndexDB) Prune(_ context.Context, start, end uint64) error {
+	for i := start; i < end; i++ {
+		delete(db.values, i)
+	}
+	return nil
+}
+
+// mockBody implements the BeaconBlockBody interface for testing.
+type mockBody struct {
+	commitments eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+func (b mockBody) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.commitments
+}
+
+func newTestSidecar(slot math.Slot) *types.BlobSidecar {
+	blob := eip4844.Blob{}
+	for i := range blob {
+		blob[i] = byte(i % 16)
+	}
+	return types.BuildBlobSidecar(
+		0,
+		&ctypes.BeaconBlockHeader{Slot: slot},
+		&blob,
+		eip4844.KZGCommitment{0x01},
+		eip4844.KZGProof{0x02},
+		make([]common.Root, 8),
+	)
+}
+
+func newTestStore(db store.IndexDB, compress bool) *store.Store[mockBody] {
+	return newTestStoreWithConfig(db, store.Config{CompressSidecars: compress})
+}
+
+func newTestStoreWithConfig(
+	db store.IndexDB, cfg store.Config,
+) *store.Store[mockBody] {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    32,
+			MinEpochsForBlobsSidecarsRequest: 4096,
+		},
+	)
+	return store.New[mockBody](db, noop.NewLogger[any](), cs, cfg)
+}
+
+func TestPersistRoundTrip(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		db := newMemIndexDB()
+		s := newTestStore(db, compress)
+		sc := newTestSidecar(1)
+
+		require.NoError(t, s.Persist(
+			1, &types.BlobSidecars{Sidecars: []*types.BlobSidecar{sc}},
+		))
+		require.True(t, s.IsDataAvailable(
+			context.Background(), 1, mockBody{commitments: []eip4844.KZGCommitment{
+				sc.KzgCommitment,
+			}},
+		))
+
+		got, err := s.GetBlobSidecar(1, sc.KzgCommitment)
+		require.NoError(t, err)
+		require.Equal(t, sc, got)
+
+		stored, err := db.Get(1, sc.KzgCommitment[:])
+		require.NoError(t, err)
+		if compress {
+			require.Less(t, len(stored), int(sc.SizeSSZ()))
+		} else {
+			require.Len(t, stored, int(sc.SizeSSZ())+1)
+		}
+	}
+}
+
+func TestGetBlobSidecarMixedEncodings(t *testing.T) {
+	db := newMemIndexDB()
+	sc := newTestSidecar(1)
+
+	// A sidecar written before the encoding header existed.
+	legacy, err := sc.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.Set(1, sc.KzgCommitment[:], legacy))
+
+	// A sidecar written by an uncompressed store.
+	raw := newTestSidecar(2)
+	require.NoError(t, newTestStore(db, false).Persist(
+		2, &types.BlobSidecars{Sidecars: []*types.BlobSidecar{raw}},
+	))
+
+	s := newTestStore(db, true)
+	for slot, want := range map[math.Slot]*types.BlobSidecar{
+		1: sc, 2: raw,
+	} {
+		got, err := s.GetBlobSidecar(slot, want.KzgCommitment)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	require.NoError(t, db.Set(3, sc.KzgCommitment[:], []byte{0xff, 0x00}))
+	_, err = s.GetBlobSidecar(3, sc.KzgCommitment)
+	require.ErrorIs(t, err, store.ErrUnknownSidecarEncoding)
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	s := newTestStore(newMemIndexDB(), true)
+	first, second := newTestSidecar(1), newTestSidecar(1)
+	second.Index = 1
+	second.KzgCommitment = eip4844.KZGCommitment{0x03}
+	require.NoError(t, s.Persist(
+		1, &types.BlobSidecars{Sidecars: []*types.BlobSidecar{first, second}},
+	))
+
+	// The sidecars are returned in the order of the commitments.
+	body := mockBody{commitments: []eip4844.KZGCommitment{
+		second.KzgCommitment, first.KzgCommitment,
+	}}
+	sidecars, err := s.GetBlobSidecars(1, body)
+	require.NoError(t, err)
+	require.Equal(t, []*types.BlobSidecar{second, first}, sidecars.Sidecars)
+
+	// A body without commitments has no sidecars.
+	sidecars, err = s.GetBlobSidecars(1, mockBody{})
+	require.NoError(t, err)
+	require.Zero(t, sidecars.Len())
+
+	// Missing sidecars are reported.
+	_, err = s.GetBlobSidecars(2, body)
+	require.ErrorIs(t, err, store.ErrSidecarNotFound)
+}
+
+// blockingIndexDB is an IndexDB whose writes wait until released, tracking
+// the number of concurrent writes.
+type blockingIndexDB struct {
+	*memIndexDB
+	mu      sync.Mutex
+	release chan struct{}
+	active  atomic.Int64
+	max     atomic.Int64
+}
+
+func (db *blockingIndexDB) Set(index uint64, key []byte, value []byte) error {
+	active := db.active.Add(1)
+	defer db.active.Add(-1)
+	for {
+		highest := db.max.Load()
+		if active <= highest || db.max.CompareAndSwap(highest, active) {
+			break
+		}
+	}
+
+	<-db.release
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.memIndexDB.Set(index, key, value)
+}
+
+func TestPersistBoundedConcurrency(t *testing.T) {
+	const limit, persists = 2, 8
+	db := &blockingIndexDB{
+		memIndexDB: newMemIndexDB(),
+		release:    make(chan struct{}),
+	}
+	s := newTestStoreWithConfig(db, store.Config{MaxConcurrentPersists: limit})
+
+	var wg sync.WaitGroup
+	for slot := range math.Slot(persists) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, s.Persist(slot, &types.BlobSidecars{
+				Sidecars: []*types.BlobSidecar{newTestSidecar(slot)},
+			}))
+		}()
+	}
+
+	// The semaphore saturates, holding back the remaining persists.
+	require.Eventually(t, func() bool {
+		return db.active.Load() == limit
+	}, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, int64(limit), db.active.Load())
+
+	close(db.release)
+	wg.Wait()
+	require.Equal(t, int64(limit), db.max.Load())
+	for slot := range math.Slot(persists) {
+		require.True(t, s.IsDataAvailable(
+			context.Background(), slot, mockBody{
+				commitments: []eip4844.KZGCommitment{{0x01}},
+			},
+		))
+	}
+}
+
+func TestPersistBoundedFanOut(t *testing.T) {
+	const limit, count = 2, 8
+	db := &blockingIndexDB{
+		memIndexDB: newMemIndexDB(),
+		release:    make(chan struct{}),
+	}
+	s := newTestStoreWithConfig(db, store.Config{MaxConcurrentPersists: limit})
+
+	body := mockBody{}
+	sidecars := &types.BlobSidecars{}
+	for i := range count {
+		sidecar := newTestSidecar(1)
+		sidecar.KzgCommitment = eip4844.KZGCommitment{byte(i)}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+		body.commitments = append(body.commitments, sidecar.KzgCommitment)
+	}
+
+	done := make(chan error)
+	go func() { done <- s.Persist(1, sidecars) }()
+
+	// The sidecars of a single block are also written at most limit at a
+	// time.
+	require.Eventually(t, func() bool {
+		return db.active.Load() == limit
+	}, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, int64(limit), db.active.Load())
+
+	close(db.release)
+	require.NoError(t, <-done)
+	require.Equal(t, int64(limit), db.max.Load())
+	require.True(t, s.IsDataAvailable(context.Background(), 1, body))
+}
+
+func TestPersistFailWhenBusy(t *testing.T) {
+	db := &blockingIndexDB{
+		memIndexDB: newMemIndexDB(),
+		release:    make(chan struct{}),
+	}
+	s := newTestStoreWithConfig(db, store.Config{
+		MaxConcurrentPersists: 1,
+		FailWhenPersistBusy:   true,
+	})
+	sidecars := &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{newTestSidecar(1)},
+	}
+
+	done := make(chan error)
+	go func() { done <- s.Persist(1, sidecars) }()
+	require.Eventually(t, func() bool {
+		return db.active.Load() == 1
+	}, time.Second, time.Millisecond)
+
+	// The semaphore is saturated, so the persist fails immediately.
+	require.ErrorIs(t, s.Persist(1, sidecars), store.ErrPersistBusy)
+
+	close(db.release)
+	require.NoError(t, <-done)
+	require.NoError(t, s.Persist(1, sidecars))
+}
@@ -21,6 +21,8 @@
 package store
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,9 +40,10 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
+	Get(index uint64, key []byte) ([]byte, error)
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
-	Prune(start uint64, end uint64) error
+	Prune(ctx context.Context, start uint64, end uint64) error
 }
 
 // BeaconBlockBody is the body of a beacon block.
@@ -28,6 +28,11 @@ var (
 	ErrSidecarContainsDifferingBlockRoots = errors.New(
 		"sidecar contains blobs with differing block roots")
 
+	// ErrSidecarBlockHeaderMismatch is returned when the block header of a
+	// sidecar does not match the block it is received with.
+	ErrSidecarBlockHeaderMismatch = errors.New(
+		"sidecar block header does not match block")
+
 	// ErrAttemptedToVerifyNilSidecar is returned when
 	// an attempt is made to store a nil sidecar.
 	ErrAttemptedToVerifyNilSidecar = errors.New(
@@ -23,6 +23,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/karalabe/ssz"
 	"github.com/sourcegraph/conc/iter"
 )
@@ -71,6 +72,24 @@ func (bs *BlobSidecars) ValidateBlockRoots() error {
 	return nil
 }
 
+// ValidateBlockRoot checks to make sure that the block header of every
+// sidecar is the header of the block with the given root.
+func (bs *BlobSidecars) ValidateBlockRoot(blockRoot common.Root) error {
+	for i, sc := range bs.Sidecars {
+		if sc == nil || sc.BeaconBlockHeader == nil {
+			return ErrAttemptedToVerifyNilSidecar
+		}
+		if root := sc.BeaconBlockHeader.HashTreeRoot(); root != blockRoot {
+			return errors.Wrapf(
+				ErrSidecarBlockHeaderMismatch,
+				"sidecar %d: header root %s, block root %s",
+				i, root, blockRoot,
+			)
+		}
+	}
+	return nil
+}
+
 // VerifyInclusionProofs verifies the inclusion proofs for all sidecars.
 func (bs *BlobSidecars) VerifyInclusionProofs(
 	kzgOffset uint64,
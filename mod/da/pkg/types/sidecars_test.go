@@ -143,3 +143,49 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestValidateBlockRoot(t *testing.T) {
+	blk := &ctypes.BeaconBlock{
+		Slot:          1,
+		ProposerIndex: 2,
+		ParentRoot:    common.Root{3},
+		StateRoot:     common.Root{4},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data:           &ctypes.Eth1Data{},
+			BlobKzgCommitments: []eip4844.KZGCommitment{{1}, {2}},
+		},
+	}
+
+	newSidecar := func(header *ctypes.BeaconBlockHeader) *types.BlobSidecar {
+		return types.BuildBlobSidecar(
+			math.U64(0),
+			header,
+			&eip4844.Blob{},
+			eip4844.KZGCommitment{},
+			eip4844.KZGProof{},
+			make([]common.Root, 8),
+		)
+	}
+
+	// Sidecars carrying the header of the block match it.
+	sidecars := types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{
+			newSidecar(blk.GetHeader()),
+			newSidecar(blk.GetHeader()),
+		},
+	}
+	require.NoError(t, sidecars.ValidateBlockRoot(blk.HashTreeRoot()))
+
+	// A sidecar carrying the header of another block is rejected.
+	otherHeader := blk.GetHeader()
+	otherHeader.StateRoot = common.Root{5}
+	sidecars.Sidecars = append(sidecars.Sidecars, newSidecar(otherHeader))
+	require.ErrorIs(
+		t,
+		sidecars.ValidateBlockRoot(blk.HashTreeRoot()),
+		types.ErrSidecarBlockHeaderMismatch,
+	)
+}
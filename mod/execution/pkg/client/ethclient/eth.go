@@ -27,6 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -44,6 +45,27 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	return result, nil
 }
 
+// BlockHashByNumber retrieves the hash of the canonical block of the given
+// number.
+func (ec *Client[ExecutionPayloadT]) BlockHashByNumber(
+	ctx context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	var result *struct {
+		Hash common.ExecutionHash `json:"hash"`
+	}
+	if err := ec.Call(
+		ctx, &result, "eth_getBlockByNumber",
+		hexutil.EncodeUint64(number.Unwrap()), false,
+	); err != nil {
+		return common.ExecutionHash{}, err
+	}
+	if result == nil {
+		return common.ExecutionHash{}, ErrNilResponse
+	}
+	return result.Hash, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
@@ -40,6 +40,8 @@ type WrappedBeaconDepositContract[
 ] struct {
 	// BeaconDepositContractFilterer is a pointer to the codegen ABI binding.
 	deposit.BeaconDepositContractFilterer
+	// address is the address of the deposit contract.
+	address common.ExecutionAddress
 }
 
 // NewWrappedBeaconDepositContract creates a new BeaconDepositContract.
@@ -68,10 +70,13 @@ func NewWrappedBeaconDepositContract[
 		WithdrawalCredentialsT,
 	]{
 		BeaconDepositContractFilterer: *contract,
+		address:                       address,
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads deposits from the deposit contract. Logs emitted by any
+// other address are ignored, such that a spoofed contract cannot inject
+// deposits.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
@@ -92,6 +97,10 @@ func (dc *WrappedBeaconDepositContract[
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
+		if common.ExecutionAddress(logs.Event.Raw.Address) != dc.address {
+			continue
+		}
+
 		var (
 			cred   bytes.B32
 			pubKey bytes.B48
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testFilterer returns its logs regardless of the queried address, as a
+// faulty or malicious execution client might.
+type testFilterer []types.Log
+
+func (f testFilterer) FilterLogs(
+	context.Context, ethereum.FilterQuery,
+) ([]types.Log, error) {
+	return f, nil
+}
+
+func (testFilterer) SubscribeFilterLogs(
+	context.Context, ethereum.FilterQuery, chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return nil, ethereum.NotFound
+}
+
+// testDepositLog returns a deposit log with the given index emitted by the
+// given address.
+func testDepositLog(
+	t *testing.T,
+	address common.ExecutionAddress,
+	index uint64,
+) types.Log {
+	t.Helper()
+	contractABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	require.NoError(t, err)
+
+	event := contractABI.Events["Deposit"]
+	data, err := event.Inputs.Pack(
+		make([]byte, 48), make([]byte, 32), uint64(32e9), make([]byte, 96),
+		index,
+	)
+	require.NoError(t, err)
+	return types.Log{
+		Address: gethcommon.Address(address),
+		Topics:  []gethcommon.Hash{event.ID},
+		Data:    data,
+	}
+}
+
+func TestReadDepositsFiltersContractAddress(t *testing.T) {
+	var (
+		address = common.NewExecutionAddressFromHex(
+			"0x4242424242424242424242424242424242424242",
+		)
+		spoofed = common.NewExecutionAddressFromHex(
+			"0x1337133713371337133713371337133713371337",
+		)
+		filterer = testFilterer{
+			testDepositLog(t, address, 0),
+			testDepositLog(t, spoofed, 1),
+			testDepositLog(t, address, 2),
+		}
+	)
+
+	contract, err := NewWrappedBeaconDepositContract[testDeposit, [32]byte](
+		address, filterer,
+	)
+	require.NoError(t, err)
+
+	// Only the deposits of the configured contract are read.
+	deposits, err := contract.ReadDeposits(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []testDeposit{{index: 0}, {index: 2}}, deposits)
+}
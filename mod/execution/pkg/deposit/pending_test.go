@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testBlock   struct{}
+	testBody    struct{}
+	testPayload struct{}
+	testDeposit struct{ index math.U64 }
+)
+
+func (testBlock) GetSlot() math.U64     { return 0 }
+func (testBlock) GetBody() testBody     { return testBody{} }
+func (testPayload) GetNumber() math.U64 { return 0 }
+
+func (testPayload) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (testBody) GetDeposits() []testDeposit { return nil }
+
+func (testBody) GetExecutionPayload() testPayload { return testPayload{} }
+
+func (testDeposit) New(
+	_ crypto.BLSPubkey, _ [32]byte, _ math.U64, _ crypto.BLSSignature,
+	index uint64,
+) testDeposit {
+	return testDeposit{index: math.U64(index)}
+}
+
+func (d testDeposit) GetIndex() math.U64 { return d.index }
+
+// testContract returns the deposits configured for each block.
+type testContract map[math.U64][]testDeposit
+
+func (c testContract) ReadDeposits(
+	_ context.Context,
+	blockNum math.U64,
+) ([]testDeposit, error) {
+	return c[blockNum], nil
+}
+
+// testExecutionClient returns the canonical hash configured for each block,
+// which is the block number unless overridden.
+type testExecutionClient map[math.U64]common.ExecutionHash
+
+func (c testExecutionClient) BlockHashByNumber(
+	_ context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	if hash, ok := c[number]; ok {
+		return hash, nil
+	}
+	return testHash(number), nil
+}
+
+func testHash(number math.U64) common.ExecutionHash {
+	return common.ExecutionHash{byte(number)}
+}
+
+type testPendingBlock struct {
+	hash     []byte
+	deposits []testDeposit
+}
+
+type testStore struct {
+	deposits []testDeposit
+	pending  map[uint64]testPendingBlock
+}
+
+func newTestStore() *testStore {
+	return &testStore{pending: make(map[uint64]testPendingBlock)}
+}
+
+func (s *testStore) Prune(context.Context, uint64, uint64) error {
+	return nil
+}
+
+func (s *testStore) EnqueueDeposits(deposits []testDeposit) error {
+	s.deposits = append(s.deposits, deposits...)
+	return nil
+}
+
+func (s *testStore) SetPendingDeposits(
+	blockNum uint64, blockHash []byte, deposits []testDeposit,
+) error {
+	s.pending[blockNum] = testPendingBlock{blockHash, deposits}
+	return nil
+}
+
+func (s *testStore) PendingDepositBlocks(maxBlock uint64) ([]uint64, error) {
+	var blocks []uint64
+	for _, blockNum := range slices.Sorted(maps.Keys(s.pending)) {
+		if blockNum <= maxBlock {
+			blocks = append(blocks, blockNum)
+		}
+	}
+	return blocks, nil
+}
+
+func (s *testStore) GetPendingDeposits(
+	blockNum uint64,
+) ([]byte, []testDeposit, error) {
+	return s.pending[blockNum].hash, s.pending[blockNum].deposits, nil
+}
+
+func (s *testStore) RemovePendingDeposits(blockNum uint64) error {
+	delete(s.pending, blockNum)
+	return nil
+}
+
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string) {}
+
+func newTestService(
+	ds *testStore,
+	dc testContract,
+	ec testExecutionClient,
+) *Service[testBlock, testBody, testDeposit, testPayload, [32]byte] {
+	return NewService[testBlock, testBody, testDeposit, testPayload](
+		noop.NewLogger[any](),
+		testFollowDistance,
+		testSink{},
+		ds,
+		dc,
+		ec,
+		nil,
+		Config{BufferFutureDeposits: true},
+	)
+}
+
+const testFollowDistance = 4
+
+func TestBufferFutureDeposits(t *testing.T) {
+	var (
+		ctx = context.Background()
+		ds  = newTestStore()
+		dc  = testContract{
+			10: {{index: 0}, {index: 1}},
+			12: {{index: 2}},
+		}
+		s = newTestService(ds, dc, testExecutionClient{})
+	)
+
+	// Deposits are read as soon as their block is seen but are beyond the
+	// follow distance, so they must not be applied.
+	s.fetchAndStoreDeposits(ctx, 10)
+	s.applyPendingDeposits(ctx, 10, testHash(10))
+	s.fetchAndStoreDeposits(ctx, 12)
+	s.applyPendingDeposits(ctx, 12, testHash(12))
+	require.Empty(t, ds.deposits)
+	require.Len(t, ds.pending, 2)
+
+	// The buffer is persisted, so it survives a restart of the service.
+	s = newTestService(ds, dc, testExecutionClient{})
+
+	// Block 10 is now within the follow distance and is applied, while
+	// block 12 stays buffered.
+	s.applyPendingDeposits(ctx, 10+testFollowDistance, testHash(14))
+	require.Equal(t, []testDeposit{{index: 0}, {index: 1}}, ds.deposits)
+	require.Len(t, ds.pending, 1)
+
+	// Block 12 is applied once the head is far enough ahead.
+	s.applyPendingDeposits(ctx, 12+testFollowDistance, testHash(16))
+	require.Equal(
+		t, []testDeposit{{index: 0}, {index: 1}, {index: 2}}, ds.deposits,
+	)
+	require.Empty(t, ds.pending)
+}
+
+func TestBufferFutureDepositsNonCanonical(t *testing.T) {
+	var (
+		ctx = context.Background()
+		ds  = newTestStore()
+		dc  = testContract{10: {{index: 0}, {index: 1}}}
+		ec  = testExecutionClient{}
+		s   = newTestService(ds, dc, ec)
+	)
+	s.fetchAndStoreDeposits(ctx, 10)
+
+	// Nothing is released while the execution client disagrees with the
+	// finalized head.
+	s.applyPendingDeposits(ctx, 14, common.ExecutionHash{0xff})
+	require.Empty(t, ds.deposits)
+	require.Len(t, ds.pending, 1)
+
+	// Block 10 is reorged out, so its deposits are read again from the
+	// canonical block before they are applied.
+	ec[10] = common.ExecutionHash{0xaa}
+	dc[10] = []testDeposit{{index: 0}}
+	s.applyPendingDeposits(ctx, 14, testHash(14))
+	require.Equal(t, []testDeposit{{index: 0}}, ds.deposits)
+	require.Empty(t, ds.pending)
+}
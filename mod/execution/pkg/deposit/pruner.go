@@ -22,10 +22,11 @@ package deposit
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BuildPruneRangeFn builds the function returning the range of deposits to
+// prune on a finalized block, which are the deposits up to and including the
+// last deposit of the block, as deposits are applied in order.
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT interface {
@@ -33,19 +34,12 @@ func BuildPruneRangeFn[
 	},
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 	WithdrawalCredentialsT any,
-](cs common.ChainSpec) func(async.Event[BeaconBlockT]) (uint64, uint64) {
+]() func(async.Event[BeaconBlockT]) (uint64, uint64) {
 	return func(event async.Event[BeaconBlockT]) (uint64, uint64) {
 		deposits := event.Data().GetBody().GetDeposits()
-		if len(deposits) == 0 || cs.MaxDepositsPerBlock() == 0 {
+		if len(deposits) == 0 {
 			return 0, 0
 		}
-		index := deposits[len(deposits)-1].GetIndex()
-
-		end := min(index.Unwrap(), cs.MaxDepositsPerBlock())
-		if index < math.U64(cs.MaxDepositsPerBlock()) {
-			return 0, end
-		}
-
-		return index.Unwrap() - cs.MaxDepositsPerBlock(), end
+		return 0, deposits[len(deposits)-1].GetIndex().Unwrap() + 1
 	}
 }
@@ -46,6 +46,9 @@ type Service[
 	eth1FollowDistance math.U64
 	// dc is the contract interface for interacting with the deposit contract.
 	dc Contract[DepositT]
+	// ec is the execution client used to verify that buffered deposits were
+	// read from canonical execution blocks.
+	ec ExecutionClient
 	// ds is the deposit store that stores deposits.
 	ds Store[DepositT]
 	// dispatcher is the dispatcher for the service.
@@ -60,6 +63,9 @@ type Service[
 	// failedBlocks is a map of blocks that failed to be processed
 	// and should be retried.
 	failedBlocks map[math.U64]struct{}
+	// bufferFutureDeposits enables reading deposits ahead of the eth1
+	// follow distance.
+	bufferFutureDeposits bool
 }
 
 // NewService creates a new instance of the Service struct.
@@ -75,7 +81,9 @@ func NewService[
 	telemetrySink TelemetrySink,
 	ds Store[DepositT],
 	dc Contract[DepositT],
+	ec ExecutionClient,
 	dispatcher asynctypes.EventDispatcher,
+	cfg Config,
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, DepositT,
 	ExecutionPayloadT, WithdrawalCredentialsT,
@@ -85,6 +93,7 @@ func NewService[
 		ExecutionPayloadT, WithdrawalCredentialsT,
 	]{
 		dc:                      dc,
+		ec:                      ec,
 		dispatcher:              dispatcher,
 		ds:                      ds,
 		eth1FollowDistance:      eth1FollowDistance,
@@ -92,6 +101,7 @@ func NewService[
 		subFinalizedBlockEvents: make(chan async.Event[BeaconBlockT]),
 		logger:                  logger,
 		metrics:                 newMetrics(telemetrySink),
+		bufferFutureDeposits:    cfg.BufferFutureDeposits,
 	}
 }
 
@@ -21,10 +21,12 @@
 package deposit
 
 import (
+	"bytes"
 	"context"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -36,8 +38,14 @@ const defaultRetryInterval = 20 * time.Second
 func (s *Service[
 	BeaconBlockT, _, _, _, _,
 ]) depositFetcher(ctx context.Context, event async.Event[BeaconBlockT]) {
-	blockNum := event.Data().GetBody().GetExecutionPayload().GetNumber()
-	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+	payload := event.Data().GetBody().GetExecutionPayload()
+	blockNum := payload.GetNumber()
+	if !s.bufferFutureDeposits {
+		s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+		return
+	}
+	s.fetchAndStoreDeposits(ctx, blockNum)
+	s.applyPendingDeposits(ctx, blockNum, payload.GetBlockHash())
 }
 
 // depositCatchupFetcher fetches deposits for blocks that failed to be
@@ -73,6 +81,11 @@ func (s *Service[
 func (s *Service[
 	_, _, _, _, _,
 ]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
+	if s.bufferFutureDeposits {
+		s.bufferDeposits(ctx, blockNum)
+		return
+	}
+
 	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
 	if err != nil {
 		s.logger.Error("Failed to read deposits", "error", err)
@@ -96,3 +109,128 @@ func (s *Service[
 
 	s.clearFailedBlock(blockNum)
 }
+
+// bufferDeposits reads the deposits of the canonical execution block of the
+// given number and persists them, along with the hash of the block, until the
+// block is behind the eth1 follow distance, see applyPendingDeposits.
+func (s *Service[
+	_, _, _, _, _,
+]) bufferDeposits(ctx context.Context, blockNum math.U64) {
+	// The hash is read before the deposits, so that a reorg in between
+	// leaves a stale hash behind which is caught once the deposits are
+	// released.
+	blockHash, err := s.ec.BlockHashByNumber(ctx, blockNum)
+	if err != nil {
+		s.logger.Error("Failed to read block hash", "error", err)
+		s.metrics.markFailedToGetBlockLogs(blockNum)
+		s.markFailedBlock(blockNum)
+		return
+	}
+
+	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+	if err != nil {
+		s.logger.Error("Failed to read deposits", "error", err)
+		s.metrics.markFailedToGetBlockLogs(blockNum)
+		s.markFailedBlock(blockNum)
+		return
+	}
+
+	if len(deposits) > 0 {
+		s.logger.Info(
+			"Buffering deposits from execution layer",
+			"block", blockNum, "deposits", len(deposits),
+		)
+	}
+
+	if err = s.ds.SetPendingDeposits(
+		blockNum.Unwrap(), blockHash[:], deposits,
+	); err != nil {
+		s.logger.Error("Failed to buffer deposits", "error", err)
+		s.markFailedBlock(blockNum)
+		return
+	}
+
+	s.clearFailedBlock(blockNum)
+}
+
+// applyPendingDeposits stores the buffered deposits of every execution block
+// that is at least the eth1 follow distance behind the given head, the
+// execution block of the last finalized beacon block. Nothing is released
+// unless the execution client agrees with the head, and the deposits of a
+// block that is no longer canonical are read again before they are stored.
+func (s *Service[
+	_, _, _, _, _,
+]) applyPendingDeposits(
+	ctx context.Context,
+	head math.U64,
+	headHash common.ExecutionHash,
+) {
+	if head < s.eth1FollowDistance {
+		return
+	}
+
+	canonical, err := s.ec.BlockHashByNumber(ctx, head)
+	if err != nil {
+		s.logger.Error("Failed to read block hash", "error", err)
+		return
+	}
+	if canonical != headHash {
+		// The deposits stay buffered and are retried on the next head.
+		s.logger.Warn(
+			"Execution client is not on the finalized chain",
+			"block", head, "expected", headHash, "actual", canonical,
+		)
+		return
+	}
+
+	blocks, err := s.ds.PendingDepositBlocks(
+		(head - s.eth1FollowDistance).Unwrap(),
+	)
+	if err != nil {
+		s.logger.Error("Failed to read buffered deposits", "error", err)
+		return
+	}
+
+	for _, blockNum := range blocks {
+		if err = s.applyBufferedBlock(ctx, math.U64(blockNum)); err != nil {
+			// This and all later blocks stay buffered, so that deposits
+			// are always stored in order.
+			s.logger.Error(
+				"Failed to store buffered deposits",
+				"block", blockNum, "error", err,
+			)
+			return
+		}
+	}
+}
+
+// applyBufferedBlock stores the buffered deposits of the given execution
+// block, reading them again if the block is no longer canonical, and drops
+// the block from the buffer.
+func (s *Service[
+	_, _, _, _, _,
+]) applyBufferedBlock(ctx context.Context, blockNum math.U64) error {
+	blockHash, deposits, err := s.ds.GetPendingDeposits(blockNum.Unwrap())
+	if err != nil {
+		return err
+	}
+
+	canonical, err := s.ec.BlockHashByNumber(ctx, blockNum)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockHash, canonical[:]) {
+		s.logger.Warn(
+			"Buffered deposits were read from a reorged block",
+			"block", blockNum, "canonical", canonical,
+		)
+		if deposits, err = s.dc.ReadDeposits(ctx, blockNum); err != nil {
+			return err
+		}
+	}
+
+	if err = s.ds.EnqueueDeposits(deposits); err != nil {
+		return err
+	}
+	return s.ds.RemovePendingDeposits(blockNum.Unwrap())
+}
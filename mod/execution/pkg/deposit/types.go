@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -58,6 +59,7 @@ type BlockEvent[
 // ExecutionPayload is an interface for execution payloads.
 type ExecutionPayload interface {
 	GetNumber() math.U64
+	GetBlockHash() common.ExecutionHash
 }
 
 // Contract is the ABI for the deposit contract.
@@ -69,6 +71,17 @@ type Contract[DepositT any] interface {
 	) ([]DepositT, error)
 }
 
+// ExecutionClient is an interface for reading the canonical chain of the
+// execution client.
+type ExecutionClient interface {
+	// BlockHashByNumber returns the hash of the canonical execution block of
+	// the given number.
+	BlockHashByNumber(
+		ctx context.Context,
+		number math.U64,
+	) (common.ExecutionHash, error)
+}
+
 // Deposit is an interface for deposits.
 type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 	// New creates a new deposit.
@@ -86,9 +99,23 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 // Store defines the interface for managing deposit operations.
 type Store[DepositT any] interface {
 	// Prune prunes the deposit store of [start, end)
-	Prune(index uint64, numPrune uint64) error
+	Prune(ctx context.Context, start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// SetPendingDeposits buffers the deposits read from the execution block
+	// of the given number and hash.
+	SetPendingDeposits(
+		blockNum uint64, blockHash []byte, deposits []DepositT,
+	) error
+	// PendingDepositBlocks returns the numbers of the execution blocks with
+	// buffered deposits up to and including maxBlock, in ascending order.
+	PendingDepositBlocks(maxBlock uint64) ([]uint64, error)
+	// GetPendingDeposits returns the hash of the execution block of the
+	// given number and the deposits buffered for it.
+	GetPendingDeposits(blockNum uint64) ([]byte, []DepositT, error)
+	// RemovePendingDeposits drops the execution block of the given number
+	// and its deposits from the buffer.
+	RemovePendingDeposits(blockNum uint64) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
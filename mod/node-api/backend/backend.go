@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 )
 
 // Backend is the db access layer for the beacon node-api.
@@ -34,7 +35,7 @@ type Backend[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -60,11 +61,14 @@ type Backend[
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
-	sb   StorageBackendT
-	cs   common.ChainSpec
-	node NodeT
+	sb      StorageBackendT
+	cs      common.ChainSpec
+	node    NodeT
+	queries queryLimiter
 
 	sp StateProcessor[BeaconStateT]
+	// shuffler selects the members of beacon committees.
+	shuffler core.Shuffler
 }
 
 // New creates and returns a new Backend instance.
@@ -72,7 +76,7 @@ func New[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -101,6 +105,7 @@ func New[
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	maxConcurrentQueries int,
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
@@ -115,9 +120,11 @@ func New[
 		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
 		WithdrawalCredentialsT,
 	]{
-		sb: storageBackend,
-		cs: cs,
-		sp: sp,
+		sb:       storageBackend,
+		cs:       cs,
+		sp:       sp,
+		queries:  newQueryLimiter(maxConcurrentQueries),
+		shuffler: core.NewSwapOrNotShuffler(core.ShuffleRoundCount),
 	}
 }
 
@@ -159,50 +166,73 @@ func (b *Backend[
 }
 
 // stateFromSlot returns the state at the given slot, after also processing the
-// next slot to ensure the returned beacon state is up to date.
+// next slot to ensure the returned beacon state is up to date. The returned
+// function must be called once the state is no longer used.
 func (b *Backend[
 	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) stateFromSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
-	var (
-		st  BeaconStateT
-		err error
-	)
-	if st, slot, err = b.stateFromSlotRaw(slot); err != nil {
-		return st, slot, err
+]) stateFromSlot(slot math.Slot) (BeaconStateT, math.Slot, func(), error) {
+	st, slot, release, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return st, slot, release, err
 	}
 
 	// Process the slot to update the latest state and block roots.
 	if _, err = b.sp.ProcessSlots(st, slot+1); err != nil {
-		return st, slot, err
+		release()
+		return st, slot, noRelease, err
 	}
 
 	// We need to set the slot on the state back since ProcessSlot will update
 	// it to slot + 1.
-	err = st.SetSlot(slot)
-	return st, slot, err
+	if err = st.SetSlot(slot); err != nil {
+		release()
+		return st, slot, noRelease, err
+	}
+	return st, slot, release, nil
+}
+
+// stateFromHeight returns the state at the given height using query context,
+// where a height of 0 is the latest height. Unlike slots, heights are passed
+// to the query context as is.
+//
+// The query context holds a slot of the query limiter for as long as it is
+// used, which is until the returned function is called, as every query
+// context keeps a cache multi-store of its height open.
+func (b *Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) stateFromHeight(height int64) (BeaconStateT, func(), error) {
+	var st BeaconStateT
+	if err := b.queries.acquire(); err != nil {
+		return st, noRelease, err
+	}
+	queryCtx, err := b.node.CreateQueryContext(height, false)
+	if err != nil {
+		b.queries.release()
+		return st, noRelease, err
+	}
+	return b.sb.StateFromContext(queryCtx), b.queries.release, nil
 }
 
 // stateFromSlotRaw returns the state at the given slot using query context,
 // resolving an input slot of 0 to the latest slot. It does not process the
-// next slot on the beacon state.
+// next slot on the beacon state. The returned function must be called once
+// the state is no longer used.
 func (b *Backend[
 	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, error) {
-	var st BeaconStateT
+]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, func(), error) {
 	//#nosec:G701 // not an issue in practice.
-	queryCtx, err := b.node.CreateQueryContext(int64(slot), false)
+	st, release, err := b.stateFromHeight(int64(slot))
 	if err != nil {
-		return st, slot, err
+		return st, slot, release, err
 	}
-	st = b.sb.StateFromContext(queryCtx)
 
 	// If using height 0 for the query context, make sure to return the latest
 	// slot.
 	if slot == 0 {
-		slot, err = st.GetSlot()
-		if err != nil {
-			return st, slot, err
+		if slot, err = st.GetSlot(); err != nil {
+			release()
+			return st, slot, noRelease, err
 		}
 	}
-	return st, slot, err
+	return st, slot, release, nil
 }
@@ -21,6 +21,8 @@
 package backend
 
 import (
+	"fmt"
+
 	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -33,10 +35,11 @@ func (b Backend[
 ]) BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error) {
 	var blockHeader BeaconBlockHeaderT
 
-	st, _, err := b.stateFromSlot(slot)
+	st, _, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return blockHeader, err
 	}
+	defer release()
 
 	blockHeader, err = st.GetLatestBlockHeader()
 	return blockHeader, err
@@ -46,16 +49,86 @@ func (b Backend[
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
-	st, slot, err := b.stateFromSlot(slot)
+	st, slot, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Root{}, err
 	}
+	defer release()
 
 	// As calculated by the beacon chain. Ideally, this logic
 	// should be abstracted by the beacon chain.
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
+// ReconstructBlock returns the block at the given slot along with its blob
+// sidecars, combining the block store and the availability store. It errors
+// if the block or any of its sidecars is missing or has been pruned.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _,
+]) ReconstructBlock(slot math.Slot) (BeaconBlockT, BlobSidecarsT, error) {
+	return reconstructBlock(
+		b.sb.BlockStore(), b.sb.AvailabilityStore(), slot,
+	)
+}
+
+// ReconstructedBlockAtSlot returns the block at the given slot along with its
+// blob sidecars, where a slot of 0 is the latest slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ReconstructedBlockAtSlot(
+	slot math.Slot,
+) (*types.ReconstructedBlockData, error) {
+	if slot == 0 {
+		_, latest, release, err := b.stateFromSlotRaw(slot)
+		if err != nil {
+			return nil, err
+		}
+		release()
+		slot = latest
+	}
+
+	blk, sidecars, err := b.ReconstructBlock(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReconstructedBlockData{
+		Block:        blk,
+		BlobSidecars: sidecars,
+	}, nil
+}
+
+// sidecarStore is the subset of the availability store used to reconstruct
+// blocks.
+type sidecarStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
+	GetBlobSidecars(math.Slot, BeaconBlockBodyT) (BlobSidecarsT, error)
+}
+
+// reconstructBlock returns the block stored at the given slot and the
+// sidecars stored for its commitments.
+func reconstructBlock[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT, BlobSidecarsT any,
+](
+	blocks BlockStore[BeaconBlockT],
+	sidecars sidecarStore[BeaconBlockBodyT, BlobSidecarsT],
+	slot math.Slot,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	var (
+		blk BeaconBlockT
+		scs BlobSidecarsT
+	)
+	blk, err := blocks.GetBlockBySlot(slot)
+	if err != nil {
+		return blk, scs, fmt.Errorf("block at slot %d: %w", slot, err)
+	}
+	scs, err = sidecars.GetBlobSidecars(slot, blk.GetBody())
+	if err != nil {
+		return blk, scs, fmt.Errorf("sidecars at slot %d: %w", slot, err)
+	}
+	return blk, scs, nil
+}
+
 // TODO: Implement this.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
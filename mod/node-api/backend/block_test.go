@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestNotFound = errors.New("not found")
+
+// testBlockStore is an in-memory block store retaining full blocks.
+type testBlockStore struct {
+	BlockStore[*types.BeaconBlock]
+	blocks map[math.Slot]*types.BeaconBlock
+}
+
+func (s *testBlockStore) GetBlockBySlot(
+	slot math.Slot,
+) (*types.BeaconBlock, error) {
+	blk, ok := s.blocks[slot]
+	if !ok {
+		return nil, errTestNotFound
+	}
+	return blk, nil
+}
+
+// testSidecarStore is an in-memory availability store whose sidecars are the
+// names of their blobs.
+type testSidecarStore map[math.Slot]map[eip4844.KZGCommitment]string
+
+func (s testSidecarStore) GetBlobSidecars(
+	slot math.Slot,
+	body *types.BeaconBlockBody,
+) ([]string, error) {
+	sidecars := make([]string, 0, len(body.BlobKzgCommitments))
+	for _, commitment := range body.BlobKzgCommitments {
+		sidecar, ok := s[slot][commitment]
+		if !ok {
+			return nil, fmt.Errorf("%w: %x", errTestNotFound, commitment[:])
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+func TestReconstructBlock(t *testing.T) {
+	var (
+		blk = &types.BeaconBlock{
+			Slot: 2,
+			Body: &types.BeaconBlockBody{
+				BlobKzgCommitments: []eip4844.KZGCommitment{{0x01}, {0x02}},
+			},
+		}
+		blocks = &testBlockStore{
+			blocks: map[math.Slot]*types.BeaconBlock{
+				1: {Slot: 1, Body: &types.BeaconBlockBody{}},
+				2: blk,
+				3: blk,
+			},
+		}
+		sidecars = testSidecarStore{
+			2: {{0x01}: "first", {0x02}: "second"},
+		}
+	)
+
+	// The block is combined with the sidecars of its commitments.
+	got, scs, err := reconstructBlock(blocks, sidecars, 2)
+	require.NoError(t, err)
+	require.Equal(t, blk, got)
+	require.Equal(t, []string{"first", "second"}, scs)
+
+	// A block without commitments has no sidecars.
+	got, scs, err = reconstructBlock(blocks, sidecars, 1)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), got.Slot)
+	require.Empty(t, scs)
+
+	// A missing block or missing sidecars are reported.
+	_, _, err = reconstructBlock(blocks, sidecars, 4)
+	require.ErrorIs(t, err, errTestNotFound)
+	_, _, err = reconstructBlock(blocks, sidecars, 3)
+	require.ErrorIs(t, err, errTestNotFound)
+}
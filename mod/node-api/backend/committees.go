@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+)
+
+// ErrSlotNotInEpoch is returned when a committee is queried for a slot which
+// is not part of the given epoch.
+var ErrSlotNotInEpoch = errors.New("slot not in epoch")
+
+// committeeValidator is a validator as accessed when deriving committees.
+type committeeValidator interface {
+	IsInValidatorSet(math.Epoch) bool
+	GetPubkey() crypto.BLSPubkey
+	GetEffectiveBalance() math.Gwei
+}
+
+// committeeState is the part of the beacon state committees are derived
+// from.
+type committeeState[ValidatorT committeeValidator] interface {
+	GetSlot() (math.Slot, error)
+	GetRandaoMixAtIndex(uint64) (common.Bytes32, error)
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+	GetGenesisValidatorsRoot() (common.Root, error)
+	CommitteeCountPerSlot(math.Epoch) (uint64, error)
+}
+
+// CommitteeMembers returns the indices of the members of the beacon committee
+// with the given index in the slot of the epoch, as derived from the state at
+// the given height, where a height of 0 is the latest height. Only the
+// committees of the epochs around that of the state, whose validators and
+// seed it retains, can be derived.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) CommitteeMembers(
+	height int64,
+	epoch math.Epoch,
+	slot math.Slot,
+	committeeIndex uint64,
+) ([]math.ValidatorIndex, error) {
+	if height < 0 {
+		return nil, types.ErrInvalidRequest
+	}
+
+	st, release, err := b.stateFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return committeeMembers[ValidatorT](
+		st, b.cs, b.shuffler, epoch, slot, committeeIndex,
+	)
+}
+
+// committeeMembers returns the indices of the members of the beacon committee
+// with the given index in the slot of the epoch, as derived from the state.
+func committeeMembers[ValidatorT committeeValidator](
+	st committeeState[ValidatorT],
+	cs common.ChainSpec,
+	shuffler core.Shuffler,
+	epoch math.Epoch,
+	slot math.Slot,
+	committeeIndex uint64,
+) ([]math.ValidatorIndex, error) {
+	if cs.SlotToEpoch(slot) != epoch {
+		return nil, fmt.Errorf(
+			"%w: slot: %d, epoch: %d", ErrSlotNotInEpoch, slot, epoch,
+		)
+	}
+	return core.BeaconCommittee[ValidatorT](
+		st, cs, shuffler, slot, committeeIndex,
+	)
+}
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testKVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	testBeaconStateMarshallable = types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]
+
+	testStateDB = state.StateDB[
+		*types.BeaconBlockHeader,
+		*testBeaconStateMarshallable,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("backend-tests")
+
+// testCoreKVStore adapts the store of the context to the core store interface.
+// The node-core adapter cannot be used here as node-core imports the
+// backend.
+type testCoreKVStore struct {
+	storetypes.KVStore
+}
+
+func (s testCoreKVStore) Get(key []byte) ([]byte, error) {
+	return s.KVStore.Get(key), nil
+}
+
+func (s testCoreKVStore) Has(key []byte) (bool, error) {
+	return s.KVStore.Has(key), nil
+}
+
+func (s testCoreKVStore) Set(key, value []byte) error {
+	s.KVStore.Set(key, value)
+	return nil
+}
+
+func (s testCoreKVStore) Delete(key []byte) error {
+	s.KVStore.Delete(key)
+	return nil
+}
+
+func (s testCoreKVStore) Iterator(
+	start, end []byte,
+) (corestore.Iterator, error) {
+	return s.KVStore.Iterator(start, end), nil
+}
+
+func (s testCoreKVStore) ReverseIterator(
+	start, end []byte,
+) (corestore.Iterator, error) {
+	return s.KVStore.ReverseIterator(start, end), nil
+}
+
+type testKVStoreService struct{}
+
+func (testKVStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	return testCoreKVStore{sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey)}
+}
+
+// newTestStateDB returns a StateDB backed by an in-memory store.
+func newTestStateDB(t testing.TB, cs common.ChainSpec) *testStateDB {
+	t.Helper()
+	var (
+		nopLog = log.NewNopLogger()
+		cms    = store.NewCommitMultiStore(
+			dbm.NewMemDB(), nopLog, metrics.NewNoOpMetrics(),
+		)
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, true, nopLog)
+	kvStore := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		testKVStoreService{},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	return (&testStateDB{}).NewFromDB(kvStore.WithContext(ctx), cs)
+}
+
+// referenceCommittee computes the committee with the given index in the
+// slot straight from the pseudo-code of the Ethereum 2.0 specification,
+// independently of the state processing code.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_beacon_committee
+//
+//nolint:lll // link.
+func referenceCommittee(
+	t *testing.T,
+	st *testStateDB,
+	cs common.ChainSpec,
+	slot math.Slot,
+	index uint64,
+) []math.ValidatorIndex {
+	t.Helper()
+	epoch := cs.SlotToEpoch(slot)
+
+	vals, err := st.GetValidators()
+	require.NoError(t, err)
+	var active []math.ValidatorIndex
+	for i, val := range vals {
+		if val.EffectiveBalance > 0 && epoch < val.ExitEpoch {
+			active = append(active, math.ValidatorIndex(i))
+		}
+	}
+
+	mix, err := st.GetRandaoMixAtIndex(
+		(epoch.Unwrap() + cs.EpochsPerHistoricalVector() -
+			cs.MinSeedLookahead() - 1) % cs.EpochsPerHistoricalVector(),
+	)
+	require.NoError(t, err)
+	domain := cs.DomainTypeAttester()
+	seed := sha256.Sum256(append(append(
+		domain[:], binary.LittleEndian.AppendUint64(nil, epoch.Unwrap())...,
+	), mix[:]...))
+
+	var (
+		count             = uint64(len(active))
+		committeesPerSlot = max(1, min(
+			cs.MaxCommitteesPerSlot(),
+			count/cs.SlotsPerEpoch()/cs.TargetCommitteeSize(),
+		))
+		committees = committeesPerSlot * cs.SlotsPerEpoch()
+		committee  = (slot.Unwrap()%cs.SlotsPerEpoch())*committeesPerSlot +
+			index
+		members []math.ValidatorIndex
+	)
+	for i := count * committee / committees; i < count*(committee+1)/
+		committees; i++ {
+		members = append(members, active[shuffledIndex(i, count, seed)])
+	}
+	return members
+}
+
+// shuffledIndex is compute_shuffled_index of the Ethereum 2.0 specification.
+func shuffledIndex(index, count uint64, seed [32]byte) uint64 {
+	for round := range core.ShuffleRoundCount {
+		h := sha256.Sum256(append(seed[:], round))
+		pivot := binary.LittleEndian.Uint64(h[:8]) % count
+		flip := (pivot + count - index) % count
+		position := max(index, flip)
+		source := sha256.Sum256(binary.LittleEndian.AppendUint32(
+			append(seed[:], round), uint32(position/256),
+		))
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index
+}
+
+func TestCommitteeMembers(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch:             2,
+				MinSeedLookahead:          1,
+				EpochsPerHistoricalVector: 8,
+				EffectiveBalanceIncrement: 1e9,
+				MaxEffectiveBalance:       32e9,
+				TargetCommitteeSize:       4,
+				MaxCommitteesPerSlot:      4,
+				DomainTypeAttester:        common.DomainType{0x01},
+			},
+		)
+		shuffler = core.NewSwapOrNotShuffler(core.ShuffleRoundCount)
+		st       = newTestStateDB(t, cs)
+	)
+
+	// The state at slot 9 of epoch 4, with 16 validators deposited as the
+	// chain does, the last of which exited in epoch 4.
+	require.NoError(t, st.SetSlot(9))
+	for i := range cs.EpochsPerHistoricalVector() {
+		require.NoError(t, st.UpdateRandaoMixAtIndex(
+			i, common.Bytes32{byte(i + 1)},
+		))
+	}
+	for i := range 16 {
+		val := types.NewValidatorFromDeposit(
+			crypto.BLSPubkey{byte(i)},
+			types.WithdrawalCredentials{},
+			32e9,
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			math.Gwei(cs.MaxEffectiveBalance()),
+		)
+		require.NoError(t, st.AddValidator(val))
+		if i == 15 {
+			val.ExitEpoch = 4
+			require.NoError(t, st.UpdateValidatorAtIndex(
+				math.ValidatorIndex(i), val,
+			))
+		}
+	}
+
+	// With 16 validators in epoch 3 there are two committees per slot, and
+	// with 15 in epochs 4 and 5 a single one. The members match the
+	// reference committees, and the committees of an epoch partition its
+	// active validators.
+	for epoch, perSlot := range map[math.Epoch]uint64{3: 2, 4: 1, 5: 1} {
+		seen := make(map[math.ValidatorIndex]struct{})
+		start := math.Slot(epoch.Unwrap() * cs.SlotsPerEpoch())
+		for slot := start; slot < start+2; slot++ {
+			for index := range perSlot {
+				members, err := committeeMembers(
+					st, cs, shuffler, epoch, slot, index,
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t, referenceCommittee(t, st, cs, slot, index), members,
+				)
+				for _, member := range members {
+					seen[member] = struct{}{}
+				}
+			}
+			_, err := committeeMembers(
+				st, cs, shuffler, epoch, slot, perSlot,
+			)
+			require.ErrorIs(t, err, core.ErrInvalidCommitteeIndex)
+		}
+		if epoch == 3 {
+			require.Len(t, seen, 16)
+		} else {
+			require.Len(t, seen, 15)
+			require.NotContains(t, seen, math.ValidatorIndex(15))
+		}
+	}
+
+	// The slot must be part of the epoch, which must be retained.
+	_, err := committeeMembers(st, cs, shuffler, 4, 10, 0)
+	require.ErrorIs(t, err, ErrSlotNotInEpoch)
+	_, err = committeeMembers(st, cs, shuffler, 2, 4, 0)
+	require.ErrorIs(t, err, core.ErrCommitteeEpochOutOfRange)
+}
@@ -30,9 +30,10 @@ func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GenesisValidatorsRoot(slot math.Slot) (common.Root, error) {
 	// needs genesis_time and gensis_fork_version
-	st, _, err := b.stateFromSlot(slot)
+	st, _, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Root{}, err
 	}
+	defer release()
 	return st.GetGenesisValidatorsRoot()
 }
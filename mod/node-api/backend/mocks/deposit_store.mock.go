@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DepositStore is an autogenerated mock type for the DepositStore type
 type DepositStore[DepositT any] struct {
@@ -122,17 +126,17 @@ func (_c *DepositStore_GetDepositsByIndex_Call[DepositT]) RunAndReturn(run func(
 	return _c
 }
 
-// Prune provides a mock function with given fields: start, end
-func (_m *DepositStore[DepositT]) Prune(start uint64, end uint64) error {
-	ret := _m.Called(start, end)
+// Prune provides a mock function with given fields: ctx, start, end
+func (_m *DepositStore[DepositT]) Prune(ctx context.Context, start uint64, end uint64) error {
+	ret := _m.Called(ctx, start, end)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Prune")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
-		r0 = rf(start, end)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, start, end)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -146,15 +150,16 @@ type DepositStore_Prune_Call[DepositT any] struct {
 }
 
 // Prune is a helper method to define mock.On call
+//   - ctx context.Context
 //   - start uint64
 //   - end uint64
-func (_e *DepositStore_Expecter[DepositT]) Prune(start interface{}, end interface{}) *DepositStore_Prune_Call[DepositT] {
-	return &DepositStore_Prune_Call[DepositT]{Call: _e.mock.On("Prune", start, end)}
+func (_e *DepositStore_Expecter[DepositT]) Prune(ctx interface{}, start interface{}, end interface{}) *DepositStore_Prune_Call[DepositT] {
+	return &DepositStore_Prune_Call[DepositT]{Call: _e.mock.On("Prune", ctx, start, end)}
 }
 
-func (_c *DepositStore_Prune_Call[DepositT]) Run(run func(start uint64, end uint64)) *DepositStore_Prune_Call[DepositT] {
+func (_c *DepositStore_Prune_Call[DepositT]) Run(run func(ctx context.Context, start uint64, end uint64)) *DepositStore_Prune_Call[DepositT] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint64), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
 	})
 	return _c
 }
@@ -164,7 +169,7 @@ func (_c *DepositStore_Prune_Call[DepositT]) Return(_a0 error) *DepositStore_Pru
 	return _c
 }
 
-func (_c *DepositStore_Prune_Call[DepositT]) RunAndReturn(run func(uint64, uint64) error) *DepositStore_Prune_Call[DepositT] {
+func (_c *DepositStore_Prune_Call[DepositT]) RunAndReturn(run func(context.Context, uint64, uint64) error) *DepositStore_Prune_Call[DepositT] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -67,6 +67,52 @@ func (_c *Validator_GetWithdrawalCredentials_Call[WithdrawalCredentialsT]) RunAn
 	return _c
 }
 
+// IsInValidatorSet provides a mock function with given fields: _a0
+func (_m *Validator[WithdrawalCredentialsT]) IsInValidatorSet(_a0 math.U64) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsInValidatorSet")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(math.U64) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Validator_IsInValidatorSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsInValidatorSet'
+type Validator_IsInValidatorSet_Call[WithdrawalCredentialsT backend.WithdrawalCredentials] struct {
+	*mock.Call
+}
+
+// IsInValidatorSet is a helper method to define mock.On call
+//   - _a0 math.U64
+func (_e *Validator_Expecter[WithdrawalCredentialsT]) IsInValidatorSet(_a0 interface{}) *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT] {
+	return &Validator_IsInValidatorSet_Call[WithdrawalCredentialsT]{Call: _e.mock.On("IsInValidatorSet", _a0)}
+}
+
+func (_c *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT]) Run(run func(_a0 math.U64)) *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT]) Return(_a0 bool) *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT]) RunAndReturn(run func(math.U64) bool) *Validator_IsInValidatorSet_Call[WithdrawalCredentialsT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsFullyWithdrawable provides a mock function with given fields: amount, epoch
 func (_m *Validator[WithdrawalCredentialsT]) IsFullyWithdrawable(amount math.U64, epoch math.U64) bool {
 	ret := _m.Called(amount, epoch)
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+
+// queryLimiter is a semaphore bounding the number of queries against
+// historical state that are served concurrently. Every such query opens a
+// cache multi-store for its height, so an unbounded number of them can
+// exhaust the memory and file handles of the node. A nil queryLimiter does
+// not limit queries.
+type queryLimiter chan struct{}
+
+// newQueryLimiter returns a queryLimiter allowing up to limit concurrent
+// queries. A non-positive limit disables the limiter.
+func newQueryLimiter(limit int) queryLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return make(queryLimiter, limit)
+}
+
+// acquire reserves a slot for a query, returning ErrTooManyQueries without
+// blocking if all slots are taken.
+func (l queryLimiter) acquire() error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l <- struct{}{}:
+		return nil
+	default:
+		return types.ErrTooManyQueries
+	}
+}
+
+// noRelease is returned in place of the function releasing a query that
+// failed, for callers to release queries alike.
+func noRelease() {}
+
+// release frees the slot reserved by a successful acquire.
+func (l queryLimiter) release() {
+	if l == nil {
+		return
+	}
+	<-l
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLimiter(t *testing.T) {
+	const limit = 4
+	l := newQueryLimiter(limit)
+
+	// Saturate the limiter.
+	for range limit {
+		require.NoError(t, l.acquire())
+	}
+
+	// Excess queries are rejected while the limiter is saturated.
+	for range 2 {
+		require.ErrorIs(t, l.acquire(), types.ErrTooManyQueries)
+	}
+
+	// Releasing a slot admits exactly one more query.
+	l.release()
+	require.NoError(t, l.acquire())
+	require.ErrorIs(t, l.acquire(), types.ErrTooManyQueries)
+}
+
+func TestQueryLimiterDisabled(t *testing.T) {
+	l := newQueryLimiter(0)
+	for range 100 {
+		require.NoError(t, l.acquire())
+	}
+	l.release()
+}
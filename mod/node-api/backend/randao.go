@@ -28,10 +28,11 @@ import (
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error) {
-	st, slot, err := b.stateFromSlot(slot)
+	st, slot, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Bytes32{}, err
 	}
+	defer release()
 	// Infer the epoch if not provided.
 	if epoch == 0 {
 		epoch = b.cs.SlotToEpoch(slot)
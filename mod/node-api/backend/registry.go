@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Format is the encoding of an exported validator registry.
+type Format string
+
+const (
+	// FormatJSON encodes the registry as a JSON array of ValidatorRecords.
+	FormatJSON Format = "json"
+	// FormatCSV encodes the registry as CSV, with a header row followed by
+	// a row per validator.
+	FormatCSV Format = "csv"
+)
+
+// ErrUnsupportedFormat is returned when the registry is exported in an
+// unknown format.
+var ErrUnsupportedFormat = errors.New("unsupported registry format")
+
+// registryHeader is the header row of a registry exported as CSV.
+//
+//nolint:gochecknoglobals // read-only.
+var registryHeader = []string{
+	"index", "pubkey", "balance", "effective_balance", "status",
+	"withdrawal_credentials",
+}
+
+// ValidatorRecord is the entry of a validator in an exported registry.
+type ValidatorRecord struct {
+	Index                 uint64           `json:"index,string"`
+	Pubkey                crypto.BLSPubkey `json:"pubkey"`
+	Balance               uint64           `json:"balance,string"`
+	EffectiveBalance      uint64           `json:"effective_balance,string"`
+	Status                string           `json:"status"`
+	WithdrawalCredentials string           `json:"withdrawal_credentials"`
+}
+
+// registryState is the part of the beacon state the registry is exported
+// from.
+type registryState[ValidatorT any] interface {
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+	GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	ValidatorStatus(math.ValidatorIndex) (string, error)
+}
+
+// ExportValidatorRegistry streams the validator registry of the state at the
+// given height in the given format, where a height of 0 is the latest
+// height. The registry is read from the state while the returned reader is
+// consumed, which holds a slot of the query limiter until the reader is
+// drained or closed. Callers must close the reader once done with it.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, WithdrawalCredentialsT,
+]) ExportValidatorRegistry(
+	height int64,
+	format Format,
+) (io.ReadCloser, error) {
+	if format != FormatJSON && format != FormatCSV {
+		return nil, ErrUnsupportedFormat
+	}
+	if height < 0 {
+		return nil, types.ErrInvalidRequest
+	}
+
+	st, release, err := b.stateFromHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer release()
+		pw.CloseWithError(
+			writeValidatorRegistry[ValidatorT, WithdrawalCredentialsT](
+				pw, st, format,
+			),
+		)
+	}()
+	return pr, nil
+}
+
+// writeValidatorRegistry writes the validator registry of the given state to
+// w in the given format, one validator at a time.
+func writeValidatorRegistry[
+	ValidatorT Validator[WithdrawalCredentialsT],
+	WithdrawalCredentialsT WithdrawalCredentials,
+](
+	w io.Writer,
+	st registryState[ValidatorT],
+	format Format,
+) error {
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	var write func(uint64, *ValidatorRecord) error
+	switch format {
+	case FormatJSON:
+		write = func(i uint64, record *ValidatorRecord) error {
+			delim := ","
+			if i == 0 {
+				delim = "["
+			}
+			bz, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			_, err = io.WriteString(w, delim+string(bz))
+			return err
+		}
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err = cw.Write(registryHeader); err != nil {
+			return err
+		}
+		defer cw.Flush()
+		write = func(_ uint64, record *ValidatorRecord) error {
+			return cw.Write([]string{
+				strconv.FormatUint(record.Index, 10),
+				record.Pubkey.String(),
+				strconv.FormatUint(record.Balance, 10),
+				strconv.FormatUint(record.EffectiveBalance, 10),
+				record.Status,
+				record.WithdrawalCredentials,
+			})
+		}
+	default:
+		return ErrUnsupportedFormat
+	}
+
+	for i := range total {
+		record, err := validatorRecord[ValidatorT, WithdrawalCredentialsT](
+			st, math.ValidatorIndex(i),
+		)
+		if err != nil {
+			return err
+		}
+		if err = write(i, record); err != nil {
+			return err
+		}
+	}
+
+	if format == FormatJSON {
+		closing := "]"
+		if total == 0 {
+			closing = "[]"
+		}
+		_, err = io.WriteString(w, closing)
+	}
+	return err
+}
+
+// validatorRecord returns the registry entry of the validator at the given
+// index.
+func validatorRecord[
+	ValidatorT Validator[WithdrawalCredentialsT],
+	WithdrawalCredentialsT WithdrawalCredentials,
+](
+	st registryState[ValidatorT],
+	index math.ValidatorIndex,
+) (*ValidatorRecord, error) {
+	validator, err := st.ValidatorByIndex(index)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := st.GetBalance(index)
+	if err != nil {
+		return nil, err
+	}
+	status, err := st.ValidatorStatus(index)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRecord{
+		Index:                 index.Unwrap(),
+		Pubkey:                validator.GetPubkey(),
+		Balance:               balance.Unwrap(),
+		EffectiveBalance:      validator.GetEffectiveBalance().Unwrap(),
+		Status:                status,
+		WithdrawalCredentials: validator.GetWithdrawalCredentials().String(),
+	}, nil
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testRegistryState is an in-memory registryState.
+type testRegistryState struct {
+	validators []*types.Validator
+	balances   []math.Gwei
+	statuses   []string
+}
+
+func (s *testRegistryState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *testRegistryState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*types.Validator, error) {
+	return s.validators[index], nil
+}
+
+func (s *testRegistryState) GetBalance(
+	index math.ValidatorIndex,
+) (math.Gwei, error) {
+	return s.balances[index], nil
+}
+
+func (s *testRegistryState) ValidatorStatus(
+	index math.ValidatorIndex,
+) (string, error) {
+	return s.statuses[index], nil
+}
+
+func newTestRegistryState() (*testRegistryState, []ValidatorRecord) {
+	st := &testRegistryState{}
+	expected := make([]ValidatorRecord, 0)
+	for i, status := range []string{
+		"active_ongoing", "pending_queued", "exited_unslashed",
+	} {
+		val := &types.Validator{
+			Pubkey:                crypto.BLSPubkey{byte(i + 1)},
+			WithdrawalCredentials: types.WithdrawalCredentials{byte(i + 1)},
+			EffectiveBalance:      math.Gwei(32e9 - i*1e9),
+		}
+		balance := math.Gwei(32e9 + i)
+		st.validators = append(st.validators, val)
+		st.balances = append(st.balances, balance)
+		st.statuses = append(st.statuses, status)
+		expected = append(expected, ValidatorRecord{
+			Index:                 uint64(i),
+			Pubkey:                val.Pubkey,
+			Balance:               balance.Unwrap(),
+			EffectiveBalance:      val.EffectiveBalance.Unwrap(),
+			Status:                status,
+			WithdrawalCredentials: val.WithdrawalCredentials.String(),
+		})
+	}
+	return st, expected
+}
+
+func TestWriteValidatorRegistryJSON(t *testing.T) {
+	st, expected := newTestRegistryState()
+
+	var buf bytes.Buffer
+	require.NoError(t, writeValidatorRegistry[
+		*types.Validator, types.WithdrawalCredentials,
+	](&buf, st, FormatJSON))
+
+	var records []ValidatorRecord
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+	require.Equal(t, expected, records)
+}
+
+func TestWriteValidatorRegistryCSV(t *testing.T) {
+	st, expected := newTestRegistryState()
+
+	var buf bytes.Buffer
+	require.NoError(t, writeValidatorRegistry[
+		*types.Validator, types.WithdrawalCredentials,
+	](&buf, st, FormatCSV))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, len(expected)+1)
+	require.Equal(t, registryHeader, rows[0])
+	for i, record := range expected {
+		require.Equal(t, []string{
+			strconv.FormatUint(record.Index, 10),
+			record.Pubkey.String(),
+			strconv.FormatUint(record.Balance, 10),
+			strconv.FormatUint(record.EffectiveBalance, 10),
+			record.Status,
+			record.WithdrawalCredentials,
+		}, rows[i+1])
+	}
+}
+
+func TestWriteValidatorRegistryEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeValidatorRegistry[
+		*types.Validator, types.WithdrawalCredentials,
+	](&buf, &testRegistryState{}, FormatJSON))
+	require.JSONEq(t, "[]", buf.String())
+
+	require.ErrorIs(t, writeValidatorRegistry[
+		*types.Validator, types.WithdrawalCredentials,
+	](&buf, &testRegistryState{}, "xml"), ErrUnsupportedFormat)
+}
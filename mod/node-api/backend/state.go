@@ -21,16 +21,20 @@
 package backend
 
 import (
+	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // StateFromSlotForProof returns the beacon state of the version that was used
 // to calculate the parent beacon block root, which has the empty state root in
-// the latest block header. Hence we do not process the next slot.
+// the latest block header. Hence we do not process the next slot. The
+// returned function must be called once the state is no longer used.
 func (b *Backend[
 	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error) {
+]) StateFromSlotForProof(
+	slot math.Slot,
+) (BeaconStateT, math.Slot, func(), error) {
 	return b.stateFromSlotRaw(slot)
 }
 
@@ -38,10 +42,11 @@ func (b *Backend[
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) StateRootAtSlot(slot math.Slot) (common.Root, error) {
-	st, slot, err := b.stateFromSlot(slot)
+	st, slot, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Root{}, err
 	}
+	defer release()
 
 	// As calculated by the beacon chain. Ideally, this logic
 	// should be abstracted by the beacon chain.
@@ -53,9 +58,49 @@ func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, ForkT, _, _, _, _, _, _, _,
 ]) StateForkAtSlot(slot math.Slot) (ForkT, error) {
 	var fork ForkT
-	st, _, err := b.stateFromSlot(slot)
+	st, _, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return fork, err
 	}
+	defer release()
 	return st.GetFork()
 }
+
+// FinalityCheckpointsAtSlot returns the justified and finalized checkpoints
+// of the state at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) FinalityCheckpointsAtSlot(
+	slot math.Slot,
+) (*types.FinalityCheckpointsData, error) {
+	st, _, release, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	justified, err := st.GetJustifiedCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	finalized, err := st.GetFinalizedCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	// Every epoch is justified as it ends, so the checkpoint justified before
+	// the current one is the finalized checkpoint.
+	return &types.FinalityCheckpointsData{
+		PreviousJustified: checkpointData(finalized),
+		CurrentJustified:  checkpointData(justified),
+		Finalized:         checkpointData(finalized),
+	}, nil
+}
+
+// checkpointData converts a checkpoint to its API representation.
+func checkpointData(checkpoint common.Checkpoint) types.CheckpointData {
+	return types.CheckpointData{
+		Epoch: checkpoint.Epoch.Unwrap(),
+		Root:  checkpoint.Root,
+	}
+}
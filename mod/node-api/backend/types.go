@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
@@ -42,6 +43,15 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns the sidecars stored for the given slot of every
+	// commitment of the body.
+	GetBlobSidecars(math.Slot, BeaconBlockBodyT) (BlobSidecarsT, error)
+}
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -69,6 +79,22 @@ type BeaconState[
 ] interface {
 	// SetSlot sets the slot on the beacon state.
 	SetSlot(math.Slot) error
+	// ActivationQueue returns the validators queued for activation.
+	ActivationQueue() ([]math.ValidatorIndex, error)
+	// ExitQueue returns the validators queued for exit.
+	ExitQueue() ([]math.ValidatorIndex, error)
+	// ValidatorChurnLimit returns the validator churn limit of the current
+	// epoch.
+	ValidatorChurnLimit() (uint64, error)
+	// EstimateActivationEpoch estimates the epoch in which the validator at
+	// the given index is activated.
+	EstimateActivationEpoch(math.ValidatorIndex) (math.Epoch, error)
+	// ValidatorStatus returns the status of the validator at the given
+	// index in the current epoch.
+	ValidatorStatus(math.ValidatorIndex) (string, error)
+	// CommitteeCountPerSlot returns the number of attestation committees
+	// per slot in the given epoch.
+	CommitteeCountPerSlot(math.Epoch) (uint64, error)
 
 	core.ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -84,6 +110,8 @@ type BlockStore[BeaconBlockT any] interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	// GetParentSlotByTimestamp retrieves the parent slot by a given timestamp.
 	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+	// GetBlockBySlot retrieves the block at a given slot.
+	GetBlockBySlot(slot math.Slot) (BeaconBlockT, error)
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -91,7 +119,7 @@ type DepositStore[DepositT any] interface {
 	// GetDepositsByIndex returns `numView` expected deposits.
 	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
 	// Prune prunes the deposit store of [start, end)
-	Prune(start, end uint64) error
+	Prune(ctx context.Context, start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
 }
@@ -121,6 +149,12 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// IsInValidatorSet checks if the validator is active in the given epoch.
+	IsInValidatorSet(math.Epoch) bool
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
@@ -144,6 +178,8 @@ type Withdrawal[T any] interface {
 
 // WithdrawalCredentials represents an interface for withdrawal credentials.
 type WithdrawalCredentials interface {
+	// String returns the hex representation of the withdrawal credentials.
+	String() string
 	// ToExecutionAddress converts the withdrawal credentials to an execution
 	// address.
 	ToExecutionAddress() (common.ExecutionAddress, error)
@@ -21,11 +21,17 @@
 package backend
 
 import (
+	"errors"
+
 	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// ErrZeroChurnLimit is returned when the activation queue is estimated from a
+// state reporting a churn limit of zero.
+var ErrZeroChurnLimit = errors.New("churn limit is zero")
+
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
 ]) ValidatorByID(
@@ -34,10 +40,11 @@ func (b Backend[
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, _, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 	index, err := utils.ValidatorIndexByID(st, id)
 	if err != nil {
 		return nil, err
@@ -50,12 +57,16 @@ func (b Backend[
 	if err != nil {
 		return nil, err
 	}
+	status, err := st.ValidatorStatus(index)
+	if err != nil {
+		return nil, err
+	}
 	return &beacontypes.ValidatorData[ValidatorT]{
 		ValidatorBalanceData: beacontypes.ValidatorBalanceData{
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active_ongoing", // TODO: fix
+		Status:    status,
 		Validator: validator,
 	}, nil
 }
@@ -86,10 +97,11 @@ func (b Backend[
 	slot math.Slot, ids []string,
 ) ([]*beacontypes.ValidatorBalanceData, error) {
 	var index math.U64
-	st, _, err := b.stateFromSlot(slot)
+	st, _, release, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 	balances := make([]*beacontypes.ValidatorBalanceData, 0)
 	for _, id := range ids {
 		index, err = utils.ValidatorIndexByID(st, id)
@@ -109,3 +121,72 @@ func (b Backend[
 	}
 	return balances, nil
 }
+
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidatorQueues(
+	slot math.Slot,
+) (*beacontypes.ValidatorQueuesData, error) {
+	st, _, release, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	activations, err := st.ActivationQueue()
+	if err != nil {
+		return nil, err
+	}
+	exits, err := st.ExitQueue()
+	if err != nil {
+		return nil, err
+	}
+	churn, err := st.ValidatorChurnLimit()
+	if err != nil {
+		return nil, err
+	}
+	if churn == 0 {
+		return nil, ErrZeroChurnLimit
+	}
+	data := &beacontypes.ValidatorQueuesData{
+		ChurnLimit:      churn,
+		ActivationQueue: make([]beacontypes.ActivationQueueData, 0),
+		ExitQueue:       make([]uint64, 0, len(exits)),
+	}
+	for i, index := range activations {
+		data.ActivationQueue = append(
+			data.ActivationQueue,
+			beacontypes.ActivationQueueData{
+				Index:              index.Unwrap(),
+				EpochsToActivation: uint64(i)/churn + 1,
+			},
+		)
+	}
+	for _, index := range exits {
+		data.ExitQueue = append(data.ExitQueue, index.Unwrap())
+	}
+	return data, nil
+}
+
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) CommitteeCountPerSlot(
+	slot math.Slot, epoch math.Epoch,
+) (*beacontypes.CommitteeCountData, error) {
+	st, slot, release, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// Infer the epoch if not provided.
+	if epoch == 0 {
+		epoch = b.cs.SlotToEpoch(slot)
+	}
+	count, err := st.CommitteeCountPerSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.CommitteeCountData{
+		Epoch:             epoch.Unwrap(),
+		CommitteesPerSlot: count,
+	}, nil
+}
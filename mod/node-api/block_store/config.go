@@ -30,6 +30,9 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// AvailabilityWindow is the number of slots to keep in the store.
 	AvailabilityWindow int `mapstructure:"availability-window"`
+	// BlocksWindow is the number of slots whose full blocks are persisted to
+	// disk by the store.
+	BlocksWindow uint64 `mapstructure:"blocks-window"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
@@ -57,11 +57,17 @@ type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	ReconstructedBlockAtSlot(
+		slot math.Slot,
+	) (*types.ReconstructedBlockData, error)
 }
 
 type StateBackend[ForkT any] interface {
 	StateRootAtSlot(slot math.Slot) (common.Root, error)
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
+	FinalityCheckpointsAtSlot(
+		slot math.Slot,
+	) (*types.FinalityCheckpointsData, error)
 }
 
 type ValidatorBackend[ValidatorT any] interface {
@@ -77,4 +83,8 @@ type ValidatorBackend[ValidatorT any] interface {
 		slot math.Slot,
 		ids []string,
 	) ([]*types.ValidatorBalanceData, error)
+	ValidatorQueues(slot math.Slot) (*types.ValidatorQueuesData, error)
+	CommitteeCountPerSlot(
+		slot math.Slot, epoch math.Epoch,
+	) (*types.CommitteeCountData, error)
 }
@@ -46,3 +46,27 @@ func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
 		Data:                rewards,
 	}, nil
 }
+
+func (h *Handler[_, ContextT, _, _]) GetReconstructedBlock(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetReconstructedBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	reconstructed, err := h.backend.ReconstructedBlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,  // only finalized blocks are stored
+		Data:                reconstructed,
+	}, nil
+}
@@ -72,3 +72,27 @@ func (h *Handler[_, ContextT, _, _]) GetStateFork(c ContextT) (any, error) {
 		Data:                types.Wrap(fork),
 	}, nil
 }
+
+func (h *Handler[_, ContextT, _, _]) GetStateFinalityCheckpoints(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetFinalityCheckpointsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	checkpoints, err := h.backend.FinalityCheckpointsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                checkpoints,
+	}, nil
+}
@@ -51,7 +51,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/finality_checkpoints",
-			Handler: h.NotImplemented,
+			Handler: h.GetStateFinalityCheckpoints,
 		},
 		{
 			Method:  http.MethodGet,
@@ -78,6 +78,16 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/states/:state_id/validator_balances",
 			Handler: h.PostStateValidatorBalances,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/eth/v1/beacon/states/:state_id/validator_queues",
+			Handler: h.GetStateValidatorQueues,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/eth/v1/beacon/states/:state_id/committee_count",
+			Handler: h.GetStateCommitteeCount,
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/committees",
@@ -143,6 +153,11 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/beacon/reconstructed_blocks/:block_id",
+			Handler: h.GetReconstructedBlock,
+		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/sync_committee/:block_id",
@@ -53,6 +53,15 @@ type GetStateValidatorRequest struct {
 	ValidatorID string `query:"validator_id" validate:"required,validator_id"`
 }
 
+type GetValidatorQueuesRequest struct {
+	types.StateIDRequest
+}
+
+type GetCommitteeCountRequest struct {
+	types.StateIDRequest
+	EpochOptionalRequest
+}
+
 type GetValidatorBalancesRequest struct {
 	types.StateIDRequest
 	IDs []string `query:"id" validate:"dive,validator_id"`
@@ -142,6 +151,10 @@ type GetBlockRewardsRequest struct {
 	types.BlockIDRequest
 }
 
+type GetReconstructedBlockRequest struct {
+	types.BlockIDRequest
+}
+
 type PostAttestationsRewardsRequest struct {
 	EpochRequest
 	IDs []string `validate:"dive,validator_id"`
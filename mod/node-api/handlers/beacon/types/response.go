@@ -57,6 +57,17 @@ type RootData struct {
 	Root common.Root `json:"root"`
 }
 
+type CheckpointData struct {
+	Epoch uint64      `json:"epoch,string"`
+	Root  common.Root `json:"root"`
+}
+
+type FinalityCheckpointsData struct {
+	PreviousJustified CheckpointData `json:"previous_justified"`
+	CurrentJustified  CheckpointData `json:"current_justified"`
+	Finalized         CheckpointData `json:"finalized"`
+}
+
 type ValidatorData[ValidatorT any] struct {
 	ValidatorBalanceData
 	Status    string     `json:"status"`
@@ -68,6 +79,24 @@ type ValidatorBalanceData struct {
 	Balance uint64 `json:"balance,string"`
 }
 
+type ValidatorQueuesData struct {
+	ChurnLimit      uint64                `json:"churn_limit,string"`
+	ActivationQueue []ActivationQueueData `json:"activation_queue"`
+	ExitQueue       []uint64              `json:"exit_queue,string"`
+}
+
+type CommitteeCountData struct {
+	Epoch             uint64 `json:"epoch,string"`
+	CommitteesPerSlot uint64 `json:"committees_per_slot,string"`
+}
+
+type ActivationQueueData struct {
+	Index uint64 `json:"index,string"`
+	// EpochsToActivation is an estimate of the number of epochs until the
+	// validator is activated, given its position and the churn limit.
+	EpochsToActivation uint64 `json:"epochs_to_activation,string"`
+}
+
 //nolint:staticcheck // todo: figure this out.
 type CommitteeData struct {
 	Index      uint64   `json:"index,string"`
@@ -75,6 +104,11 @@ type CommitteeData struct {
 	Validators []uint64 `json:"validators,string"`
 }
 
+type ReconstructedBlockData struct {
+	Block        any `json:"block"`
+	BlobSidecars any `json:"blob_sidecars"`
+}
+
 type BlockRewardsData struct {
 	ProposerIndex     uint64 `json:"proposer_index,string"`
 	Total             uint64 `json:"total,string"`
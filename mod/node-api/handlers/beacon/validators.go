@@ -24,6 +24,7 @@ import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 func (h *Handler[_, ContextT, _, _]) GetStateValidators(
@@ -169,3 +170,58 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
 		Data:                balances,
 	}, nil
 }
+
+func (h *Handler[_, ContextT, _, _]) GetStateValidatorQueues(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetValidatorQueuesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	queues, err := h.backend.ValidatorQueues(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                queues,
+	}, nil
+}
+
+func (h *Handler[_, ContextT, _, _]) GetStateCommitteeCount(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetCommitteeCountRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	epoch := math.Epoch(0)
+	if req.Epoch != "" {
+		epoch, err = utils.U64FromString(req.Epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	count, err := h.backend.CommitteeCountPerSlot(slot, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                count,
+	}, nil
+}
@@ -25,15 +25,21 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
-type Handler[ContextT context.Context] struct {
+// Handler is the handler for the debug API.
+type Handler[ContextT context.Context, BlockProductionT any] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend[BlockProductionT]
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+// NewHandler creates a new handler for the debug API.
+func NewHandler[ContextT context.Context, BlockProductionT any](
+	backend Backend[BlockProductionT],
+) *Handler[ContextT, BlockProductionT] {
+	h := &Handler[ContextT, BlockProductionT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend: backend,
 	}
 	return h
 }
@@ -36,5 +36,7 @@ type BlockBackend[BeaconBlockHeaderT any] interface {
 }
 
 type StateBackend[BeaconStateT any] interface {
-	StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+	StateFromSlotForProof(
+		slot math.Slot,
+	) (BeaconStateT, math.Slot, func(), error)
 }
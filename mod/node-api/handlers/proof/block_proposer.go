@@ -38,12 +38,13 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
-	slot, beaconState, blockHeader, err := h.resolveTimestampID(
+	slot, beaconState, blockHeader, release, err := h.resolveTimestampID(
 		params.TimestampID,
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	h.Logger().Info("Generating block proposer proofs", "slot", slot)
 
@@ -38,12 +38,13 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
-	slot, beaconState, blockHeader, err := h.resolveTimestampID(
+	slot, beaconState, blockHeader, release, err := h.resolveTimestampID(
 		params.TimestampID,
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	// Generate the proof (along with the "correct" beacon block root to
 	// verify against) for the execution payload block number.
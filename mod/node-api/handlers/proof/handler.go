@@ -72,11 +72,12 @@ func NewHandler[
 }
 
 // Get the slot from the given input of timestamp id, beacon state, and beacon
-// block header for the resolved slot.
+// block header for the resolved slot. The returned function must be called
+// once the beacon state is no longer used.
 func (h *Handler[
 	BeaconBlockHeaderT, BeaconStateT, _, _, _, _,
 ]) resolveTimestampID(timestampID string) (
-	math.Slot, BeaconStateT, BeaconBlockHeaderT, error,
+	math.Slot, BeaconStateT, BeaconBlockHeaderT, func(), error,
 ) {
 	var (
 		beaconState BeaconStateT
@@ -85,18 +86,19 @@ func (h *Handler[
 
 	slot, err := utils.ParentSlotFromTimestampID(timestampID, h.backend)
 	if err != nil {
-		return 0, beaconState, blockHeader, err
+		return 0, beaconState, blockHeader, nil, err
 	}
 
-	beaconState, slot, err = h.backend.StateFromSlotForProof(slot)
+	beaconState, slot, release, err := h.backend.StateFromSlotForProof(slot)
 	if err != nil {
-		return 0, beaconState, blockHeader, err
+		return 0, beaconState, blockHeader, nil, err
 	}
 
 	blockHeader, err = h.backend.BlockHeaderAtSlot(slot)
 	if err != nil {
-		return 0, beaconState, blockHeader, err
+		release()
+		return 0, beaconState, blockHeader, nil, err
 	}
 
-	return slot, beaconState, blockHeader, nil
+	return slot, beaconState, blockHeader, release, nil
 }
@@ -99,6 +99,7 @@ func NewBeaconState(
 		0,
 		[]math.Gwei{},
 		0,
+		nil,
 	)
 	return &BeaconState{BeaconStateMarshallable: bsm}, err
 }
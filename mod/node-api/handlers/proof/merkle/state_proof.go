@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+)
+
+// ErrInvalidStateProof is returned when a proof fails to verify against the
+// beacon state root.
+var ErrInvalidStateProof = errors.New(
+	"proof failed to verify against state root",
+)
+
+// VerifyStateProof verifies that the leaf is at the generalized index in the
+// beacon state with the given root. The branch holds the sibling nodes from
+// the leaf up to the root, as returned by the Prove*InState functions.
+func VerifyStateProof(
+	stateRoot common.Root,
+	gindex uint64,
+	leaf common.Root,
+	branch [][32]byte,
+) error {
+	if gindex == 0 {
+		return errors.Wrap(ErrInvalidStateProof, "generalized index is 0")
+	}
+
+	proof := make([]common.Root, len(branch))
+	for i, node := range branch {
+		proof[i] = node
+	}
+
+	verified, err := merkle.VerifyProof(
+		merkle.GeneralizedIndex(gindex), leaf, proof, stateRoot,
+	)
+	if err != nil {
+		return errors.Join(ErrInvalidStateProof, err)
+	} else if !verified {
+		return errors.Wrapf(
+			ErrInvalidStateProof, "state root: 0x%x", stateRoot[:],
+		)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	sszmerkle "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVerifyStateProof tests the VerifyStateProof function against a proof
+// of the execution number in the beacon state.
+func TestVerifyStateProof(t *testing.T) {
+	bs, err := mock.NewBeaconState(5, nil, 69420, common.ExecutionAddress{})
+	require.NoError(t, err)
+	proof, leaf, err := merkle.ProveExecutionNumberInState(bs)
+	require.NoError(t, err)
+
+	stateRoot := bs.HashTreeRoot()
+	branch := func() [][32]byte {
+		branch := make([][32]byte, len(proof))
+		for i, node := range proof {
+			branch[i] = node
+		}
+		return branch
+	}
+
+	t.Run("valid proof", func(t *testing.T) {
+		require.NoError(t, merkle.VerifyStateProof(
+			stateRoot, merkle.ExecutionNumberGIndexDenebState, leaf, branch(),
+		))
+	})
+
+	t.Run("wrong leaf", func(t *testing.T) {
+		wrongLeaf := leaf
+		wrongLeaf[0] ^= 0x01
+		require.ErrorIs(t, merkle.VerifyStateProof(
+			stateRoot, merkle.ExecutionNumberGIndexDenebState,
+			wrongLeaf, branch(),
+		), merkle.ErrInvalidStateProof)
+	})
+
+	t.Run("wrong branch", func(t *testing.T) {
+		wrongBranch := branch()
+		wrongBranch[len(wrongBranch)-1][31] ^= 0x01
+		require.ErrorIs(t, merkle.VerifyStateProof(
+			stateRoot, merkle.ExecutionNumberGIndexDenebState,
+			leaf, wrongBranch,
+		), merkle.ErrInvalidStateProof)
+	})
+
+	t.Run("wrong gindex", func(t *testing.T) {
+		require.ErrorIs(t, merkle.VerifyStateProof(
+			stateRoot, merkle.ExecutionNumberGIndexDenebState+1,
+			leaf, branch(),
+		), merkle.ErrInvalidStateProof)
+	})
+
+	t.Run("truncated branch", func(t *testing.T) {
+		err = merkle.VerifyStateProof(
+			stateRoot, merkle.ExecutionNumberGIndexDenebState,
+			leaf, branch()[1:],
+		)
+		require.ErrorIs(t, err, merkle.ErrInvalidStateProof)
+		require.ErrorIs(t, err, sszmerkle.ErrUnexpectedProofLength)
+	})
+}
@@ -26,4 +26,5 @@ var (
 	ErrNotFound       = errors.New("not found")
 	ErrNotImplemented = errors.New("not implemented")
 	ErrInvalidRequest = errors.New("invalid request")
+	ErrTooManyQueries = errors.New("too many concurrent queries")
 )
@@ -21,7 +21,8 @@
 package server
 
 const (
-	defaultAddress = "0.0.0.0:3500"
+	defaultAddress              = "0.0.0.0:3500"
+	defaultMaxConcurrentQueries = 64
 )
 
 // Config is the configuration for the node API server.
@@ -32,13 +33,17 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// MaxConcurrentQueries is the maximum number of queries against
+	// historical state served concurrently, 0 disables the limit.
+	MaxConcurrentQueries int `mapstructure:"max-concurrent-queries"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:              false,
+		Address:              defaultAddress,
+		Logging:              false,
+		MaxConcurrentQueries: defaultMaxConcurrentQueries,
 	}
 }
@@ -46,6 +46,7 @@ require (
 	github.com/hashicorp/go-metrics v0.5.3
 	github.com/spf13/afero v1.11.0
 	github.com/spf13/cast v1.7.0
+	github.com/supranational/blst v0.3.13
 )
 
 require (
@@ -206,7 +207,6 @@ require (
 	github.com/spf13/viper v1.19.0
 	github.com/stretchr/testify v1.9.0
 	github.com/subosito/gotenv v1.6.0 // indirect
-	github.com/supranational/blst v0.3.13 // indirect
 	github.com/syndtr/goleveldb v1.0.1-0.20220721030215-126854af5e6d // indirect
 	github.com/tendermint/go-amino v0.16.0 // indirect
 	github.com/tidwall/btree v1.7.0 // indirect
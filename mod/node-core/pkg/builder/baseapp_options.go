@@ -21,6 +21,8 @@
 package builder
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -29,9 +31,12 @@ import (
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
+	beaconflags "github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -47,6 +52,7 @@ func DefaultServiceOptions[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	appOpts config.AppOptions,
+	chainSpec common.ChainSpec,
 ) []func(*cometbft.Service[LoggerT]) {
 	var cache storetypes.MultiStorePersistentCache
 
@@ -68,11 +74,60 @@ func DefaultServiceOptions[
 		}
 	}
 
+	// accepting a chain ID prefix is only permitted on devnets.
+	chainIDPrefix := cast.ToString(appOpts.Get(server.FlagChainIDPrefix))
+	if chainIDPrefix != "" &&
+		chainSpec.DepositEth1ChainID() != spec.DevnetEth1ChainID {
+		panic(errors.New("chain-id-prefix is only permitted on devnets"))
+	}
+
+	// the genesis checksum, if set, is a hex-encoded SHA-256.
+	genesisChecksum, err := hex.DecodeString(
+		cast.ToString(appOpts.Get(server.FlagGenesisChecksum)),
+	)
+	if err != nil {
+		panic(fmt.Errorf("invalid genesis-checksum: %w", err))
+	}
+	if len(genesisChecksum) != 0 && len(genesisChecksum) != sha256.Size {
+		panic(fmt.Errorf(
+			"invalid genesis-checksum: expected %d bytes, got %d",
+			sha256.Size, len(genesisChecksum),
+		))
+	}
+
+	// the query rate limits are class=rate:burst limits per source.
+	queryRateLimits, err := cometbft.ParseQueryRateLimits(
+		cast.ToString(appOpts.Get(server.FlagQueryRateLimits)),
+	)
+	if err != nil {
+		panic(fmt.Errorf("invalid query-rate-limits: %w", err))
+	}
+
+	// the write-ahead log, if enabled, is kept in the data directory.
+	var finalizeWAL string
+	if cast.ToBool(appOpts.Get(server.FlagFinalizeWAL)) {
+		finalizeWAL = filepath.Join(
+			cast.ToString(appOpts.Get(flags.FlagHome)), "data", "finalize.wal",
+		)
+	}
+
 	return []func(*cometbft.Service[LoggerT]){
 		cometbft.SetPruning[LoggerT](pruningOpts),
 		cometbft.SetMinRetainBlocks[LoggerT](
 			cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
 		),
+		cometbft.SetRetainCatchUpBlocks[LoggerT](
+			cast.ToUint64(appOpts.Get(server.FlagRetainCatchUpBlocks)),
+		),
+		cometbft.SetMaxReorgDepth[LoggerT](
+			cast.ToUint64(appOpts.Get(server.FlagMaxReorgDepth)),
+		),
+		cometbft.SetProcessProposalBudgetPercent[LoggerT](
+			cast.ToUint64(appOpts.Get(server.FlagProcessProposalBudget)),
+		),
+		cometbft.SetMinPeers[LoggerT](
+			cast.ToUint64(appOpts.Get(server.FlagMinPeers)),
+		),
 		cometbft.SetInterBlockCache[LoggerT](cache),
 		cometbft.SetIAVLCacheSize[LoggerT](
 			cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)),
@@ -82,6 +137,28 @@ func DefaultServiceOptions[
 			true,
 		),
 		cometbft.SetChainID[LoggerT](chainID),
+		cometbft.SetChainIDPrefix[LoggerT](chainIDPrefix),
+		cometbft.SetMaxGenesisValidators[LoggerT](
+			cast.ToUint64(appOpts.Get(server.FlagMaxGenesisValidators)),
+		),
+		cometbft.SetGenesisChecksum[LoggerT](genesisChecksum),
+		cometbft.SetQueryRateLimits[LoggerT](queryRateLimits),
+		cometbft.SetCommitRetries[LoggerT](
+			cast.ToUint64(appOpts.Get(server.FlagCommitRetries)),
+		),
+		cometbft.SetCommitRetryBackoff[LoggerT](
+			cast.ToDuration(appOpts.Get(server.FlagCommitRetryBackoff)),
+		),
+		cometbft.SetFinalizeWAL[LoggerT](finalizeWAL),
+		cometbft.SetVerifyAppHash[LoggerT](
+			cast.ToBool(appOpts.Get(server.FlagVerifyAppHash)),
+		),
+		cometbft.SetSkipEmptySlots[LoggerT](
+			cast.ToBool(appOpts.Get(server.FlagSkipEmptySlots)),
+		),
+		cometbft.SetReuseProposalState[LoggerT](
+			cast.ToBool(appOpts.Get(beaconflags.VerifiedBlockCache)),
+		),
 	}
 }
 
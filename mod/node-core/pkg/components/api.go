@@ -52,11 +52,14 @@ type NodeAPIBackendInput[
 		DepositT, ExecutionPayloadHeaderT,
 	]
 	StorageBackend StorageBackendT
+	Config         *config.Config
 }
 
 func ProvideNodeAPIBackend[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconBlockStoreT BlockStore[BeaconBlockT],
@@ -116,6 +119,7 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.Config.NodeAPI.MaxConcurrentQueries,
 	)
 }
 
@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
@@ -54,10 +55,12 @@ type NodeAPIHandlersInput[
 	]
 	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
 	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[
+	DebugAPIHandler   *debugapi.Handler[
+		NodeAPIContextT, validator.BlockProduction,
+	]
+	EventsAPIHandler *eventsapi.Handler[NodeAPIContextT]
+	NodeAPIHandler   *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler  *proofapi.Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
@@ -132,8 +135,13 @@ func ProvideNodeAPIConfigHandler[
 
 func ProvideNodeAPIDebugHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+	ValidatorServiceT debugapi.Backend[validator.BlockProduction],
+](
+	validatorService ValidatorServiceT,
+) *debugapi.Handler[NodeAPIContextT, validator.BlockProduction] {
+	return debugapi.NewHandler[
+		NodeAPIContextT, validator.BlockProduction,
+	](validatorService)
 }
 
 func ProvideNodeAPIEventsHandler[
@@ -43,6 +43,7 @@ type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts   config.AppOptions
 	ChainSpec common.ChainSpec
+	Config    *config.Config
 	Logger    LoggerT
 }
 
@@ -70,6 +71,7 @@ func ProvideAvailibilityStore[
 		),
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
+		in.Config.AvailabilityStore,
 	), nil
 }
 
@@ -83,6 +85,7 @@ type AvailabilityPrunerInput[
 	depinject.In
 	AvailabilityStore AvailabilityStoreT
 	ChainSpec         common.ChainSpec
+	Config            *config.Config
 	Dispatcher        Dispatcher
 	Logger            LoggerT
 }
@@ -121,6 +124,8 @@ func ProvideAvailabilityPruner[
 		in.AvailabilityStore,
 		manager.AvailabilityPrunerName,
 		subFinalizedBlocks,
-		dastore.BuildPruneRangeFn[BeaconBlockT](in.ChainSpec),
+		dastore.BuildPruneRangeFn[BeaconBlockT](
+			in.ChainSpec, in.Config.AvailabilityStore.MaxRetainedSlots,
+		),
 	), nil
 }
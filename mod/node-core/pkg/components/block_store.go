@@ -21,11 +21,17 @@
 package components
 
 import (
+	"os"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // BlockStoreInput is the input for the dep inject framework.
@@ -39,8 +45,10 @@ type BlockStoreInput[
 ] struct {
 	depinject.In
 
-	Config *config.Config
-	Logger LoggerT
+	AppOpts   config.AppOptions
+	ChainSpec common.ChainSpec
+	Config    *config.Config
+	Logger    LoggerT
 }
 
 // ProvideBlockStore is a function that provides the module to the
@@ -57,8 +65,27 @@ func ProvideBlockStore[
 		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT, LoggerT,
 	],
 ) (*block.KVStore[BeaconBlockT], error) {
+	// full blocks are only persisted if a blocks window is configured.
+	var blocks block.IndexDB
+	if in.Config.BlockStoreService.BlocksWindow > 0 {
+		blocks = filedb.NewRangeDB(
+			filedb.NewDB(
+				filedb.WithRootDirectory(
+					cast.ToString(
+						in.AppOpts.Get(flags.FlagHome),
+					)+"/data/blocks",
+				),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(in.Logger),
+			),
+		)
+	}
 	return block.NewStore[BeaconBlockT](
 		in.Logger.With("service", manager.BlockStoreName),
 		in.Config.BlockStoreService.AvailabilityWindow,
+		blocks,
+		in.Config.BlockStoreService.BlocksWindow,
+		in.ChainSpec,
 	), nil
 }
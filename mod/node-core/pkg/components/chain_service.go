@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/policy"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
@@ -64,6 +65,8 @@ type ChainServiceInput[
 	Dispatcher     Dispatcher
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
+	ProposalPolicy *policy.ProposalPolicy
+	Backpressure   *policy.Backpressure
 	Signer         crypto.BLSSigner
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context,
@@ -135,8 +138,14 @@ func ProvideChainService[
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.ProposalPolicy,
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		in.Cfg.Validator.FinalityStallThreshold,
+		in.Cfg.Validator.DAQuarantineSize,
+		in.Cfg.Validator.DAQuarantineTTL,
+		in.Backpressure,
+		in.Cfg.Validator.VerifiedBlockCache,
 	)
 }
@@ -21,6 +21,7 @@
 package components
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
@@ -33,8 +34,9 @@ const (
 	BetnetChainSpecType = "betnet"
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() common.ChainSpec {
+// ProvideChainSpec provides the chain spec based on the environment variable,
+// failing if it is inconsistent.
+func ProvideChainSpec() (common.ChainSpec, error) {
 	// TODO: This is hood as fuck needs to be improved
 	// but for now we ball to get CI unblocked.
 	specType := os.Getenv(ChainSpecTypeEnvVar)
@@ -48,5 +50,8 @@ func ProvideChainSpec() common.ChainSpec {
 		chainSpec = spec.TestnetChainSpec()
 	}
 
-	return chainSpec
+	if err := chainSpec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid chain spec: %w", err)
+	}
+	return chainSpec, nil
 }
@@ -42,6 +42,7 @@ func ProvideCometBFTService[
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	chainSpec common.ChainSpec,
+	dispatcher Dispatcher,
 ) *cometbft.Service[LoggerT] {
 	return cometbft.NewService(
 		storeKey,
@@ -50,6 +51,9 @@ func ProvideCometBFTService[
 		abciMiddleware,
 		cmtCfg,
 		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		append(
+			builder.DefaultServiceOptions[LoggerT](appOpts, chainSpec),
+			cometbft.SetDispatcher[LoggerT](dispatcher),
+		)...,
 	)
 }
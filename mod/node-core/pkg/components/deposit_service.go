@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
@@ -47,6 +48,7 @@ type DepositServiceIn[
 	depinject.In
 	BeaconDepositContract DepositContractT
 	ChainSpec             common.ChainSpec
+	Config                *config.Config
 	DepositStore          DepositStoreT
 	Dispatcher            Dispatcher
 	EngineClient          *client.EngineClient[
@@ -101,6 +103,8 @@ func ProvideDepositService[
 		in.TelemetrySink,
 		in.DepositStore,
 		in.BeaconDepositContract,
+		in.EngineClient,
 		in.Dispatcher,
+		in.Config.Deposit,
 	), nil
 }
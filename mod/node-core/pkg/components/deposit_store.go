@@ -28,7 +28,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -68,7 +67,6 @@ type DepositPrunerInput[
 	LoggerT any,
 ] struct {
 	depinject.In
-	ChainSpec    common.ChainSpec
 	DepositStore DepositStoreT
 	Dispatcher   Dispatcher
 	Logger       LoggerT
@@ -111,6 +109,6 @@ func ProvideDepositPruner[
 			BeaconBlockBodyT,
 			DepositT,
 			WithdrawalCredentials,
-		](in.ChainSpec),
+		](),
 	), nil
 }
@@ -23,8 +23,11 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	dp "github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // DispatcherInput is the input for the Dispatcher.
@@ -38,6 +41,7 @@ type DispatcherInput[
 // ProvideDispatcher provides a new Dispatcher.
 func ProvideDispatcher[
 	BeaconBlockT any,
+	BeaconBlockHeaderT any,
 	BlobSidecarsT any,
 	GenesisT any,
 	LoggerT log.AdvancedLogger[LoggerT],
@@ -57,9 +61,24 @@ func ProvideDispatcher[
 		dp.WithEvent[async.Event[BlobSidecarsT]](async.SidecarsVerified),
 		dp.WithEvent[async.Event[BeaconBlockT]](async.FinalBeaconBlockReceived),
 		dp.WithEvent[async.Event[BlobSidecarsT]](async.FinalSidecarsReceived),
+		dp.WithEvent[async.Event[int]](async.FinalSidecarsProcessed),
 		dp.WithEvent[ValidatorUpdateEvent](
 			async.FinalValidatorUpdatesProcessed,
 		),
 		dp.WithEvent[async.Event[BeaconBlockT]](async.BeaconBlockFinalized),
+		dp.WithDropOldestEvent[async.Event[cometbft.BlockFinalized]](
+			async.BlockFinalized,
+		),
+		dp.WithEvent[async.Event[BeaconBlockT]](
+			async.ReplayBeaconBlockReceived,
+		),
+		dp.WithEvent[ValidatorUpdateEvent](
+			async.ReplayValidatorUpdatesProcessed,
+		),
+		dp.WithEvent[async.Event[math.Epoch]](async.FinalityStalled),
+		dp.WithEvent[async.Event[math.Epoch]](async.EmptyValidatorSet),
+		dp.WithEvent[async.Event[blockchain.Equivocation[BeaconBlockHeaderT]]](
+			async.EquivocationDetected,
+		),
 	)
 }
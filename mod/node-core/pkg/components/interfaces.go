@@ -78,6 +78,9 @@ type (
 		// Persist makes sure that the sidecar remains accessible for data
 		// availability checks throughout the beacon node's operation.
 		Persist(math.Slot, BlobSidecarsT) error
+		// GetBlobSidecars returns the sidecars stored for the given slot of
+		// every commitment of the body.
+		GetBlobSidecars(math.Slot, BeaconBlockBodyT) (BlobSidecarsT, error)
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -116,6 +119,9 @@ type (
 		// GetTimestamp returns the timestamp of the block from the execution
 		// payload.
 		GetTimestamp() math.U64
+		// ComputeBlockRoot returns the canonical root of the block, as
+		// referenced by the parent root of the next block.
+		ComputeBlockRoot() (common.Root, error)
 	}
 
 	// BeaconBlockBody represents a generic interface for the body of a beacon
@@ -139,6 +145,8 @@ type (
 		GetExecutionPayload() ExecutionPayloadT
 		// GetDeposits returns the list of deposits.
 		GetDeposits() []DepositT
+		// GetEth1Data returns the eth1 data vote of the beacon block body.
+		GetEth1Data() Eth1DataT
 		// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 		GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 		// SetRandaoReveal sets the Randao reveal of the beacon block body.
@@ -210,6 +218,7 @@ type (
 			nextWithdrawalIndex uint64,
 			nextWithdrawalValidatorIndex math.U64,
 			slashings []math.U64, totalSlashing math.U64,
+			historicalSummaries []common.HistoricalSummary,
 		) (T, error)
 	}
 
@@ -248,6 +257,7 @@ type (
 		Get(index int) BlobSidecarT
 		GetSidecars() []BlobSidecarT
 		ValidateBlockRoots() error
+		ValidateBlockRoot(blockRoot common.Root) error
 		VerifyInclusionProofs(kzgOffset uint64) error
 	}
 
@@ -298,6 +308,8 @@ type (
 		// GetParentSlotByTimestamp retrieves the parent slot by a given
 		// timestamp from the store.
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		// GetBlockBySlot retrieves the block at a given slot from the store.
+		GetBlockBySlot(slot math.Slot) (BeaconBlockT, error)
 	}
 
 	ConsensusEngine interface {
@@ -384,9 +396,23 @@ type (
 			numView uint64,
 		) ([]DepositT, error)
 		// Prune prunes the deposit store of [start, end)
-		Prune(start, end uint64) error
+		Prune(ctx context.Context, start, end uint64) error
 		// EnqueueDeposits adds a list of deposits to the deposit store.
 		EnqueueDeposits(deposits []DepositT) error
+		// SetPendingDeposits buffers the deposits read from the execution
+		// block of the given number and hash.
+		SetPendingDeposits(
+			blockNum uint64, blockHash []byte, deposits []DepositT,
+		) error
+		// PendingDepositBlocks returns the numbers of the execution blocks
+		// with buffered deposits up to and including maxBlock.
+		PendingDepositBlocks(maxBlock uint64) ([]uint64, error)
+		// GetPendingDeposits returns the hash of the execution block of the
+		// given number and the deposits buffered for it.
+		GetPendingDeposits(blockNum uint64) ([]byte, []DepositT, error)
+		// RemovePendingDeposits drops the execution block of the given
+		// number and its deposits from the buffer.
+		RemovePendingDeposits(blockNum uint64) error
 	}
 
 	// 	Eth1Data[T any] interface {
@@ -543,9 +569,10 @@ type (
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
+		Get(index uint64, key []byte) ([]byte, error)
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
-		Prune(start uint64, end uint64) error
+		Prune(ctx context.Context, start uint64, end uint64) error
 	}
 
 	// LocalBuilder is the interface for the builder service.
@@ -560,8 +587,6 @@ type (
 			ctx context.Context,
 			st BeaconStateT,
 			slot math.Slot,
-			timestamp uint64,
-			parentBlockRoot common.Root,
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
 		) (*engineprimitives.PayloadID, error)
@@ -583,8 +608,6 @@ type (
 			ctx context.Context,
 			st BeaconStateT,
 			slot math.Slot,
-			timestamp uint64,
-			parentBlockRoot common.Root,
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
@@ -650,6 +673,13 @@ type (
 			st BeaconStateT,
 			blk BeaconBlockT,
 		) (transition.ValidatorUpdates, error)
+		// TransitionDeferred performs the core state transition, holding
+		// back the records it gathers until the returned function is called.
+		TransitionDeferred(
+			ctx ContextT,
+			st BeaconStateT,
+			blk BeaconBlockT,
+		) (transition.ValidatorUpdates, func(), error)
 	}
 
 	SidecarFactory[BeaconBlockT any, BlobSidecarsT any] interface {
@@ -795,6 +825,7 @@ type (
 			cs common.ChainSpec,
 		) T
 		Copy() T
+		Save()
 		Context() context.Context
 		HashTreeRoot() common.Root
 		GetMarshallable() (BeaconStateMarshallableT, error)
@@ -828,6 +859,9 @@ type (
 		) T
 		// Copy returns a copy of the key-value store.
 		Copy() T
+		// Save writes the changes made to a copy of the key-value store into
+		// the store it was copied from.
+		Save()
 		// GetLatestExecutionPayloadHeader retrieves the latest execution
 		// payload
 		// header.
@@ -873,6 +907,13 @@ type (
 		GetEth1Data() (Eth1DataT, error)
 		// SetEth1Data sets the eth1 data.
 		SetEth1Data(data Eth1DataT) error
+		// GetEth1DataVotes retrieves the eth1 data votes of the current voting
+		// period.
+		GetEth1DataVotes() ([]Eth1DataT, error)
+		// AppendEth1DataVote appends an eth1 data vote.
+		AppendEth1DataVote(vote Eth1DataT) error
+		// ResetEth1DataVotes removes all eth1 data votes.
+		ResetEth1DataVotes() error
 		// GetValidators retrieves all validators.
 		GetValidators() (ValidatorsT, error)
 		// GetBalances retrieves all balances.
@@ -892,6 +933,41 @@ type (
 		GetTotalSlashing() (math.Gwei, error)
 		// SetTotalSlashing sets the total slashing.
 		SetTotalSlashing(total math.Gwei) error
+		// GetJustifiedCheckpoint retrieves the current justified checkpoint.
+		GetJustifiedCheckpoint() (common.Checkpoint, error)
+		// SetJustifiedCheckpoint sets the current justified checkpoint.
+		SetJustifiedCheckpoint(checkpoint common.Checkpoint) error
+		// GetFinalizedCheckpoint retrieves the finalized checkpoint.
+		GetFinalizedCheckpoint() (common.Checkpoint, error)
+		// SetFinalizedCheckpoint sets the finalized checkpoint.
+		SetFinalizedCheckpoint(checkpoint common.Checkpoint) error
+		// GetHistoricalSummaries retrieves the historical summaries.
+		GetHistoricalSummaries() ([]common.HistoricalSummary, error)
+		// AppendHistoricalSummary appends a historical summary.
+		AppendHistoricalSummary(summary common.HistoricalSummary) error
+		// GetInactivityScore retrieves the inactivity score of a validator.
+		GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+		// SetInactivityScore sets the inactivity score of a validator.
+		SetInactivityScore(idx math.ValidatorIndex, score uint64) error
+		// GetPreviousEpochParticipation retrieves the participation flags of
+		// a validator in the previous epoch.
+		GetPreviousEpochParticipation(idx math.ValidatorIndex) (byte, error)
+		// GetCurrentEpochParticipation retrieves the participation flags of a
+		// validator in the current epoch.
+		GetCurrentEpochParticipation(idx math.ValidatorIndex) (byte, error)
+		// SetPreviousEpochParticipation sets the participation flags of a
+		// validator in the previous epoch.
+		SetPreviousEpochParticipation(
+			idx math.ValidatorIndex, flags byte,
+		) error
+		// SetCurrentEpochParticipation sets the participation flags of a
+		// validator in the current epoch.
+		SetCurrentEpochParticipation(
+			idx math.ValidatorIndex, flags byte,
+		) error
+		// RotateEpochParticipation moves the participation flags of the
+		// current epoch to the previous epoch.
+		RotateEpochParticipation() error
 		// GetRandaoMixAtIndex retrieves the randao mix at the given index.
 		GetRandaoMixAtIndex(index uint64) (common.Bytes32, error)
 		// GetSlashings retrieves all slashings.
@@ -963,9 +1039,21 @@ type (
 		GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 		GetTotalValidators() (uint64, error)
 		GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+		GetJustifiedCheckpoint() (common.Checkpoint, error)
+		GetFinalizedCheckpoint() (common.Checkpoint, error)
+		GetHistoricalSummaries() ([]common.HistoricalSummary, error)
+		GetInactivityScore(math.ValidatorIndex) (uint64, error)
+		GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+		GetCurrentEpochParticipation(math.ValidatorIndex) (byte, error)
 		ValidatorIndexByCometBFTAddress(
 			cometBFTAddress []byte,
 		) (math.ValidatorIndex, error)
+		ActivationQueue() ([]math.ValidatorIndex, error)
+		ExitQueue() ([]math.ValidatorIndex, error)
+		ValidatorChurnLimit() (uint64, error)
+		EstimateActivationEpoch(math.ValidatorIndex) (math.Epoch, error)
+		ValidatorStatus(math.ValidatorIndex) (string, error)
+		CommitteeCountPerSlot(math.Epoch) (uint64, error)
 	}
 
 	// WriteOnlyBeaconState is the interface for a write-only beacon state.
@@ -989,6 +1077,13 @@ type (
 		SetNextWithdrawalIndex(uint64) error
 		SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 		SetTotalSlashing(math.Gwei) error
+		SetJustifiedCheckpoint(common.Checkpoint) error
+		SetFinalizedCheckpoint(common.Checkpoint) error
+		AppendHistoricalSummary(common.HistoricalSummary) error
+		SetInactivityScore(math.ValidatorIndex, uint64) error
+		SetPreviousEpochParticipation(math.ValidatorIndex, byte) error
+		SetCurrentEpochParticipation(math.ValidatorIndex, byte) error
+		RotateEpochParticipation() error
 	}
 
 	// WriteOnlyStateRoots defines a struct which only has write access to state
@@ -1043,6 +1138,8 @@ type (
 	WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 		SetEth1Data(Eth1DataT) error
 		SetEth1DepositIndex(uint64) error
+		AppendEth1DataVote(Eth1DataT) error
+		ResetEth1DataVotes() error
 		SetLatestExecutionPayloadHeader(
 			ExecutionPayloadHeaderT,
 		) error
@@ -1052,6 +1149,7 @@ type (
 	ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 		GetEth1Data() (Eth1DataT, error)
 		GetEth1DepositIndex() (uint64, error)
+		GetEth1DataVotes() ([]Eth1DataT, error)
 		GetLatestExecutionPayloadHeader() (
 			ExecutionPayloadHeaderT, error,
 		)
@@ -1060,6 +1158,11 @@ type (
 	// ReadOnlyWithdrawals only has read access to withdrawal methods.
 	ReadOnlyWithdrawals[WithdrawalT any] interface {
 		ExpectedWithdrawals() ([]WithdrawalT, error)
+		UnsweptWithdrawableValidators() (uint64, error)
+		VerifyWithdrawalIndices(withdrawals []WithdrawalT) error
+		NextWithdrawalIndices(
+			withdrawals []WithdrawalT,
+		) (uint64, math.ValidatorIndex, error)
 	}
 )
 
@@ -1143,12 +1246,20 @@ type (
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+		ReconstructedBlockAtSlot(
+			slot math.Slot,
+		) (*types.ReconstructedBlockData, error)
 	}
 
 	StateBackend[BeaconStateT, ForkT any] interface {
 		StateRootAtSlot(slot math.Slot) (common.Root, error)
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
-		StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+		StateFromSlotForProof(
+			slot math.Slot,
+		) (BeaconStateT, math.Slot, func(), error)
+		FinalityCheckpointsAtSlot(
+			slot math.Slot,
+		) (*types.FinalityCheckpointsData, error)
 	}
 
 	ValidatorBackend[ValidatorT any] interface {
@@ -1164,5 +1275,9 @@ type (
 			slot math.Slot,
 			ids []string,
 		) ([]*types.ValidatorBalanceData, error)
+		ValidatorQueues(slot math.Slot) (*types.ValidatorQueuesData, error)
+		CommitteeCountPerSlot(
+			slot math.Slot, epoch math.Epoch,
+		) (*types.CommitteeCountData, error)
 	}
 )
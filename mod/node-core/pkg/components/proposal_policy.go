@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/policy"
+	"github.com/berachain/beacon-kit/mod/config"
+)
+
+// ProposalPolicyInput is the input for the proposal policy provider.
+type ProposalPolicyInput struct {
+	depinject.In
+	Cfg *config.Config
+}
+
+// ProvideProposalPolicy is a depinject provider for the proposal policy.
+func ProvideProposalPolicy(
+	in ProposalPolicyInput,
+) (*policy.ProposalPolicy, error) {
+	extraDataPrefix, err := hex.DecodeString(
+		strings.TrimPrefix(in.Cfg.Validator.ExtraDataPrefix, "0x"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extra-data-prefix: %w", err)
+	}
+	return policy.NewProposalPolicy(
+		in.Cfg.Validator.AllowedFeeRecipients...,
+	).WithExtraData(
+		in.Cfg.Validator.MaxExtraDataSize, extraDataPrefix,
+	), nil
+}
@@ -18,26 +18,20 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package payloadtime
+//go:build !bls12381
 
-import (
-	"time"
+package signer
 
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 )
 
-// Next calculates the
-// next timestamp for an execution payload
-//
-// TODO: This is hood and needs to be improved.
-func Next(
-	chainSpec common.ChainSpec,
-	parentPayloadTime math.U64,
-) uint64 {
-	//#nosec:G701 // not an issue in practice.
-	return max(
-		uint64(time.Now().Unix())+chainSpec.TargetSecondsPerEth1Block(),
-		uint64(parentPayloadTime+1),
-	)
+// fastAggregateVerify errors since the bls12381 build tag is not set.
+func fastAggregateVerify(
+	[]crypto.BLSPubkey,
+	[]byte,
+	crypto.BLSSignature,
+) error {
+	return bls12381.ErrDisabled
 }
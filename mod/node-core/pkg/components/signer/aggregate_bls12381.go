@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// dst is the domain separation tag of the signatures, that of the proof of
+// possession scheme.
+//
+//nolint:gochecknoglobals // read-only.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// fastAggregateVerify verifies the aggregate signature of the pubkeys over the
+// message, i.e. FastAggregateVerify of the BLS signature standard. The
+// pubkeys are validated on decompression, the signature on verification.
+func fastAggregateVerify(
+	pubKeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	if len(pubKeys) == 0 {
+		return ErrInvalidSignature
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		if pks[i] = new(blst.P1Affine).
+			Uncompress(pubKey[:]); pks[i] == nil || !pks[i].KeyValidate() {
+			return ErrInvalidPubKey
+		}
+	}
+
+	sig := new(blst.P2Affine).Uncompress(signature[:])
+	if sig == nil || !sig.FastAggregateVerify(true, pks, msg, dst) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -28,6 +28,9 @@ var (
 		"signer returned an invalid signature",
 	)
 
+	// ErrInvalidPubKey is returned when a public key is not a valid point.
+	ErrInvalidPubKey = errors.New("invalid public key")
+
 	// ErrValidatorPrivateKeyRequired is returned when the validator private key
 	// is required but not provided.
 	ErrValidatorPrivateKeyRequired = errors.New(
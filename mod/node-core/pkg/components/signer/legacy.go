@@ -73,6 +73,16 @@ func (LegacySigner) VerifySignature(
 	return nil
 }
 
+// FastAggregateVerify verifies an aggregate signature against a message and
+// the public keys of its signers.
+func (LegacySigner) FastAggregateVerify(
+	pubKeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return fastAggregateVerify(pubKeys, msg, signature)
+}
+
 // LegacyKey is a byte array that represents a BLS12-381 secret key.
 type LegacyKey [constants.BLSSecretKeyLength]byte
 
@@ -81,3 +81,13 @@ func (f BLSSigner) VerifySignature(
 	}
 	return nil
 }
+
+// FastAggregateVerify verifies an aggregate signature against a message and
+// the public keys of its signers.
+func (BLSSigner) FastAggregateVerify(
+	pubKeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return fastAggregateVerify(pubKeys, msg, signature)
+}
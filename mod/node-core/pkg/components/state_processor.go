@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -47,7 +48,10 @@ type StateProcessorInput[
 		PayloadID,
 		WithdrawalsT,
 	]
-	Signer crypto.BLSSigner
+	Config     *config.Config
+	Signer     crypto.BLSSigner
+	AuditSink  core.AuditSink  `optional:"true"`
+	TimingSink core.TimingSink `optional:"true"`
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
@@ -108,5 +112,8 @@ func ProvideStateProcessor[
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.Signer,
+		in.AuditSink,
+		in.Config.StateProcessor,
+		in.TimingSink,
 	)
 }
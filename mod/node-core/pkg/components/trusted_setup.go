@@ -24,6 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/spf13/afero"
@@ -42,15 +43,23 @@ func ProvideTrustedSetup(
 ) (*gokzg4844.JSONTrustedSetup, error) {
 	return ReadTrustedSetup(
 		cast.ToString(in.AppOpts.Get(flags.KZGTrustedSetupPath)),
+		cast.ToString(in.AppOpts.Get(flags.KZGTrustedSetupHash)),
 	)
 }
 
-// ReadTrustedSetup reads the trusted setup from the file system.
-func ReadTrustedSetup(filePath string) (*gokzg4844.JSONTrustedSetup, error) {
+// ReadTrustedSetup reads the trusted setup from the file system and verifies
+// it against the expected hash, if any.
+func ReadTrustedSetup(
+	filePath string,
+	expectedHash string,
+) (*gokzg4844.JSONTrustedSetup, error) {
 	config, err := afero.ReadFile(afero.NewOsFs(), filePath)
 	if err != nil {
 		return nil, err
 	}
+	if err = kzg.VerifyTrustedSetupHash(config, expectedHash); err != nil {
+		return nil, err
+	}
 	params := new(gokzg4844.JSONTrustedSetup)
 	if err = json.Unmarshal(config, params); err != nil {
 		return nil, err
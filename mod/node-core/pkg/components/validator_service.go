@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/policy"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -52,6 +53,8 @@ type ValidatorServiceInput[
 	Dispatcher     Dispatcher
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
+	ProposalPolicy *policy.ProposalPolicy
+	Backpressure   *policy.Backpressure
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
@@ -132,6 +135,8 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		in.ProposalPolicy,
+		in.Backpressure,
 		in.TelemetrySink,
 		in.Dispatcher,
 	), nil
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BuildNextPayloadAttributes computes the payload attributes for the slot
+// following parentSlot. The timestamp is derived from the latest execution
+// payload, while the prev-randao, fee recipient and withdrawals are assembled
+// by the attributes factory.
+//
+// NOTE: st must already have been processed up to parentSlot + 1, so that the
+// root of the parent block is present in the state's block roots.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) BuildNextPayloadAttributes(
+	st BeaconStateT,
+	parentSlot math.Slot,
+) (PayloadAttributesT, error) {
+	attrs, _, err := pb.nextPayloadAttributes(st, parentSlot)
+	return attrs, err
+}
+
+// nextPayloadAttributes returns the BuildNextPayloadAttributes along with the
+// root of the parent block they build on.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) nextPayloadAttributes(
+	st BeaconStateT,
+	parentSlot math.Slot,
+) (PayloadAttributesT, common.Root, error) {
+	var attrs PayloadAttributesT
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return attrs, common.Root{}, err
+	}
+
+	parentBlockRoot, err := st.GetBlockRootAtIndex(
+		parentSlot.Unwrap() % pb.chainSpec.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return attrs, common.Root{}, err
+	}
+
+	attrs, err = pb.attributesFactory.BuildPayloadAttributes(
+		st,
+		parentSlot+1,
+		nextPayloadTime(pb.chainSpec, lph.GetTimestamp()),
+		parentBlockRoot,
+	)
+	return attrs, parentBlockRoot, err
+}
+
+// nextPayloadTime calculates the next timestamp for an execution payload.
+//
+// TODO: This is hood and needs to be improved.
+func nextPayloadTime(
+	chainSpec common.ChainSpec,
+	parentPayloadTime math.U64,
+) uint64 {
+	//#nosec:G701 // not an issue in practice.
+	return max(
+		uint64(time.Now().Unix())+chainSpec.TargetSecondsPerEth1Block(),
+		uint64(parentPayloadTime+1),
+	)
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testWithdrawal = engineprimitives.Withdrawal
+	testAttributes = engineprimitives.PayloadAttributes[*testWithdrawal]
+)
+
+// testPayload is a minimal execution payload.
+type testPayload struct{}
+
+func (*testPayload) Empty(uint32) *testPayload                { return &testPayload{} }
+func (*testPayload) Version() uint32                          { return 0 }
+func (p *testPayload) IsNil() bool                            { return p == nil }
+func (*testPayload) GetBlockHash() common.ExecutionHash       { return common.ExecutionHash{} }
+func (*testPayload) GetFeeRecipient() common.ExecutionAddress { return common.ExecutionAddress{} }
+func (*testPayload) GetParentHash() common.ExecutionHash      { return common.ExecutionHash{} }
+
+// testHeader is a minimal execution payload header.
+type testHeader struct {
+	timestamp math.U64
+}
+
+func (testHeader) GetBlockHash() common.ExecutionHash  { return common.ExecutionHash{} }
+func (testHeader) GetParentHash() common.ExecutionHash { return common.ExecutionHash{} }
+func (h testHeader) GetTimestamp() math.U64            { return h.timestamp }
+
+// testState is a minimal beacon state backed by fixed values.
+type testState struct {
+	header      testHeader
+	randaoMixes map[uint64]common.Bytes32
+	blockRoots  map[uint64]common.Root
+	withdrawals []*testWithdrawal
+}
+
+func (s *testState) GetRandaoMixAtIndex(i uint64) (common.Bytes32, error) {
+	return s.randaoMixes[i], nil
+}
+
+func (s *testState) ExpectedWithdrawals() ([]*testWithdrawal, error) {
+	return s.withdrawals, nil
+}
+
+func (s *testState) GetLatestExecutionPayloadHeader() (testHeader, error) {
+	return s.header, nil
+}
+
+func (s *testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (s *testState) GetBlockRootAtIndex(i uint64) (common.Root, error) {
+	return s.blockRoots[i], nil
+}
+
+func TestBuildNextPayloadAttributes(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:             8,
+			SlotsPerHistoricalRoot:    16,
+			EpochsPerHistoricalVector: 4,
+		},
+	)
+	feeRecipient := common.ExecutionAddress{0xfe}
+	st := &testState{
+		header: testHeader{timestamp: 1 << 40},
+		randaoMixes: map[uint64]common.Bytes32{
+			// parent slot 23 -> next slot 24 -> epoch 3.
+			3: {0xaa},
+		},
+		blockRoots: map[uint64]common.Root{
+			23 % 16: {0xbb},
+		},
+		withdrawals: []*testWithdrawal{
+			{Index: 7, Validator: 3, Address: feeRecipient, Amount: 10},
+		},
+	}
+
+	pb := New[
+		*testState, *testPayload, testHeader,
+		*testAttributes, engineprimitives.PayloadID, *testWithdrawal,
+	](
+		&Config{},
+		cs,
+		noop.NewLogger[any](),
+		nil,
+		nil,
+		attributes.NewAttributesFactory[
+			*testState, *testAttributes, *testWithdrawal,
+		](cs, noop.NewLogger[any](), feeRecipient),
+	)
+
+	attrs, err := pb.BuildNextPayloadAttributes(st, 23)
+	require.NoError(t, err)
+	require.Equal(t, st.withdrawals, attrs.Withdrawals)
+	require.Equal(t, common.Bytes32{0xaa}, attrs.PrevRandao)
+	require.Equal(t, common.Root{0xbb}, attrs.ParentBeaconBlockRoot)
+	require.Equal(t, feeRecipient, attrs.SuggestedFeeRecipient)
+	require.Greater(t, attrs.Timestamp, st.header.timestamp)
+}
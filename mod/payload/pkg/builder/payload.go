@@ -31,6 +31,9 @@ import (
 
 // RequestPayloadAsync builds a payload for the given slot and
 // returns the payload ID.
+//
+// NOTE: st must already have been processed up to slot, see
+// BuildNextPayloadAttributes.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -38,8 +41,6 @@ func (pb *PayloadBuilder[
 	ctx context.Context,
 	st BeaconStateT,
 	slot math.Slot,
-	timestamp uint64,
-	parentBlockRoot common.Root,
 	headEth1BlockHash common.ExecutionHash,
 	finalEth1BlockHash common.ExecutionHash,
 ) (*PayloadIDT, error) {
@@ -47,6 +48,12 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
+	// Assemble the payload attributes.
+	attrs, parentBlockRoot, err := pb.nextPayloadAttributes(st, slot-1)
+	if err != nil {
+		return nil, err
+	}
+
 	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
 		pb.logger.Warn(
 			"aborting payload build; payload already exists in cache",
@@ -58,13 +65,6 @@ func (pb *PayloadBuilder[
 		return &payloadID, nil
 	}
 
-	// Assemble the payload attributes.
-	attrs, err := pb.attributesFactory.
-		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
-	if err != nil {
-		return nil, err
-	}
-
 	// Submit the forkchoice update to the execution client.
 	var payloadID *PayloadIDT
 	payloadID, _, err = pb.ee.NotifyForkchoiceUpdate(
@@ -99,8 +99,6 @@ func (pb *PayloadBuilder[
 	ctx context.Context,
 	st BeaconStateT,
 	slot math.Slot,
-	timestamp uint64,
-	parentBlockRoot common.Root,
 	parentEth1Hash common.ExecutionHash,
 	finalBlockHash common.ExecutionHash,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
@@ -114,8 +112,6 @@ func (pb *PayloadBuilder[
 		ctx,
 		st,
 		slot,
-		timestamp,
-		parentBlockRoot,
 		parentEth1Hash,
 		finalBlockHash,
 	)
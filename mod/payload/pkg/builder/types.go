@@ -76,6 +76,8 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash.
 	GetParentHash() common.ExecutionHash
+	// GetTimestamp returns the timestamp.
+	GetTimestamp() math.U64
 }
 
 // AttributesFactory is the interface for the attributes factory.
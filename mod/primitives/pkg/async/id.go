@@ -44,6 +44,19 @@ const (
 	// finalize block events.
 	FinalBeaconBlockReceived       = "final-beacon-block-received"
 	FinalSidecarsReceived          = "final-blob-sidecars-received"
+	FinalSidecarsProcessed         = "final-blob-sidecars-processed"
 	FinalValidatorUpdatesProcessed = "final-validator-updates"
 	BeaconBlockFinalized           = "beacon-block-finalized"
+	BlockFinalized                 = "block-finalized"
+
+	// determinism check events.
+	ReplayBeaconBlockReceived       = "replay-beacon-block-received"
+	ReplayValidatorUpdatesProcessed = "replay-validator-updates"
+
+	// liveness events.
+	FinalityStalled   = "finality-stalled"
+	EmptyValidatorSet = "empty-validator-set"
+
+	// slashing events.
+	EquivocationDetected = "equivocation-detected"
 )
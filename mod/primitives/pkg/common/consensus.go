@@ -118,3 +118,33 @@ func (r Root) MarshalJSON() ([]byte, error) {
 func (r *Root) UnmarshalJSON(input []byte) error {
 	return r.UnmarshalText(input[1 : len(input)-1])
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                 Checkpoint                                 */
+/* -------------------------------------------------------------------------- */
+
+// Checkpoint as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#checkpoint
+//
+//nolint:lll
+type Checkpoint struct {
+	// Epoch is the epoch of the checkpoint.
+	Epoch math.Epoch
+	// Root is the root of the block at the start slot of the epoch.
+	Root Root
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              HistoricalSummary                             */
+/* -------------------------------------------------------------------------- */
+
+// HistoricalSummary as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#historicalsummary
+//
+//nolint:lll
+type HistoricalSummary struct {
+	// BlockSummaryRoot is the root of the block roots of the period.
+	BlockSummaryRoot Root
+	// StateSummaryRoot is the root of the state roots of the period.
+	StateSummaryRoot Root
+}
@@ -27,4 +27,8 @@ const (
 	//
 	// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-4844.md
 	BlobCommitmentVersion uint8 = 0x01
+
+	// GasPerBlob is the amount of blob gas consumed by a single blob, as
+	// defined in EIP-4844.
+	GasPerBlob uint64 = 1 << 17
 )
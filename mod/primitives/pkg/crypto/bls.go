@@ -57,4 +57,10 @@ type BLSSigner interface {
 
 	// VerifySignature verifies a signature against a message and a public key.
 	VerifySignature(pubKey BLSPubkey, msg []byte, signature BLSSignature) error
+
+	// FastAggregateVerify verifies an aggregate signature against a message
+	// and the public keys of its signers.
+	FastAggregateVerify(
+		pubKeys []BLSPubkey, msg []byte, signature BLSSignature,
+	) error
 }
@@ -20,6 +20,54 @@ func (_m *BLSSigner) EXPECT() *BLSSigner_Expecter {
 	return &BLSSigner_Expecter{mock: &_m.Mock}
 }
 
+// FastAggregateVerify provides a mock function with given fields: pubKeys, msg, signature
+func (_m *BLSSigner) FastAggregateVerify(pubKeys []crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature) error {
+	ret := _m.Called(pubKeys, msg, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FastAggregateVerify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]crypto.BLSPubkey, []byte, crypto.BLSSignature) error); ok {
+		r0 = rf(pubKeys, msg, signature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BLSSigner_FastAggregateVerify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FastAggregateVerify'
+type BLSSigner_FastAggregateVerify_Call struct {
+	*mock.Call
+}
+
+// FastAggregateVerify is a helper method to define mock.On call
+//   - pubKeys []crypto.BLSPubkey
+//   - msg []byte
+//   - signature crypto.BLSSignature
+func (_e *BLSSigner_Expecter) FastAggregateVerify(pubKeys interface{}, msg interface{}, signature interface{}) *BLSSigner_FastAggregateVerify_Call {
+	return &BLSSigner_FastAggregateVerify_Call{Call: _e.mock.On("FastAggregateVerify", pubKeys, msg, signature)}
+}
+
+func (_c *BLSSigner_FastAggregateVerify_Call) Run(run func(pubKeys []crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature)) *BLSSigner_FastAggregateVerify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]crypto.BLSPubkey), args[1].([]byte), args[2].(crypto.BLSSignature))
+	})
+	return _c
+}
+
+func (_c *BLSSigner_FastAggregateVerify_Call) Return(_a0 error) *BLSSigner_FastAggregateVerify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BLSSigner_FastAggregateVerify_Call) RunAndReturn(run func([]crypto.BLSPubkey, []byte, crypto.BLSSignature) error) *BLSSigner_FastAggregateVerify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PublicKey provides a mock function with given fields:
 func (_m *BLSSigner) PublicKey() crypto.BLSPubkey {
 	ret := _m.Called()
@@ -39,7 +39,17 @@ var MarshalIndent = json.MarshalIndent
 
 var Unmarshal = json.Unmarshal
 
+var NewDecoder = json.NewDecoder
+
 // RawMessage is an alias for json.RawMessage, represensting a raw encoded JSON
 // value. It implements Marshaler and Unmarshaler and can be used to delay JSON
 // decoding or precompute a JSON encoding.
 type RawMessage = json.RawMessage
+
+// Decoder is an alias for json.Decoder, reading and decoding JSON values from
+// an input stream.
+type Decoder = json.Decoder
+
+// Delim is an alias for json.Delim, representing an array or object delimiter
+// token of a Decoder.
+type Delim = json.Delim
@@ -55,6 +55,12 @@ var (
 	// even.
 	ErrOddLengthTreeRoots = errors.New("input list length must be even")
 
+	// ErrInsufficientOutputRoots is returned when the output list is too
+	// short to hold the hashes of the input list.
+	ErrInsufficientOutputRoots = errors.New(
+		"output list too short for input list",
+	)
+
 	// ErrMaxRootsExceeded is returned when the number of roots exceeds the
 	// maximum allowed.
 	ErrMaxRootsExceeded = errors.New(
@@ -20,8 +20,6 @@
 
 package merkle
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
-
 // VerifyProof given a tree root, a leaf, the generalized merkle index
 // of the leaf in the tree, and the proof itself.
 func VerifyProof[RootT, ProofT ~[32]byte](
@@ -72,17 +70,10 @@ func RootFromBranch[RootT, BranchT ~[32]byte](
 ) RootT {
 	var (
 		hashInput  [64]byte
-		hashFn     func([]byte) [32]byte
+		hashFn     = hashFnForDepth(SHA256Hasher{}, depth)
 		merkleRoot = leaf
 	)
 
-	//nolint:mnd // 5 as defined by the library.
-	if depth > 5 {
-		hashFn = sha256.CustomHashFn()
-	} else {
-		hashFn = sha256.Hash
-	}
-
 	for i := range depth {
 		ithBit := (index >> i) & 1
 		if ithBit == 1 {
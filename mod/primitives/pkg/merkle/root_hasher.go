@@ -28,7 +28,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes/buffer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
-	"github.com/prysmaticlabs/gohashtree"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -160,6 +159,18 @@ func BuildParentTreeRoots[RootT ~[32]byte](
 // supporting generics.
 func BuildParentTreeRootsWithNRoutines(
 	outputList, inputList [][32]byte, minParallelizationSize int,
+) error {
+	return buildParentTreeRoots(
+		SHA256Hasher{}, outputList, inputList, minParallelizationSize,
+	)
+}
+
+// buildParentTreeRoots hashes a list of roots as in
+// BuildParentTreeRootsWithNRoutines, with the given hasher.
+func buildParentTreeRoots(
+	hasher TreeHasher,
+	outputList, inputList [][32]byte,
+	minParallelizationSize int,
 ) error {
 	// Validate input list length.
 	inputLength := len(inputList)
@@ -170,7 +181,7 @@ func BuildParentTreeRootsWithNRoutines(
 	// If the input list is small, hash it using the default method since
 	// the overhead of parallelizing the hashing process is not worth it.
 	if inputLength < minParallelizationSize {
-		return gohashtree.Hash(outputList, inputList)
+		return hashLayer(hasher, outputList, inputList)
 	}
 
 	// Get the number of goroutines to use.
@@ -205,7 +216,8 @@ func BuildParentTreeRootsWithNRoutines(
 			segmentStart := j * twiceGroupSize
 			segmentEnd := (j + 1) * twiceGroupSize
 
-			return gohashtree.Hash(
+			return hashLayer(
+				hasher,
 				outputList[j*groupSize:],
 				inputList[segmentStart:segmentEnd],
 			)
@@ -213,7 +225,8 @@ func BuildParentTreeRootsWithNRoutines(
 	}
 
 	// Hash the last segment of the inputList.
-	if err := gohashtree.Hash(
+	if err := hashLayer(
+		hasher,
 		outputList[n*groupSize:],
 		inputList[n*twiceGroupSize:],
 	); err != nil {
@@ -23,10 +23,10 @@ package merkle
 import (
 	"encoding/binary"
 	"fmt"
+	"unsafe"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
 )
@@ -43,7 +43,8 @@ type Tree[RootT ~[32]byte] struct {
 	branches [][]RootT
 	leaves   []RootT
 
-	hasher Hasher[[32]byte]
+	hasher     Hasher[[32]byte]
+	treeHasher TreeHasher
 }
 
 // NewTreeFromLeaves constructs a Merkle tree, with the minimum
@@ -75,6 +76,16 @@ func NewTreeWithMaxLeaves[RootT ~[32]byte](
 func NewTreeFromLeavesWithDepth[RootT ~[32]byte](
 	leaves []RootT,
 	depth uint8,
+) (*Tree[RootT], error) {
+	return NewTreeFromLeavesWithHasher(leaves, depth, SHA256Hasher{})
+}
+
+// NewTreeFromLeavesWithHasher constructs a Merkle tree of the given depth
+// like NewTreeFromLeavesWithDepth, merkleizing it with the given hasher.
+func NewTreeFromLeavesWithHasher[RootT ~[32]byte](
+	leaves []RootT,
+	depth uint8,
+	h TreeHasher,
 ) (*Tree[RootT], error) {
 	if err := verifySufficientDepth(len(leaves), depth); err != nil {
 		return &Tree[RootT]{}, err
@@ -96,18 +107,24 @@ func NewTreeFromLeavesWithDepth[RootT ~[32]byte](
 			currentLayer = append(currentLayer, zero.Hashes[d])
 		}
 
-		if err := BuildParentTreeRoots(
-			layers[d+1], currentLayer,
+		if err := buildParentTreeRoots(
+			h,
+			//#nosec:G103 // on purpose.
+			*(*[][32]byte)(unsafe.Pointer(&layers[d+1])),
+			//#nosec:G103 // on purpose.
+			*(*[][32]byte)(unsafe.Pointer(&currentLayer)),
+			MinParallelizationSize,
 		); err != nil {
 			return &Tree[RootT]{}, err
 		}
 	}
 
 	return &Tree[RootT]{
-		branches: layers,
-		leaves:   leaves,
-		depth:    depth,
-		hasher:   NewHasher[[32]byte](sha256.Hash),
+		branches:   layers,
+		leaves:     leaves,
+		depth:      depth,
+		hasher:     NewHasher[[32]byte](h.Hash),
+		treeHasher: h,
 	}, nil
 }
 
@@ -127,20 +144,13 @@ func (m *Tree[RootT]) Insert(item [32]byte, index int) error {
 	}
 
 	var (
-		hashFn       func([]byte) [32]byte
+		hashFn       = hashFnForDepth(m.treeHasher, m.depth)
 		neighbor     = [32]byte{}
 		input        = [64]byte{}
 		currentIndex = index
 		root         = item
 	)
 
-	//nolint:mnd // 5 as defined by the library.
-	if m.depth > 5 {
-		hashFn = sha256.CustomHashFn()
-	} else {
-		hashFn = sha256.Hash
-	}
-
 	for i := range m.depth {
 		if neighborIdx := currentIndex ^ 1; neighborIdx >= len(m.branches[i]) {
 			neighbor = zero.Hashes[i]
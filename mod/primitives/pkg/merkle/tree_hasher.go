@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/prysmaticlabs/gohashtree"
+)
+
+// TreeHasher is the hash function used to merkleize trees. Implementations
+// must be SHA-256, but may e.g. be hardware accelerated.
+type TreeHasher interface {
+	// Hash returns the hash of the data.
+	Hash(data []byte) [32]byte
+	// HashPair returns the hash of the concatenation of a and b.
+	HashPair(a, b [32]byte) [32]byte
+}
+
+// LayerHasher is a TreeHasher that can hash a whole layer of a tree at once.
+type LayerHasher interface {
+	TreeHasher
+	// HashLayer hashes each consecutive pair of roots of the input into the
+	// output.
+	HashLayer(output, input [][32]byte) error
+}
+
+// SHA256Hasher is the default TreeHasher, hashing layers with gohashtree.
+type SHA256Hasher struct{}
+
+// Hash returns the SHA-256 of the data.
+func (SHA256Hasher) Hash(data []byte) [32]byte {
+	return sha256.Hash(data)
+}
+
+// HashPair returns the SHA-256 of the concatenation of a and b.
+func (SHA256Hasher) HashPair(a, b [32]byte) [32]byte {
+	var input [64]byte
+	copy(input[:32], a[:])
+	copy(input[32:], b[:])
+	return sha256.Hash(input[:])
+}
+
+// HashLayer hashes each consecutive pair of roots of the input into the
+// output.
+func (SHA256Hasher) HashLayer(output, input [][32]byte) error {
+	return gohashtree.Hash(output, input)
+}
+
+// hashLayer hashes each consecutive pair of roots of the input into the
+// output with the given hasher.
+func hashLayer(h TreeHasher, output, input [][32]byte) error {
+	if lh, ok := h.(LayerHasher); ok {
+		return lh.HashLayer(output, input)
+	}
+	if len(input)%two != 0 {
+		return ErrOddLengthTreeRoots
+	}
+	if len(output) < len(input)/two {
+		return ErrInsufficientOutputRoots
+	}
+	for i := range len(input) / two {
+		output[i] = h.HashPair(input[two*i], input[two*i+1])
+	}
+	return nil
+}
+
+// hashFnForDepth returns the hash function of the hasher to hash the given
+// number of layers of a tree with. The default hasher reuses its internal
+// state when hashing deep trees.
+func hashFnForDepth(h TreeHasher, depth uint8) HashFn {
+	if _, ok := h.(SHA256Hasher); !ok {
+		return h.Hash
+	}
+
+	//nolint:mnd // 5 as defined by the library.
+	if depth > 5 {
+		return sha256.CustomHashFn()
+	}
+	return sha256.Hash
+}
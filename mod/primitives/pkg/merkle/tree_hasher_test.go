@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// stdlibHasher is a TreeHasher backed by the standard library, hashing
+// layers pair by pair.
+type stdlibHasher struct{}
+
+func (stdlibHasher) Hash(data []byte) [32]byte {
+	return sha256.Sum256(data)
+}
+
+func (stdlibHasher) HashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// treeHashers are the TreeHasher implementations compared by the tests.
+//
+//nolint:gochecknoglobals // test fixture.
+var treeHashers = map[string]merkle.TreeHasher{
+	"sha256": merkle.SHA256Hasher{},
+	"stdlib": stdlibHasher{},
+}
+
+// testLeaves returns n distinct leaves.
+func testLeaves(n int) [][32]byte {
+	leaves := make([][32]byte, n)
+	for i := range leaves {
+		leaves[i] = sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+	}
+	return leaves
+}
+
+func TestTreeHasherEquivalence(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 64, 5000, 10001} {
+		var roots [][32]byte
+		for name, h := range treeHashers {
+			leaves := testLeaves(n)
+			tree, err := merkle.NewTreeFromLeavesWithHasher(leaves, 14, h)
+			require.NoError(t, err, name)
+			root := tree.HashTreeRoot()
+
+			// Proofs verify against the root of the tree.
+			proof, err := tree.MerkleProof(uint64(n - 1))
+			require.NoError(t, err, name)
+			require.True(t, merkle.VerifyProof(
+				tree.Root(), leaves[n-1], uint64(1<<14+n-1), proof,
+			), name)
+
+			// Inserting a leaf updates the root the same way.
+			require.NoError(t, tree.Insert([32]byte{0x01}, 0), name)
+			roots = append(roots, root, tree.HashTreeRoot())
+		}
+		for i := 2; i < len(roots); i++ {
+			require.Equal(t, roots[i%2], roots[i], "%d leaves", n)
+		}
+	}
+}
+
+func TestTreeDefaultHasher(t *testing.T) {
+	leaves := testLeaves(7)
+	tree, err := merkle.NewTreeWithMaxLeaves(leaves, 1<<14)
+	require.NoError(t, err)
+	hashed, err := merkle.NewTreeFromLeavesWithHasher(
+		leaves, 14, merkle.SHA256Hasher{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, hashed.HashTreeRoot(), tree.HashTreeRoot())
+}
+
+func BenchmarkTreeHasher(b *testing.B) {
+	for _, n := range []int{64, 8192} {
+		leaves := testLeaves(n)
+		for name, h := range treeHashers {
+			b.Run(fmt.Sprintf("%s/%d", name, n), func(b *testing.B) {
+				for range b.N {
+					_, err := merkle.NewTreeFromLeavesWithHasher(
+						leaves, 14, h,
+					)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
@@ -22,6 +22,21 @@ package transition
 
 import "context"
 
+// misbehavingValidatorsKey is the context key of the CometBFT addresses of
+// the validators the block being processed holds evidence against.
+type misbehavingValidatorsKey struct{}
+
+// WithMisbehavingValidators returns a copy of ctx carrying the CometBFT
+// addresses of the validators the block being processed holds evidence of
+// misbehavior against. The evidence is part of the block agreed on by
+// CometBFT, hence it is the same whenever the block is processed.
+func WithMisbehavingValidators(
+	ctx context.Context,
+	addresses [][]byte,
+) context.Context {
+	return context.WithValue(ctx, misbehavingValidatorsKey{}, addresses)
+}
+
 // Context is the context for the state transition.
 type Context struct {
 	context.Context
@@ -38,6 +53,10 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// AuditOperations indicates whether to emit the state-changing operations
+	// of the block to the audit sink. This is only set when the resulting
+	// state is committed.
+	AuditOperations bool
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -65,6 +84,23 @@ func (c *Context) GetSkipValidateResult() bool {
 	return c.SkipValidateResult
 }
 
+// GetAuditOperations returns whether to emit the state-changing operations of
+// the block to the audit sink.
+func (c *Context) GetAuditOperations() bool {
+	return c.AuditOperations
+}
+
+// GetMisbehavingValidators returns the CometBFT addresses of the validators
+// the block holds evidence of misbehavior against, as carried by the
+// underlying context.
+func (c *Context) GetMisbehavingValidators() [][]byte {
+	if c.Context == nil {
+		return nil
+	}
+	addresses, _ := c.Context.Value(misbehavingValidatorsKey{}).([][]byte)
+	return addresses
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
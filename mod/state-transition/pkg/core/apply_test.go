@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testKVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	testBeaconStateMarshallable = types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]
+
+	testStateDB = state.StateDB[
+		*types.BeaconBlockHeader,
+		*testBeaconStateMarshallable,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+
+	testStateProcessor = core.StateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*testStateDB,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	]
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("apply-tests")
+
+// testKVStoreService opens the store of the context it is given, so that
+// copies of the state operate on their own cache.
+type testKVStoreService struct{}
+
+func (testKVStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	return components.NewKVStore(
+		sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey),
+	)
+}
+
+// newTestStateDB returns a StateDB backed by an in-memory store.
+func newTestStateDB(t testing.TB, cs common.ChainSpec) *testStateDB {
+	t.Helper()
+	var (
+		nopLog = log.NewNopLogger()
+		cms    = store.NewCommitMultiStore(
+			dbm.NewMemDB(), nopLog, metrics.NewNoOpMetrics(),
+		)
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, true, nopLog)
+	kvStore := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		testKVStoreService{},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	return (&testStateDB{}).NewFromDB(kvStore.WithContext(ctx), cs)
+}
+
+// testSpecData returns the data of a chain spec with small vectors suitable
+// for tests.
+func testSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+] {
+	return chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]{
+		SlotsPerEpoch:                    4,
+		SlotsPerHistoricalRoot:           8,
+		HistoricalRootsLimit:             8,
+		EpochsPerHistoricalVector:        8,
+		EpochsPerSlashingsVector:         8,
+		EpochsPerEth1VotingPeriod:        1,
+		Eth1FollowDistance:               1,
+		MaxEffectiveBalance:              32e9,
+		EffectiveBalanceIncrement:        1e9,
+		MaxWithdrawalsPerPayload:         16,
+		MaxValidatorsPerWithdrawalsSweep: 16,
+	}
+}
+
+// testChainSpec returns a chain spec with small vectors suitable for tests.
+func testChainSpec() common.ChainSpec {
+	return chain.NewChainSpec(testSpecData())
+}
+
+// testStateProcessorOptions are the options of the state processors returned
+// by newTestStateProcessor.
+type testStateProcessorOptions struct {
+	// cfg is the configuration of the state processor.
+	cfg core.Config
+	// timingSink, if set, receives the phase timings of every block
+	// transitioned.
+	timingSink core.TimingSink
+	// auditSink, if set, receives the records of the operations of blocks
+	// processed with auditing enabled.
+	auditSink core.AuditSink
+}
+
+// newTestStateProcessor returns a state processor without an execution
+// engine.
+func newTestStateProcessor(
+	cs common.ChainSpec,
+	signer crypto.BLSSigner,
+	opts testStateProcessorOptions,
+) *testStateProcessor {
+	return core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*testStateDB,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](
+		cs, nil, signer, opts.auditSink, opts.cfg, opts.timingSink,
+	)
+}
+
+// newTestGenesisState returns the genesis state of a chain without deposits,
+// initialized by the state processor at the Deneb fork, to which the given
+// validators are added without any balance.
+func newTestGenesisState(
+	t testing.TB,
+	cs common.ChainSpec,
+	sp *testStateProcessor,
+	validators ...*types.Validator,
+) *testStateDB {
+	t.Helper()
+	st := newTestStateDB(t, cs)
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		nil,
+		(&types.ExecutionPayloadHeader{}).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	for _, val := range validators {
+		require.NoError(t, st.AddValidator(val))
+	}
+	return st
+}
+
+// newTestValidator returns an active validator with the given pubkey,
+// staking the maximum effective balance and withdrawing to the given address.
+func newTestValidator(
+	pubkey crypto.BLSPubkey,
+	address common.ExecutionAddress,
+) *types.Validator {
+	return &types.Validator{
+		Pubkey: pubkey,
+		WithdrawalCredentials: types.NewCredentialsFromExecutionAddress(
+			address,
+		),
+		EffectiveBalance:  32e9,
+		ExitEpoch:         math.Epoch(constants.FarFutureEpoch),
+		WithdrawableEpoch: math.Epoch(constants.FarFutureEpoch),
+	}
+}
+
+func TestApplyBlock(t *testing.T) {
+	var (
+		cs = testChainSpec()
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+	)
+
+	// Build a genesis state with a single fully staked validator.
+	preState := newTestGenesisState(
+		t, cs, sp, newTestValidator(
+			crypto.BLSPubkey{}, common.ExecutionAddress{0x01},
+		),
+	)
+	require.NoError(t, preState.IncreaseBalance(0, 32e9))
+	preRoot := preState.HashTreeRoot()
+
+	// The parent of the block is the genesis header, sealed by the slot
+	// processing.
+	sealed := preState.Copy()
+	_, err := sp.ProcessSlots(sealed, 1)
+	require.NoError(t, err)
+	parent, err := sealed.GetLatestBlockHeader()
+	require.NoError(t, err)
+	withdrawals, err := sealed.ExpectedWithdrawals()
+	require.NoError(t, err)
+
+	newBlock := func(stateRoot common.Root) *types.BeaconBlock {
+		blk, err := (&types.BeaconBlock{}).NewWithVersion(
+			1, 0, parent.HashTreeRoot(), version.Deneb,
+		)
+		require.NoError(t, err)
+		blk.StateRoot = stateRoot
+		blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		blk.Body.ExecutionPayload.Withdrawals = withdrawals
+		return blk
+	}
+
+	// Compute the post-state root without validating it.
+	postRoot, err := sp.ApplyBlock(&transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+	}, preState, newBlock(common.Root{}))
+	require.NoError(t, err)
+	require.NotEqual(t, preRoot, postRoot)
+	require.Equal(t, preRoot, preState.HashTreeRoot())
+
+	validating := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+	}
+
+	t.Run("known root", func(t *testing.T) {
+		root, err := sp.ApplyBlock(validating, preState, newBlock(postRoot))
+		require.NoError(t, err)
+		require.Equal(t, postRoot, root)
+		require.Equal(t, preRoot, preState.HashTreeRoot())
+	})
+
+	t.Run("wrong root", func(t *testing.T) {
+		_, err := sp.ApplyBlock(
+			validating, preState, newBlock(common.Root{0x01}),
+		)
+		require.ErrorIs(t, err, core.ErrStateRootMismatch)
+		require.Equal(t, preRoot, preState.HashTreeRoot())
+	})
+}
@@ -0,0 +1,381 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// AggregateVerifier verifies an aggregate signature of the given pubkeys over
+// a message, i.e. FastAggregateVerify. It is never called without pubkeys.
+type AggregateVerifier func(
+	pubkeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error
+
+// attestationState is the state accessed by attestation processing.
+type attestationState[ValidatorT syncCommitteeValidator] interface {
+	syncCommitteeState[ValidatorT]
+	GetGenesisValidatorsRoot() (common.Root, error)
+	CommitteeCountPerSlot(math.Epoch) (uint64, error)
+}
+
+// attestationProcessingState is the state updated by attestation processing.
+type attestationProcessingState[
+	ValidatorT syncCommitteeValidator,
+] interface {
+	attestationState[ValidatorT]
+	GetBlockRootAtIndex(uint64) (common.Root, error)
+	GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+	GetCurrentEpochParticipation(math.ValidatorIndex) (byte, error)
+	SetPreviousEpochParticipation(math.ValidatorIndex, byte) error
+	SetCurrentEpochParticipation(math.ValidatorIndex, byte) error
+}
+
+// ProcessAttestation verifies the attestation against the state and records
+// the participation of its attesters, as defined in the Ethereum 2.0
+// specification. The aggregate pubkey is reconstructed from the committee
+// members set in the aggregation bits, and must verify the aggregate
+// signature over the signing root of the attestation data.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#modified-process_attestation
+//
+//nolint:lll // link.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+]) ProcessAttestation(
+	st BeaconStateT,
+	attestation Attestation[AttestationData],
+) error {
+	return processAttestation[ForkDataT](
+		st, sp.cs, sp.shuffler, sp.signer.FastAggregateVerify, attestation,
+	)
+}
+
+// processAttestation verifies the attestation against the state, its
+// aggregate signature with the given verifier, and records the participation
+// of its attesters.
+func processAttestation[
+	ForkDataT ForkData[ForkDataT],
+	AttestationDataT AttestationData,
+	ValidatorT syncCommitteeValidator,
+](
+	st attestationProcessingState[ValidatorT],
+	cs common.ChainSpec,
+	shuffler Shuffler,
+	verifyAggregate AggregateVerifier,
+	attestation Attestation[AttestationDataT],
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// An attestation is included at least MinAttestationInclusionDelay slots
+	// after its slot, and at the latest in the epoch following its own.
+	data := attestation.GetData()
+	attestationSlot := math.Slot(data.GetSlot())
+	if attestationSlot+math.Slot(cs.MinAttestationInclusionDelay()) > slot ||
+		cs.SlotToEpoch(attestationSlot)+1 < cs.SlotToEpoch(slot) {
+		return errors.Wrapf(
+			ErrAttestationSlotOutOfRange, "attestation slot: %d, slot: %d",
+			attestationSlot, slot,
+		)
+	}
+
+	committee, err := beaconCommitteeIndices(
+		st, cs, shuffler, attestationSlot, data.GetIndex().Unwrap(),
+	)
+	if err != nil {
+		return err
+	}
+
+	bits := attestation.GetAggregationBits()
+	if expected := (len(committee) + 7) / 8; len(bits) != expected {
+		return errors.Wrapf(
+			ErrInvalidAggregationBits, "expected: %d bytes, got: %d",
+			expected, len(bits),
+		)
+	}
+	// The bits past the committee members must not be set.
+	if padding := len(committee) % 8; padding != 0 &&
+		bits[len(bits)-1]>>padding != 0 {
+		return errors.Wrapf(
+			ErrInvalidAggregationBits, "padding bits set: %08b",
+			bits[len(bits)-1],
+		)
+	}
+	var (
+		attesters    = make([]math.ValidatorIndex, 0, len(committee))
+		participants = make([]crypto.BLSPubkey, 0, len(committee))
+	)
+	for i, index := range committee {
+		if !participates(bits, i) {
+			continue
+		}
+		val, err := st.ValidatorByIndex(index)
+		if err != nil {
+			return err
+		}
+		attesters = append(attesters, index)
+		participants = append(participants, val.GetPubkey())
+	}
+	if len(participants) == 0 {
+		return ErrNoAttestationParticipants
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	forkVersion := cs.ActiveForkVersionForEpoch(
+		cs.SlotToEpoch(attestationSlot),
+	)
+	domain, err := cs.DomainTypeRegistry().DomainType(
+		chain.DomainNameAttester, forkVersion,
+	)
+	if err != nil {
+		return err
+	}
+
+	var fd ForkDataT
+	signingRoot := fd.New(
+		version.FromUint32[common.Version](forkVersion), genesisValidatorsRoot,
+	).ComputeAttestationSigningRoot(domain, data)
+	if err = verifyAggregate(
+		participants, signingRoot[:], attestation.GetSignature(),
+	); err != nil {
+		return errors.Wrap(ErrInvalidAttestationSignature, err.Error())
+	}
+	return recordParticipation(st, cs, slot, data, attesters)
+}
+
+// recordParticipation adds the participation flags earned by the attestation
+// to those of its attesters, as defined in the Ethereum 2.0 specification.
+// Attestation data carries no checkpoints, as blocks are final once
+// committed: the source and target of an attestation always match, the
+// source being timely within the square root of an epoch. The head matches
+// if the attested root is that of the block at the attestation slot.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#modified-get_attestation_participation_flag_indices
+//
+// Participation is only recorded from the Electra fork onwards.
+//
+//nolint:lll // link.
+func recordParticipation[ValidatorT syncCommitteeValidator](
+	st attestationProcessingState[ValidatorT],
+	cs common.ChainSpec,
+	slot math.Slot,
+	data AttestationData,
+	attesters []math.ValidatorIndex,
+) error {
+	epoch := cs.SlotToEpoch(slot)
+	if cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+
+	var (
+		attestationSlot = math.Slot(data.GetSlot())
+		delay           = (slot - attestationSlot).Unwrap()
+		flags           = byte(1 << TimelyTargetFlagIndex)
+	)
+	if delay <= integerSquareRoot(cs.SlotsPerEpoch()) {
+		flags |= 1 << TimelySourceFlagIndex
+	}
+	headRoot, err := st.GetBlockRootAtIndex(
+		attestationSlot.Unwrap() % cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return err
+	}
+	if headRoot == data.GetBeaconBlockRoot() &&
+		delay == cs.MinAttestationInclusionDelay() {
+		flags |= 1 << TimelyHeadFlagIndex
+	}
+
+	getParticipation := st.GetCurrentEpochParticipation
+	setParticipation := st.SetCurrentEpochParticipation
+	if cs.SlotToEpoch(attestationSlot) < epoch {
+		getParticipation = st.GetPreviousEpochParticipation
+		setParticipation = st.SetPreviousEpochParticipation
+	}
+	for _, index := range attesters {
+		participation, err := getParticipation(index)
+		if err != nil {
+			return err
+		}
+		if err = setParticipation(index, participation|flags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// integerSquareRoot returns the largest integer whose square does not exceed
+// n, as defined in the Ethereum 2.0 specification.
+func integerSquareRoot(n uint64) uint64 {
+	x, y := n, (n+1)/2
+	for y < x {
+		x, y = y, (y+n/y)/2
+	}
+	return x
+}
+
+// BeaconCommittee returns the indices of the members of the beacon committee
+// with the given index in the slot, as defined in the Ethereum 2.0
+// specification. The validators are only known as of the current epoch of the
+// state, hence only the committees of the previous epoch, the current one and
+// the MinSeedLookahead epochs following it can be derived.
+func BeaconCommittee[ValidatorT syncCommitteeValidator](
+	st attestationState[ValidatorT],
+	cs common.ChainSpec,
+	shuffler Shuffler,
+	slot math.Slot,
+	index uint64,
+) ([]math.ValidatorIndex, error) {
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		current = cs.SlotToEpoch(stateSlot)
+		epoch   = cs.SlotToEpoch(slot)
+	)
+	if epoch+1 < current ||
+		epoch > current+math.Epoch(cs.MinSeedLookahead()) {
+		return nil, errors.Wrapf(
+			ErrCommitteeEpochOutOfRange,
+			"epoch: %d, current epoch: %d", epoch, current,
+		)
+	}
+	return beaconCommitteeIndices(st, cs, shuffler, slot, index)
+}
+
+// beaconCommittee returns the pubkeys of the members of the beacon committee
+// with the given index in the slot.
+func beaconCommittee[ValidatorT syncCommitteeValidator](
+	st attestationState[ValidatorT],
+	cs common.ChainSpec,
+	shuffler Shuffler,
+	slot math.Slot,
+	index uint64,
+) ([]crypto.BLSPubkey, error) {
+	indices, err := beaconCommitteeIndices(st, cs, shuffler, slot, index)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]crypto.BLSPubkey, 0, len(indices))
+	for _, i := range indices {
+		val, err := st.ValidatorByIndex(i)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, val.GetPubkey())
+	}
+	return members, nil
+}
+
+// beaconCommitteeIndices returns the indices of the members of the beacon
+// committee with the given index in the slot, as defined in the Ethereum 2.0
+// specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_beacon_committee
+//
+//nolint:lll // link.
+func beaconCommitteeIndices[ValidatorT syncCommitteeValidator](
+	st attestationState[ValidatorT],
+	cs common.ChainSpec,
+	shuffler Shuffler,
+	slot math.Slot,
+	index uint64,
+) ([]math.ValidatorIndex, error) {
+	epoch := cs.SlotToEpoch(slot)
+	committeesPerSlot, err := st.CommitteeCountPerSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if index >= committeesPerSlot {
+		return nil, errors.Wrapf(
+			ErrInvalidCommitteeIndex, "index: %d, committees per slot: %d",
+			index, committeesPerSlot,
+		)
+	}
+
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+	active := make([]math.ValidatorIndex, 0, total)
+	for i := range total {
+		val, err := st.ValidatorByIndex(math.ValidatorIndex(i))
+		if err != nil {
+			return nil, err
+		}
+		if val.IsInValidatorSet(epoch) {
+			active = append(active, math.ValidatorIndex(i))
+		}
+	}
+	if len(active) == 0 {
+		return nil, ErrNoActiveValidators
+	}
+
+	domain, err := cs.DomainTypeRegistry().DomainType(
+		chain.DomainNameAttester, cs.ActiveForkVersionForEpoch(epoch),
+	)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := committeeSeed(st, cs, epoch.Unwrap(), domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// The committees of the epoch partition the shuffled active validators,
+	// numbered by slot and then by index within the slot.
+	var (
+		count     = uint64(len(active))
+		committee = (slot.Unwrap()%cs.SlotsPerEpoch())*committeesPerSlot +
+			index
+		committees = committeesPerSlot * cs.SlotsPerEpoch()
+		start      = count * committee / committees
+		end        = count * (committee + 1) / committees
+		members    = make([]math.ValidatorIndex, 0, end-start)
+	)
+	for i := start; i < end; i++ {
+		shuffled, err := shuffler.ShuffleIndex(i, count, seed)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, active[shuffled])
+	}
+	return members, nil
+}
+
+// participates returns whether the bit of the committee member at the given
+// position is set.
+func participates(bits []byte, position int) bool {
+	return bits[position/8]&(1<<(position%8)) != 0
+}
@@ -0,0 +1,437 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttestation is an attestation of the given bits, data and signature.
+type testAttestation struct {
+	bits      []byte
+	data      *types.AttestationData
+	signature crypto.BLSSignature
+}
+
+func (a testAttestation) GetAggregationBits() []byte {
+	return a.bits
+}
+
+func (a testAttestation) GetData() *types.AttestationData {
+	return a.data
+}
+
+func (a testAttestation) GetSignature() crypto.BLSSignature {
+	return a.signature
+}
+
+// testAttestationState is an in-memory attestation state.
+type testAttestationState struct {
+	*testCommitteeState
+	committeesPerSlot uint64
+	blockRoots        map[uint64]common.Root
+	previous          map[math.ValidatorIndex]byte
+	current           map[math.ValidatorIndex]byte
+}
+
+func (*testAttestationState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{0xaa}, nil
+}
+
+func (s *testAttestationState) CommitteeCountPerSlot(
+	math.Epoch,
+) (uint64, error) {
+	return s.committeesPerSlot, nil
+}
+
+func (s *testAttestationState) GetBlockRootAtIndex(
+	index uint64,
+) (common.Root, error) {
+	return s.blockRoots[index], nil
+}
+
+func (s *testAttestationState) GetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return s.previous[idx], nil
+}
+
+func (s *testAttestationState) GetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return s.current[idx], nil
+}
+
+func (s *testAttestationState) SetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	s.previous[idx] = flags
+	return nil
+}
+
+func (s *testAttestationState) SetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+	flags byte,
+) error {
+	s.current[idx] = flags
+	return nil
+}
+
+// testAggregateSignature is the signature the test aggregate verifier
+// accepts for the pubkeys over the message.
+func testAggregateSignature(
+	pubkeys []crypto.BLSPubkey,
+	msg []byte,
+) crypto.BLSSignature {
+	var buf []byte
+	for _, pubkey := range pubkeys {
+		buf = append(buf, pubkey[:]...)
+	}
+	hash := sha256.Hash(append(buf, msg...))
+
+	var signature crypto.BLSSignature
+	copy(signature[:], hash[:])
+	return signature
+}
+
+// testVerifyAggregate is an AggregateVerifier accepting the signatures of
+// testAggregateSignature.
+func testVerifyAggregate(
+	pubkeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	if expected := testAggregateSignature(pubkeys, msg); !bytes.Equal(
+		expected[:], signature[:],
+	) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func TestProcessAttestation(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:                2,
+			SlotsPerHistoricalRoot:       8,
+			MinSeedLookahead:             1,
+			EpochsPerHistoricalVector:    8,
+			MinAttestationInclusionDelay: 1,
+			DomainTypeAttester:           common.DomainType{0x01},
+		},
+	)
+	shuffler := NewSwapOrNotShuffler(ShuffleRoundCount)
+	process := func(
+		st *testAttestationState,
+		attestation testAttestation,
+	) error {
+		return processAttestation[*types.ForkData](
+			st, cs, shuffler, testVerifyAggregate, attestation,
+		)
+	}
+
+	// The state at slot 9 of epoch 4, with 2 committees per slot over the
+	// 16 validators active, i.e. 4 members per committee.
+	st := &testAttestationState{
+		testCommitteeState: &testCommitteeState{slot: 9},
+		committeesPerSlot:  2,
+		blockRoots:         map[uint64]common.Root{0: {0xbb}},
+		previous:           make(map[math.ValidatorIndex]byte),
+		current:            make(map[math.ValidatorIndex]byte),
+	}
+	for i := range cs.EpochsPerHistoricalVector() {
+		var mix common.Bytes32
+		for j := range mix {
+			mix[j] = byte(i + 1)
+		}
+		st.mixes = append(st.mixes, mix)
+	}
+	for i := range 16 {
+		st.validators = append(st.validators, testCommitteeValidator{
+			pubkey:  crypto.BLSPubkey{byte(i)},
+			balance: 32e9,
+			exit:    math.Epoch(^uint64(0)),
+		})
+	}
+
+	data := (&types.AttestationData{}).New(8, 1, common.Root{0xbb})
+	committee, err := beaconCommittee(st, cs, shuffler, 8, 1)
+	require.NoError(t, err)
+	require.Len(t, committee, 4)
+
+	// The committees of the epoch partition its active validators.
+	seen := make(map[crypto.BLSPubkey]struct{})
+	for slot := range math.Slot(2) {
+		for index := range uint64(2) {
+			members, err := beaconCommittee(st, cs, shuffler, 8+slot, index)
+			require.NoError(t, err)
+			for _, pubkey := range members {
+				seen[pubkey] = struct{}{}
+			}
+		}
+	}
+	require.Len(t, seen, 16)
+
+	// sign returns the signature of the committee members at the set bits
+	// over the attestation data.
+	sign := func(bits []byte) crypto.BLSSignature {
+		var participants []crypto.BLSPubkey
+		for i, pubkey := range committee {
+			if participates(bits, i) {
+				participants = append(participants, pubkey)
+			}
+		}
+		fd := (&types.ForkData{}).New(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(4),
+			),
+			common.Root{0xaa},
+		)
+		root := fd.ComputeAttestationSigningRoot(
+			common.DomainType{0x01}, data,
+		)
+		return testAggregateSignature(participants, root[:])
+	}
+
+	t.Run("valid aggregate", func(t *testing.T) {
+		for _, bits := range [][]byte{{0x0f}, {0x05}, {0x08}} {
+			require.NoError(t, process(
+				st, testAttestation{
+					bits: bits, data: data, signature: sign(bits),
+				},
+			))
+		}
+
+		// The attesters are timely with the source, target and head.
+		require.Len(t, st.current, len(committee))
+		for _, pubkey := range committee {
+			require.Equal(
+				t, byte(0b111), st.current[math.ValidatorIndex(pubkey[0])],
+			)
+		}
+		require.Empty(t, st.previous)
+	})
+
+	t.Run("participation", func(t *testing.T) {
+		st.current = make(map[math.ValidatorIndex]byte)
+
+		// An attestation of the previous epoch to another head, included
+		// after the square root of an epoch, is only timely with the target.
+		require.NoError(t, recordParticipation(
+			st, cs, 9, (&types.AttestationData{}).New(7, 0, common.Root{}),
+			[]math.ValidatorIndex{3, 5},
+		))
+		require.Equal(t, map[math.ValidatorIndex]byte{
+			3: 0b010, 5: 0b010,
+		}, st.previous)
+		require.Empty(t, st.current)
+
+		// Flags already earned are kept.
+		st.current[3] = 0b100
+		require.NoError(t, recordParticipation(
+			st, cs, 9, (&types.AttestationData{}).New(8, 0, common.Root{}),
+			[]math.ValidatorIndex{3},
+		))
+		require.Equal(t, byte(0b111), st.current[3])
+
+		// Before the Electra fork, no participation is recorded.
+		deneb := chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch:      2,
+				ElectraForkEpoch:   5,
+				DenebPlusForkEpoch: 5,
+			},
+		)
+		st.current = make(map[math.ValidatorIndex]byte)
+		require.NoError(t, recordParticipation(
+			st, deneb, 9, data, []math.ValidatorIndex{3},
+		))
+		require.Empty(t, st.current)
+	})
+
+	t.Run("flipped participation bit", func(t *testing.T) {
+		signature := sign([]byte{0x05})
+		for _, bits := range [][]byte{{0x07}, {0x04}} {
+			err = process(
+				st, testAttestation{
+					bits: bits, data: data, signature: signature,
+				},
+			)
+			require.ErrorIs(t, err, ErrInvalidAttestationSignature)
+		}
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		bits := []byte{0x0f}
+		signature := sign(bits)
+		signature[0] ^= 0x01
+
+		err = process(
+			st, testAttestation{bits: bits, data: data, signature: signature},
+		)
+		require.ErrorIs(t, err, ErrInvalidAttestationSignature)
+
+		// Nor is the signature valid for other attestation data.
+		err = process(
+			st, testAttestation{
+				bits:      bits,
+				data:      (&types.AttestationData{}).New(8, 1, common.Root{}),
+				signature: sign(bits),
+			},
+		)
+		require.ErrorIs(t, err, ErrInvalidAttestationSignature)
+	})
+
+	t.Run("no participants", func(t *testing.T) {
+		bits := []byte{0x00}
+		err = process(
+			st, testAttestation{bits: bits, data: data, signature: sign(bits)},
+		)
+		require.ErrorIs(t, err, ErrNoAttestationParticipants)
+	})
+
+	t.Run("wrong committee size", func(t *testing.T) {
+		bits := []byte{0x0f, 0x00}
+		err = process(
+			st, testAttestation{bits: bits, data: data, signature: sign(bits)},
+		)
+		require.ErrorIs(t, err, ErrInvalidAggregationBits)
+	})
+
+	t.Run("invalid committee index", func(t *testing.T) {
+		bits := []byte{0x0f}
+		err = process(
+			st, testAttestation{
+				bits:      bits,
+				data:      (&types.AttestationData{}).New(8, 2, common.Root{}),
+				signature: sign(bits),
+			},
+		)
+		require.ErrorIs(t, err, ErrInvalidCommitteeIndex)
+	})
+
+	t.Run("padding bits set", func(t *testing.T) {
+		for _, bits := range [][]byte{{0x1f}, {0x85}} {
+			err = process(
+				st, testAttestation{
+					bits: bits, data: data, signature: sign(bits),
+				},
+			)
+			require.ErrorIs(t, err, ErrInvalidAggregationBits)
+		}
+	})
+
+	t.Run("slot out of range", func(t *testing.T) {
+		bits := []byte{0x0f}
+		// The attestations of the current slot are not included before
+		// MinAttestationInclusionDelay, nor those of past the previous epoch.
+		for _, slot := range []math.U64{4, 9, 10} {
+			err = process(
+				st, testAttestation{
+					bits:      bits,
+					data:      (&types.AttestationData{}).New(slot, 1, common.Root{}),
+					signature: sign(bits),
+				},
+			)
+			require.ErrorIs(t, err, ErrAttestationSlotOutOfRange)
+		}
+	})
+}
+
+func TestBeaconCommittee(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:             2,
+			MinSeedLookahead:          1,
+			EpochsPerHistoricalVector: 8,
+			DomainTypeAttester:        common.DomainType{0x01},
+		},
+	)
+	shuffler := NewSwapOrNotShuffler(ShuffleRoundCount)
+
+	// The state at slot 9 of epoch 4, whose validators are identified by the
+	// first byte of their pubkey.
+	st := &testAttestationState{
+		testCommitteeState: &testCommitteeState{slot: 9},
+		committeesPerSlot:  2,
+	}
+	for i := range cs.EpochsPerHistoricalVector() {
+		st.mixes = append(st.mixes, common.Bytes32{byte(i + 1)})
+	}
+	for i := range 16 {
+		st.validators = append(st.validators, testCommitteeValidator{
+			pubkey:  crypto.BLSPubkey{byte(i)},
+			balance: 32e9,
+			exit:    math.Epoch(^uint64(0)),
+		})
+	}
+
+	// The committees of the previous epoch up to the seed lookahead are
+	// derived, matching the members attestations are verified against.
+	for _, slot := range []math.Slot{6, 7, 8, 9, 10, 11} {
+		indices, err := BeaconCommittee(st, cs, shuffler, slot, 1)
+		require.NoError(t, err)
+		pubkeys, err := beaconCommittee(st, cs, shuffler, slot, 1)
+		require.NoError(t, err)
+		require.Len(t, indices, len(pubkeys))
+		for i, index := range indices {
+			require.Equal(t, crypto.BLSPubkey{byte(index)}, pubkeys[i])
+		}
+	}
+
+	// The committees of other epochs are out of the window of the state.
+	for _, slot := range []math.Slot{5, 12} {
+		_, err := BeaconCommittee(st, cs, shuffler, slot, 0)
+		require.ErrorIs(t, err, ErrCommitteeEpochOutOfRange)
+	}
+	_, err := BeaconCommittee(st, cs, shuffler, 8, 2)
+	require.ErrorIs(t, err, ErrInvalidCommitteeIndex)
+}
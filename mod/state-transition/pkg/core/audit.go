@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// AuditOp is the type of a state-changing operation recorded in the audit
+// log.
+type AuditOp uint8
+
+const (
+	// AuditOpDeposit records a deposit credited to a validator.
+	AuditOpDeposit AuditOp = iota
+	// AuditOpWithdrawal records a withdrawal debited from a validator.
+	AuditOpWithdrawal
+	// AuditOpSlashing records a slashing penalty debited from a validator.
+	AuditOpSlashing
+	// AuditOpValidatorStatus records a validator being added to the registry
+	// or initiating its exit.
+	AuditOpValidatorStatus
+)
+
+// String returns the name of the operation.
+func (op AuditOp) String() string {
+	switch op {
+	case AuditOpDeposit:
+		return "deposit"
+	case AuditOpWithdrawal:
+		return "withdrawal"
+	case AuditOpSlashing:
+		return "slashing"
+	case AuditOpValidatorStatus:
+		return "validator-status"
+	default:
+		return "unknown"
+	}
+}
+
+// AuditRecord is a single state-changing operation applied to a validator.
+type AuditRecord struct {
+	// Op is the type of the operation.
+	Op AuditOp
+	// ValidatorIndex is the index of the validator the operation applies to.
+	ValidatorIndex math.ValidatorIndex
+	// Amount is the balance change of the operation, zero for status changes.
+	Amount math.Gwei
+	// Slot is the slot of the block that applied the operation.
+	Slot math.Slot
+}
+
+// AuditSink receives a record of every state-changing operation of a
+// finalized block. Records are delivered synchronously, in the order the
+// operations were applied, once the block has been fully processed.
+// Implementations must not fail the block and are responsible for handling
+// their own write errors.
+type AuditSink interface {
+	// Record appends the given record to the audit log.
+	Record(record AuditRecord)
+}
+
+// auditLog buffers the records of a single block until it has been fully
+// processed, so that a block failing part way through emits nothing. A nil
+// auditLog discards all records.
+type auditLog struct {
+	slot    math.Slot
+	records []AuditRecord
+}
+
+// newAuditLog returns an audit log for the block at the given slot, or nil if
+// there is no sink to deliver the records to.
+func newAuditLog(sink AuditSink, slot math.Slot) *auditLog {
+	if sink == nil {
+		return nil
+	}
+	return &auditLog{slot: slot}
+}
+
+// record buffers an operation applied to the validator at the given index.
+func (l *auditLog) record(
+	op AuditOp,
+	idx math.ValidatorIndex,
+	amount math.Gwei,
+) {
+	if l == nil {
+		return
+	}
+	l.records = append(l.records, AuditRecord{
+		Op:             op,
+		ValidatorIndex: idx,
+		Amount:         amount,
+		Slot:           l.slot,
+	})
+}
+
+// flush delivers the buffered records to the sink in order.
+func (l *auditLog) flush(sink AuditSink) {
+	if l == nil {
+		return
+	}
+	for _, record := range l.records {
+		sink.Record(record)
+	}
+	l.records = nil
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testAuditSink collects the records it receives.
+type testAuditSink struct {
+	records []AuditRecord
+}
+
+func (s *testAuditSink) Record(record AuditRecord) {
+	s.records = append(s.records, record)
+}
+
+func TestAuditLog(t *testing.T) {
+	const slot = math.Slot(7)
+
+	t.Run("records are emitted in order once flushed", func(t *testing.T) {
+		sink := &testAuditSink{}
+		audit := newAuditLog(sink, slot)
+
+		// A block applying a deposit and a withdrawal.
+		audit.record(AuditOpDeposit, 1, 32e9)
+		audit.record(AuditOpWithdrawal, 2, 1e9)
+		require.Empty(t, sink.records)
+
+		audit.flush(sink)
+		require.Equal(t, []AuditRecord{
+			{
+				Op:             AuditOpDeposit,
+				ValidatorIndex: 1,
+				Amount:         32e9,
+				Slot:           slot,
+			},
+			{
+				Op:             AuditOpWithdrawal,
+				ValidatorIndex: 2,
+				Amount:         1e9,
+				Slot:           slot,
+			},
+		}, sink.records)
+
+		// Flushing again does not emit the records twice.
+		audit.flush(sink)
+		require.Len(t, sink.records, 2)
+	})
+
+	t.Run("unflushed records are never emitted", func(t *testing.T) {
+		sink := &testAuditSink{}
+		audit := newAuditLog(sink, slot)
+		audit.record(AuditOpDeposit, 1, 32e9)
+		require.Empty(t, sink.records)
+	})
+
+	t.Run("no sink discards records", func(t *testing.T) {
+		audit := newAuditLog(nil, slot)
+		require.Nil(t, audit)
+		require.NotPanics(t, func() {
+			audit.record(AuditOpDeposit, 1, 32e9)
+			audit.flush(nil)
+		})
+	})
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+const (
+	// defaultGenesisVerificationWorkers is the default number of workers
+	// verifying the signatures of the genesis deposits.
+	defaultGenesisVerificationWorkers = 0
+
+	// defaultRejectDuplicateGenesisPubkeys is the default for rejecting a
+	// genesis with several deposits for the same public key.
+	defaultRejectDuplicateGenesisPubkeys = false
+
+	// defaultValidateGenesisForkVersion is the default for validating the
+	// genesis fork version against the chain spec.
+	defaultValidateGenesisForkVersion = true
+)
+
+// Config is the configuration for the state processor.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// GenesisVerificationWorkers is the number of workers verifying the
+	// signatures of the genesis deposits concurrently at InitChain. A value
+	// of 0 or 1 verifies them serially.
+	GenesisVerificationWorkers int `mapstructure:"genesis-verification-workers"`
+	// RejectDuplicateGenesisPubkeys rejects a genesis with several deposits
+	// for the same public key. By default, the deposits following the first
+	// one are applied as top-ups of its validator.
+	RejectDuplicateGenesisPubkeys bool `mapstructure:"reject-duplicate-genesis-pubkeys"`
+	// ValidateGenesisForkVersion rejects a genesis whose fork version is not
+	// the one the chain spec activates at the genesis epoch.
+	ValidateGenesisForkVersion bool `mapstructure:"validate-genesis-fork-version"`
+}
+
+// DefaultConfig returns the default configuration for the state processor.
+func DefaultConfig() Config {
+	return Config{
+		GenesisVerificationWorkers:    defaultGenesisVerificationWorkers,
+		RejectDuplicateGenesisPubkeys: defaultRejectDuplicateGenesisPubkeys,
+		ValidateGenesisForkVersion:    defaultValidateGenesisForkVersion,
+	}
+}
@@ -46,6 +46,18 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDuplicateDepositIndex is returned when a deposit carries an index
+	// that has already been processed.
+	ErrDuplicateDepositIndex = errors.New("duplicate deposit index")
+
+	// ErrDepositIndexGap is returned when a deposit carries an index that
+	// skips over one or more unprocessed deposits.
+	ErrDepositIndexGap = errors.New("deposit index gap")
+
+	// ErrDuplicateGenesisPubkey is returned when genesis deposits for the
+	// same public key are rejected rather than merged.
+	ErrDuplicateGenesisPubkey = errors.New("duplicate genesis pubkey")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -58,6 +70,10 @@ var (
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
 
+	// ErrBlobGasUsedMismatch is returned when the blob gas used by the
+	// execution payload does not match the number of blobs in the block.
+	ErrBlobGasUsedMismatch = errors.New("blob gas used mismatch")
+
 	// ErrSlashedProposer is returned when a block is processed in which
 	// the proposer is slashed.
 	ErrSlashedProposer = errors.New(
@@ -67,6 +83,69 @@ var (
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
 
+	// ErrShuffleIndexOutOfRange is returned when the index to shuffle is not
+	// within the list being shuffled.
+	ErrShuffleIndexOutOfRange = errors.New("shuffle index out of range")
+
+	// ErrShufflerNetworkMismatch is returned when a custom shuffler is set
+	// for a network other than the one of the chain spec.
+	ErrShufflerNetworkMismatch = errors.New("shuffler network mismatch")
+
+	// ErrSyncCommitteePeriodOutOfRange is returned when the sync committee
+	// of a period cannot be derived from the state, either because its seed
+	// is not fixed yet or because it is no longer retained.
+	ErrSyncCommitteePeriodOutOfRange = errors.New(
+		"sync committee period out of range")
+
+	// ErrGenesisForkVersionMismatch is returned when the fork version of the
+	// genesis is not the one the chain spec activates at the genesis epoch.
+	ErrGenesisForkVersionMismatch = errors.New(
+		"genesis fork version mismatch")
+
+	// ErrNoActiveValidators is returned when a committee is computed without
+	// any active validator carrying an effective balance.
+	ErrNoActiveValidators = errors.New("no active validators")
+
+	// ErrAttestationSlotOutOfRange is returned when an attestation is
+	// included before MinAttestationInclusionDelay slots have passed, or
+	// later than the epoch following its own.
+	ErrAttestationSlotOutOfRange = errors.New(
+		"attestation slot out of range")
+
+	// ErrCommitteeEpochOutOfRange is returned when the beacon committees of
+	// an epoch cannot be derived from the state, as the epoch is either too
+	// far in the past or in the future.
+	ErrCommitteeEpochOutOfRange = errors.New(
+		"committee epoch out of range")
+
+	// ErrInvalidCommitteeIndex is returned when an attestation is for a
+	// committee which does not exist in its slot.
+	ErrInvalidCommitteeIndex = errors.New("invalid committee index")
+
+	// ErrInvalidAggregationBits is returned when the participation bits of an
+	// attestation do not match the size of its committee, or set padding bits.
+	ErrInvalidAggregationBits = errors.New("invalid aggregation bits")
+
+	// ErrNoAttestationParticipants is returned when an attestation has no
+	// participating committee members.
+	ErrNoAttestationParticipants = errors.New(
+		"attestation without participants")
+
+	// ErrInvalidAttestationSignature is returned when the aggregate signature
+	// of an attestation does not verify against its participants.
+	ErrInvalidAttestationSignature = errors.New(
+		"invalid attestation signature")
+
+	// ErrEpochParticipationUnavailable is returned when the participation of
+	// an epoch other than the current or previous epoch of the state is
+	// requested.
+	ErrEpochParticipationUnavailable = errors.New(
+		"epoch participation unavailable")
+
+	// ErrEpochRewardsUnavailable is returned when the rewards of an epoch
+	// other than the current epoch of the state are requested.
+	ErrEpochRewardsUnavailable = errors.New("epoch rewards unavailable")
+
 	// ErrExceedMaximumWithdrawals is returned when the number of withdrawals
 	// in a block exceeds the maximum allowed.
 	ErrExceedMaximumWithdrawals = errors.New("exceeds maximum withdrawals")
@@ -74,4 +153,13 @@ var (
 	// ErrNumWithdrawalsMismatch is returned when the number of withdrawals
 	// in a block does not match the expected value.
 	ErrNumWithdrawalsMismatch = errors.New("number of withdrawals mismatch")
+
+	// ErrTooManyValidatorUpdates is returned when the validator updates of an
+	// epoch exceed the registry or take more validators out of the validator
+	// set than the churn limit allows.
+	ErrTooManyValidatorUpdates = errors.New("too many validator updates")
+
+	// ErrHistoricalSummariesFull is returned when a historical summary is
+	// appended to a state holding HistoricalRootsLimit summaries already.
+	ErrHistoricalSummariesFull = errors.New("historical summaries full")
 )
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEth1DataVotesResetAtVotingPeriod(t *testing.T) {
+	// Voting periods last 3 epochs of 2 slots each.
+	data := testSpecData()
+	data.SlotsPerEpoch = 2
+	data.EpochsPerEth1VotingPeriod = 3
+	var (
+		cs = chain.NewChainSpec(data)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st = newTestGenesisState(
+			t, cs, sp, newTestValidator(
+				crypto.BLSPubkey{0x01}, common.ExecutionAddress{0x01},
+			),
+		)
+	)
+	require.NoError(t, cs.Validate())
+	require.NoError(t, st.AppendEth1DataVote(&types.Eth1Data{
+		DepositCount: 1,
+	}))
+
+	// Votes are kept until the last epoch of the voting period ends.
+	for _, slot := range []math.Slot{2, 4, 5} {
+		_, err := sp.ProcessSlots(st, slot)
+		require.NoError(t, err)
+		votes, err := st.GetEth1DataVotes()
+		require.NoError(t, err)
+		require.Len(t, votes, 1, "slot %d", slot)
+	}
+
+	_, err := sp.ProcessSlots(st, 6)
+	require.NoError(t, err)
+	votes, err := st.GetEth1DataVotes()
+	require.NoError(t, err)
+	require.Empty(t, votes)
+}
@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+// testGenesisDeposits returns n deposits signed by distinct validators for
+// the genesis of the given chain spec, followed by an unsigned top-up of the
+// first validator.
+func testGenesisDeposits(
+	t testing.TB,
+	cs common.ChainSpec,
+	n int,
+) []*types.Deposit {
+	t.Helper()
+	var (
+		forkData = types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(0),
+			), common.Root{},
+		)
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+		deposits = make([]*types.Deposit, 0, n+1)
+	)
+	for i := range n {
+		key, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		msg, sig, err := types.CreateAndSignDepositMessage(
+			forkData, cs.DomainTypeDeposit(),
+			&signer.LegacySigner{PrivKey: &key}, credentials, 32e9,
+		)
+		require.NoError(t, err)
+		deposits = append(deposits, types.NewDeposit(
+			msg.Pubkey, msg.Credentials, msg.Amount, sig, uint64(i),
+		))
+	}
+
+	// Signatures of top-ups are not verified.
+	return append(deposits, types.NewDeposit(
+		deposits[0].Pubkey, credentials, 1e9, [96]byte{}, uint64(n),
+	))
+}
+
+// genesisState runs the genesis of the chain with the given deposits.
+func genesisState(
+	t testing.TB,
+	cs common.ChainSpec,
+	deposits []*types.Deposit,
+	workers int,
+	rejectDuplicates bool,
+) (*testStateDB, error) {
+	t.Helper()
+	st := newTestStateDB(t, cs)
+	_, err := newTestStateProcessor(
+		cs, &signer.LegacySigner{}, testStateProcessorOptions{
+			cfg: core.Config{
+				GenesisVerificationWorkers:    workers,
+				RejectDuplicateGenesisPubkeys: rejectDuplicates,
+			},
+		},
+	).InitializePreminedBeaconStateFromEth1(
+		st,
+		deposits,
+		(&types.ExecutionPayloadHeader{}).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	return st, err
+}
+
+func TestGenesisDepositVerification(t *testing.T) {
+	var (
+		cs       = testChainSpec()
+		deposits = testGenesisDeposits(t, cs, 8)
+	)
+
+	serial, err := genesisState(t, cs, deposits, 0, false)
+	require.NoError(t, err)
+	validators, err := serial.GetValidators()
+	require.NoError(t, err)
+	require.Len(t, validators, 8)
+
+	t.Run("concurrent", func(t *testing.T) {
+		for _, workers := range []int{2, 4, 16} {
+			st, err := genesisState(t, cs, deposits, workers, false)
+			require.NoError(t, err)
+			got, err := st.GetValidators()
+			require.NoError(t, err)
+			require.Equal(t, validators, got)
+			require.Equal(t, serial.HashTreeRoot(), st.HashTreeRoot())
+		}
+	})
+
+	t.Run("bad deposit", func(t *testing.T) {
+		bad := make([]*types.Deposit, len(deposits))
+		for i, dep := range deposits {
+			cp := *dep
+			bad[i] = &cp
+		}
+		bad[5].Signature = bad[4].Signature
+		bad[6].Signature = bad[4].Signature
+
+		for _, workers := range []int{2, 4, 16} {
+			_, err := genesisState(t, cs, bad, workers, false)
+			require.ErrorIs(t, err, signer.ErrInvalidSignature)
+			require.ErrorContains(t, err, "genesis deposit 5")
+		}
+	})
+}
+
+func TestGenesisDuplicatePubkeys(t *testing.T) {
+	var (
+		cs = testChainSpec()
+		// The last deposit tops up the validator of the first one.
+		deposits = testGenesisDeposits(t, cs, 4)
+	)
+
+	t.Run("merge", func(t *testing.T) {
+		for _, workers := range []int{0, 4} {
+			st, err := genesisState(t, cs, deposits, workers, false)
+			require.NoError(t, err)
+			validators, err := st.GetValidators()
+			require.NoError(t, err)
+			require.Len(t, validators, 4)
+
+			// The top-up is applied to the validator of the first deposit.
+			idx, err := st.ValidatorIndexByPubkey(deposits[0].Pubkey)
+			require.NoError(t, err)
+			require.Equal(t, math.ValidatorIndex(0), idx)
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		for _, workers := range []int{0, 4} {
+			_, err := genesisState(t, cs, deposits, workers, true)
+			require.ErrorIs(t, err, core.ErrDuplicateGenesisPubkey)
+			require.ErrorContains(t, err, "genesis deposits 0 and 4")
+		}
+
+		// Genesis without duplicates is accepted.
+		st, err := genesisState(t, cs, deposits[:4], 0, true)
+		require.NoError(t, err)
+		validators, err := st.GetValidators()
+		require.NoError(t, err)
+		require.Len(t, validators, 4)
+	})
+}
+
+func TestGenesisMaxEffectiveBalance(t *testing.T) {
+	data := testSpecData()
+	data.MaxEffectiveBalanceElectra = 64e9
+	var (
+		cs     = chain.NewChainSpec(data)
+		legacy = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+		compounding = legacy
+	)
+	compounding[0] = types.CompoundingCredentialPrefix
+
+	// Both validators deposit 40, then top up by another 30.
+	genesisDeposits := func(cs common.ChainSpec) []*types.Deposit {
+		var (
+			deposits = make([]*types.Deposit, 4)
+			forkData = types.NewForkData(
+				version.FromUint32[common.Version](
+					cs.ActiveForkVersionForEpoch(0),
+				), common.Root{},
+			)
+		)
+		for i, credentials := range []types.WithdrawalCredentials{
+			legacy, compounding,
+		} {
+			key, err := bls12381.GenPrivKey()
+			require.NoError(t, err)
+			msg, sig, err := types.CreateAndSignDepositMessage(
+				forkData, cs.DomainTypeDeposit(),
+				&signer.LegacySigner{PrivKey: &key}, credentials, 40e9,
+			)
+			require.NoError(t, err)
+			deposits[i] = types.NewDeposit(
+				msg.Pubkey, msg.Credentials, msg.Amount, sig, uint64(i),
+			)
+			deposits[i+2] = types.NewDeposit(
+				msg.Pubkey, msg.Credentials, 30e9, [96]byte{}, uint64(i+2),
+			)
+		}
+		return deposits
+	}
+	deposits := genesisDeposits(cs)
+
+	t.Run("creation", func(t *testing.T) {
+		st, err := genesisState(t, cs, deposits[:2], 0, false)
+		require.NoError(t, err)
+		validators, err := st.GetValidators()
+		require.NoError(t, err)
+		require.Len(t, validators, 2)
+
+		// The legacy validator is capped at 32, the compounding one is not.
+		require.Equal(t, math.Gwei(32e9), validators[0].GetEffectiveBalance())
+		require.Equal(t, math.Gwei(40e9), validators[1].GetEffectiveBalance())
+	})
+
+	t.Run("top-up", func(t *testing.T) {
+		st, err := genesisState(t, cs, deposits, 0, false)
+		require.NoError(t, err)
+		validators, err := st.GetValidators()
+		require.NoError(t, err)
+		require.Len(t, validators, 2)
+
+		require.Equal(t, math.Gwei(32e9), validators[0].GetEffectiveBalance())
+		require.Equal(t, math.Gwei(64e9), validators[1].GetEffectiveBalance())
+	})
+
+	t.Run("before electra", func(t *testing.T) {
+		data := data
+		data.DenebPlusForkEpoch = 1
+		data.ElectraForkEpoch = 1
+		cs := chain.NewChainSpec(data)
+		st, err := genesisState(t, cs, genesisDeposits(cs), 0, false)
+		require.NoError(t, err)
+		validators, err := st.GetValidators()
+		require.NoError(t, err)
+		require.Len(t, validators, 2)
+
+		// Both validators are capped at 32 until the Electra fork.
+		require.Equal(t, math.Gwei(32e9), validators[0].GetEffectiveBalance())
+		require.Equal(t, math.Gwei(32e9), validators[1].GetEffectiveBalance())
+	})
+}
+
+func TestGenesisOversizedDeposits(t *testing.T) {
+	data := testSpecData()
+	data.MaxEffectiveBalanceElectra = 64e9
+	data.CreditDepositTopUps = true
+	var (
+		cs       = chain.NewChainSpec(data)
+		forkData = types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(0),
+			), common.Root{},
+		)
+		legacy = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+		compounding = legacy
+		deposits    = make([]*types.Deposit, 4)
+	)
+	compounding[0] = types.CompoundingCredentialPrefix
+
+	// Both validators deposit 40, then top up by another 30.5.
+	for i, credentials := range []types.WithdrawalCredentials{
+		legacy, compounding,
+	} {
+		key, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		msg, sig, err := types.CreateAndSignDepositMessage(
+			forkData, cs.DomainTypeDeposit(),
+			&signer.LegacySigner{PrivKey: &key}, credentials, 40e9,
+		)
+		require.NoError(t, err)
+		deposits[i] = types.NewDeposit(
+			msg.Pubkey, msg.Credentials, msg.Amount, sig, uint64(i),
+		)
+		deposits[i+2] = types.NewDeposit(
+			msg.Pubkey, msg.Credentials, 30.5e9, [96]byte{}, uint64(i+2),
+		)
+	}
+
+	// assertBalances asserts the balances and effective balances of the
+	// validators.
+	assertBalances := func(
+		st *testStateDB, balances, effectiveBalances []math.Gwei,
+	) {
+		for i := range balances {
+			idx := math.ValidatorIndex(i)
+			balance, err := st.GetBalance(idx)
+			require.NoError(t, err)
+			require.Equal(t, balances[i], balance, "validator %d", i)
+			val, err := st.ValidatorByIndex(idx)
+			require.NoError(t, err)
+			require.Equal(
+				t, effectiveBalances[i], val.GetEffectiveBalance(),
+				"validator %d", i,
+			)
+		}
+	}
+
+	t.Run("creation", func(t *testing.T) {
+		st, err := genesisState(t, cs, deposits[:2], 0, false)
+		require.NoError(t, err)
+
+		// The full deposits are credited, the effective balance of the
+		// legacy validator is capped at 32.
+		assertBalances(
+			st, []math.Gwei{40e9, 40e9}, []math.Gwei{32e9, 40e9},
+		)
+	})
+
+	t.Run("top-up", func(t *testing.T) {
+		st, err := genesisState(t, cs, deposits, 0, false)
+		require.NoError(t, err)
+
+		// The full top-ups are credited, the effective balances are capped
+		// at the ceiling of each validator.
+		assertBalances(
+			st, []math.Gwei{70.5e9, 70.5e9}, []math.Gwei{32e9, 64e9},
+		)
+	})
+}
+
+func BenchmarkGenesisDepositVerification(b *testing.B) {
+	var (
+		cs       = testChainSpec()
+		deposits = testGenesisDeposits(b, cs, 64)
+	)
+	for _, workers := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for range b.N {
+				if _, err := genesisState(b, cs, deposits, workers, false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
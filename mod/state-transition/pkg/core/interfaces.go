@@ -83,6 +83,14 @@ type ReadOnlyBeaconState[
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	GetTotalValidators() (uint64, error)
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	GetJustifiedCheckpoint() (common.Checkpoint, error)
+	GetFinalizedCheckpoint() (common.Checkpoint, error)
+	GetHistoricalSummaries() ([]common.HistoricalSummary, error)
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
+	GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+	GetCurrentEpochParticipation(math.ValidatorIndex) (byte, error)
+	ValidatorChurnLimit() (uint64, error)
+	CommitteeCountPerSlot(math.Epoch) (uint64, error)
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
@@ -109,6 +117,13 @@ type WriteOnlyBeaconState[
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 	SetTotalSlashing(math.Gwei) error
+	SetJustifiedCheckpoint(common.Checkpoint) error
+	SetFinalizedCheckpoint(common.Checkpoint) error
+	AppendHistoricalSummary(common.HistoricalSummary) error
+	SetInactivityScore(math.ValidatorIndex, uint64) error
+	SetPreviousEpochParticipation(math.ValidatorIndex, byte) error
+	SetCurrentEpochParticipation(math.ValidatorIndex, byte) error
+	RotateEpochParticipation() error
 }
 
 // WriteOnlyStateRoots defines a struct which only has write access to state
@@ -161,6 +176,8 @@ type ReadOnlyValidators[ValidatorT any] interface {
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
 	SetEth1DepositIndex(uint64) error
+	AppendEth1DataVote(Eth1DataT) error
+	ResetEth1DataVotes() error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
 	) error
@@ -170,6 +187,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
+	GetEth1DataVotes() ([]Eth1DataT, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
@@ -178,4 +196,9 @@ type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 // ReadOnlyWithdrawals only has read access to withdrawal methods.
 type ReadOnlyWithdrawals[WithdrawalT any] interface {
 	ExpectedWithdrawals() ([]WithdrawalT, error)
+	UnsweptWithdrawableValidators() (uint64, error)
+	VerifyWithdrawalIndices(withdrawals []WithdrawalT) error
+	NextWithdrawalIndices(
+		withdrawals []WithdrawalT,
+	) (uint64, math.ValidatorIndex, error)
 }
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+)
+
+const (
+	// ShuffleRoundCount is the number of swap-or-not rounds used by the
+	// default shuffler, as defined in the Ethereum 2.0 specification.
+	ShuffleRoundCount uint8 = 90
+
+	// shuffleSeedSize is the size of the shuffling seed.
+	shuffleSeedSize = 32
+	// shufflePivotInputSize is the size of the pivot hash input, i.e. the
+	// seed followed by the round.
+	shufflePivotInputSize = shuffleSeedSize + 1
+	// shuffleSourceInputSize is the size of the source hash input, i.e. the
+	// pivot hash input followed by the position window.
+	shuffleSourceInputSize = shufflePivotInputSize + 4
+)
+
+// SwapOrNotShuffler is the swap-or-not shuffler as defined in the Ethereum 2.0
+// specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_shuffled_index
+//
+//nolint:lll // link.
+type SwapOrNotShuffler struct {
+	// rounds is the number of swap-or-not rounds.
+	rounds uint8
+}
+
+// NewSwapOrNotShuffler creates a new swap-or-not shuffler with the given
+// number of rounds.
+func NewSwapOrNotShuffler(rounds uint8) *SwapOrNotShuffler {
+	return &SwapOrNotShuffler{rounds: rounds}
+}
+
+// ShuffleIndex returns the shuffled position of the index in a list of count
+// elements, for the given seed.
+func (s *SwapOrNotShuffler) ShuffleIndex(
+	index, count uint64,
+	seed [32]byte,
+) (uint64, error) {
+	if index >= count {
+		return 0, errors.Wrapf(
+			ErrShuffleIndexOutOfRange, "index: %d, count: %d", index, count,
+		)
+	}
+
+	buf := make([]byte, shuffleSourceInputSize)
+	copy(buf, seed[:])
+	for round := range s.rounds {
+		buf[shuffleSeedSize] = round
+		pivotHash := sha256.Hash(buf[:shufflePivotInputSize])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		flip := (pivot + count - index) % count
+		position := max(index, flip)
+
+		//nolint:mnd,gosec // 256 positions per source hash, fits in uint32.
+		binary.LittleEndian.PutUint32(
+			buf[shufflePivotInputSize:], uint32(position/256),
+		)
+		source := sha256.Hash(buf)
+		//nolint:mnd // 8 bits per byte.
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index, nil
+}
+
+// SetShuffler overrides the default swap-or-not shuffler. Since all nodes of
+// a network must shuffle identically, the shuffler is bound to the network it
+// is intended for and rejected for any other network.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SetShuffler(shuffler Shuffler, eth1ChainID uint64) error {
+	if eth1ChainID != sp.cs.DepositEth1ChainID() {
+		return errors.Wrapf(
+			ErrShufflerNetworkMismatch,
+			"shuffler chain id: %d, network chain id: %d",
+			eth1ChainID, sp.cs.DepositEth1ChainID(),
+		)
+	}
+	sp.shuffler = shuffler
+	return nil
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapOrNotShuffler(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounds   uint8
+		seed     [32]byte
+		index    uint64
+		count    uint64
+		expected uint64
+	}{
+		{
+			name:     "single element",
+			rounds:   core.ShuffleRoundCount,
+			index:    0,
+			count:    1,
+			expected: 0,
+		},
+		{
+			name:     "zero rounds",
+			rounds:   0,
+			index:    5,
+			count:    10,
+			expected: 5,
+		},
+		{
+			name:     "zero seed",
+			rounds:   core.ShuffleRoundCount,
+			index:    9,
+			count:    10,
+			expected: 2,
+		},
+		{
+			name:     "zero seed minimal rounds",
+			rounds:   10,
+			index:    1000,
+			count:    4096,
+			expected: 3340,
+		},
+		{
+			name:     "seed",
+			rounds:   core.ShuffleRoundCount,
+			seed:     [32]byte{0x01, 0x80, 0x0c},
+			index:    33,
+			count:    100,
+			expected: 65,
+		},
+		{
+			name:     "seed large list",
+			rounds:   core.ShuffleRoundCount,
+			seed:     [32]byte{0xff, 0xee, 0xdd, 0xcc},
+			index:    1000,
+			count:    4096,
+			expected: 3446,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shuffler := core.NewSwapOrNotShuffler(tt.rounds)
+			shuffled, err := shuffler.ShuffleIndex(tt.index, tt.count, tt.seed)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, shuffled)
+		})
+	}
+}
+
+func TestSwapOrNotShufflerPermutation(t *testing.T) {
+	var (
+		count    = uint64(64)
+		seen     = make(map[uint64]struct{}, count)
+		shuffler = core.NewSwapOrNotShuffler(core.ShuffleRoundCount)
+	)
+	for i := range count {
+		shuffled, err := shuffler.ShuffleIndex(i, count, [32]byte{0x42})
+		require.NoError(t, err)
+		require.Less(t, shuffled, count)
+		seen[shuffled] = struct{}{}
+	}
+	require.Len(t, seen, int(count))
+}
+
+func TestSwapOrNotShufflerIndexOutOfRange(t *testing.T) {
+	shuffler := core.NewSwapOrNotShuffler(core.ShuffleRoundCount)
+	_, err := shuffler.ShuffleIndex(10, 10, [32]byte{})
+	require.ErrorIs(t, err, core.ErrShuffleIndexOutOfRange)
+	_, err = shuffler.ShuffleIndex(0, 0, [32]byte{})
+	require.ErrorIs(t, err, core.ErrShuffleIndexOutOfRange)
+}
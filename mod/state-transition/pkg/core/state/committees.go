@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// CommitteeCountPerSlot returns the number of attestation committees per slot
+// in the given epoch, as defined in the Ethereum 2.0 Specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_committee_count_per_slot
+//
+//nolint:lll
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) CommitteeCountPerSlot(epoch math.Epoch) (uint64, error) {
+	validators, err := s.GetValidators()
+	if err != nil {
+		return 0, err
+	}
+
+	var active uint64
+	for _, val := range validators {
+		if val.IsInValidatorSet(epoch) {
+			active++
+		}
+	}
+
+	validatorsPerSlot := s.cs.SlotsPerEpoch() * s.cs.TargetCommitteeSize()
+	if validatorsPerSlot == 0 {
+		return 1, nil
+	}
+	return max(
+		1, min(s.cs.MaxCommitteesPerSlot(), active/validatorsPerSlot),
+	), nil
+}
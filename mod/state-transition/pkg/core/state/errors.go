@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrEpochOutOfRange is returned when the requested epoch is outside of
+	// the window retained in the state.
+	ErrEpochOutOfRange = errors.New("epoch out of range")
+
+	// ErrSlotOutOfRange is returned when the requested slot is outside of the
+	// window retained in the state.
+	ErrSlotOutOfRange = errors.New("slot out of range")
+
+	// ErrValidatorNotPending is returned when the validator is not queued for
+	// activation.
+	ErrValidatorNotPending = errors.New("validator not pending activation")
+
+	// ErrWithdrawalIndexMismatch is returned when the withdrawals of a
+	// payload do not carry consecutive indices from the next withdrawal
+	// index of the state.
+	ErrWithdrawalIndexMismatch = errors.New("withdrawal index mismatch")
+)
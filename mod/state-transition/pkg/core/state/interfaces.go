@@ -44,6 +44,9 @@ type KVStore[
 	WithContext(ctx context.Context) T
 	// Copy returns a copy of the key-value store.
 	Copy() T
+	// Save writes the changes made to a copy of the key-value store into
+	// the store it was copied from.
+	Save()
 	// GetLatestExecutionPayloadHeader retrieves the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
@@ -87,6 +90,13 @@ type KVStore[
 	GetEth1Data() (Eth1DataT, error)
 	// SetEth1Data sets the eth1 data.
 	SetEth1Data(data Eth1DataT) error
+	// GetEth1DataVotes retrieves the eth1 data votes of the current voting
+	// period.
+	GetEth1DataVotes() ([]Eth1DataT, error)
+	// AppendEth1DataVote appends an eth1 data vote.
+	AppendEth1DataVote(vote Eth1DataT) error
+	// ResetEth1DataVotes removes all eth1 data votes.
+	ResetEth1DataVotes() error
 	// GetValidators retrieves all validators.
 	GetValidators() (ValidatorsT, error)
 	// GetBalances retrieves all balances.
@@ -104,6 +114,37 @@ type KVStore[
 	GetTotalSlashing() (math.Gwei, error)
 	// SetTotalSlashing sets the total slashing.
 	SetTotalSlashing(total math.Gwei) error
+	// GetJustifiedCheckpoint retrieves the current justified checkpoint.
+	GetJustifiedCheckpoint() (common.Checkpoint, error)
+	// SetJustifiedCheckpoint sets the current justified checkpoint.
+	SetJustifiedCheckpoint(checkpoint common.Checkpoint) error
+	// GetFinalizedCheckpoint retrieves the finalized checkpoint.
+	GetFinalizedCheckpoint() (common.Checkpoint, error)
+	// SetFinalizedCheckpoint sets the finalized checkpoint.
+	SetFinalizedCheckpoint(checkpoint common.Checkpoint) error
+	// GetHistoricalSummaries retrieves the historical summaries.
+	GetHistoricalSummaries() ([]common.HistoricalSummary, error)
+	// AppendHistoricalSummary appends a historical summary.
+	AppendHistoricalSummary(summary common.HistoricalSummary) error
+	// GetInactivityScore retrieves the inactivity score of a validator.
+	GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+	// SetInactivityScore sets the inactivity score of a validator.
+	SetInactivityScore(idx math.ValidatorIndex, score uint64) error
+	// GetPreviousEpochParticipation retrieves the participation flags of a
+	// validator in the previous epoch.
+	GetPreviousEpochParticipation(idx math.ValidatorIndex) (byte, error)
+	// GetCurrentEpochParticipation retrieves the participation flags of a
+	// validator in the current epoch.
+	GetCurrentEpochParticipation(idx math.ValidatorIndex) (byte, error)
+	// SetPreviousEpochParticipation sets the participation flags of a
+	// validator in the previous epoch.
+	SetPreviousEpochParticipation(idx math.ValidatorIndex, flags byte) error
+	// SetCurrentEpochParticipation sets the participation flags of a
+	// validator in the current epoch.
+	SetCurrentEpochParticipation(idx math.ValidatorIndex, flags byte) error
+	// RotateEpochParticipation moves the participation flags of the current
+	// epoch to the previous epoch.
+	RotateEpochParticipation() error
 	// GetRandaoMixAtIndex retrieves the randao mix at the given index.
 	GetRandaoMixAtIndex(index uint64) (common.Bytes32, error)
 	// GetSlashings retrieves all slashings.
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// queuedValidator is a validator's position in a queue.
+type queuedValidator struct {
+	index math.ValidatorIndex
+	epoch math.Epoch
+}
+
+// ActivationQueue returns the indices of the validators that are eligible for
+// activation but not yet activated, ordered by activation eligibility epoch
+// and then by index.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) ActivationQueue() ([]math.ValidatorIndex, error) {
+	return s.queue(func(val ValidatorT) (math.Epoch, bool) {
+		return val.GetActivationEligibilityEpoch(),
+			val.GetActivationEligibilityEpoch() !=
+				math.Epoch(constants.FarFutureEpoch) &&
+				val.GetActivationEpoch() ==
+					math.Epoch(constants.FarFutureEpoch)
+	})
+}
+
+// ExitQueue returns the indices of the validators that have initiated an exit
+// which has not yet taken effect, ordered by exit epoch and then by index.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) ExitQueue() ([]math.ValidatorIndex, error) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := s.cs.SlotToEpoch(slot)
+
+	return s.queue(func(val ValidatorT) (math.Epoch, bool) {
+		return val.GetExitEpoch(),
+			val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) &&
+				val.GetExitEpoch() > epoch
+	})
+}
+
+// EstimateActivationEpoch estimates the epoch in which the validator at the
+// given index is activated. The validators ahead of it in the activation
+// queue leave it at the current churn limit per epoch, starting with the
+// current epoch, and are activated MinActivationDelay epochs after the one
+// following the epoch they leave it in. The estimate assumes the churn limit
+// holds and no validator joins the queue ahead of it.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) EstimateActivationEpoch(index math.ValidatorIndex) (math.Epoch, error) {
+	queue, err := s.ActivationQueue()
+	if err != nil {
+		return 0, err
+	}
+
+	position := slices.Index(queue, index)
+	if position < 0 {
+		return 0, errors.Wrapf(ErrValidatorNotPending, "index %d", index)
+	}
+
+	churn, err := s.ValidatorChurnLimit()
+	if err != nil {
+		return 0, err
+	}
+
+	slot, err := s.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+
+	return s.cs.SlotToEpoch(slot) + math.Epoch(uint64(position)/churn) +
+		1 + math.Epoch(s.cs.MinActivationDelay()), nil
+}
+
+// ValidatorChurnLimit returns the maximum number of validators that may be
+// activated or exited in the current epoch, as defined in the Ethereum 2.0
+// Specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+// The churn limit is never zero, even if MinPerEpochChurnLimit is left unset.
+//
+//nolint:lll
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) ValidatorChurnLimit() (uint64, error) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	epoch := s.cs.SlotToEpoch(slot)
+
+	validators, err := s.GetValidators()
+	if err != nil {
+		return 0, err
+	}
+
+	var active uint64
+	for _, val := range validators {
+		if val.IsInValidatorSet(epoch) {
+			active++
+		}
+	}
+
+	churn := max(s.cs.MinPerEpochChurnLimit(), 1)
+	if s.cs.ChurnLimitQuotient() == 0 {
+		return churn, nil
+	}
+	return max(churn, active/s.cs.ChurnLimitQuotient()), nil
+}
+
+// queue returns the indices of the validators selected by include, ordered
+// by the epoch it returns and then by index.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) queue(
+	include func(ValidatorT) (math.Epoch, bool),
+) ([]math.ValidatorIndex, error) {
+	validators, err := s.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	queued := make([]queuedValidator, 0)
+	for i, val := range validators {
+		if epoch, ok := include(val); ok {
+			queued = append(queued, queuedValidator{
+				index: math.ValidatorIndex(i),
+				epoch: epoch,
+			})
+		}
+	}
+
+	slices.SortStableFunc(queued, func(a, b queuedValidator) int {
+		return cmp.Or(
+			cmp.Compare(a.epoch, b.epoch), cmp.Compare(a.index, b.index),
+		)
+	})
+
+	indices := make([]math.ValidatorIndex, len(queued))
+	for i, q := range queued {
+		indices[i] = q.index
+	}
+	return indices, nil
+}
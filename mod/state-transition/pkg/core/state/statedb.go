@@ -239,46 +239,247 @@ func (s *StateDB[
 			return nil, err
 		}
 
-		withdrawalAddress, err = validator.
-			GetWithdrawalCredentials().ToExecutionAddress()
-		if err != nil {
-			return nil, err
+		// Set the amount of the withdrawal depending on the balance of the
+		// validator. Validators which are not eligible for a withdrawal are
+		// skipped if so configured.
+		amount = s.withdrawableAmount(validator, balance, epoch)
+		if amount != 0 || !s.cs.SkipIneligibleWithdrawals() {
+			withdrawalAddress, err = validator.
+				GetWithdrawalCredentials().ToExecutionAddress()
+			if err != nil {
+				return nil, err
+			}
+
+			withdrawal = withdrawal.New(
+				math.U64(withdrawalIndex),
+				validatorIndex,
+				withdrawalAddress,
+				amount,
+			)
+
+			withdrawals = append(withdrawals, withdrawal)
+
+			// Increment the withdrawal index to process the next withdrawal.
+			withdrawalIndex++
+
+			// Cap the number of withdrawals to the maximum allowed per
+			// payload.
+			//#nosec:G701 // won't overflow in practice.
+			if len(withdrawals) == int(s.cs.MaxWithdrawalsPerPayload()) {
+				break
+			}
 		}
 
-		// Set the amount of the withdrawal depending on the balance of the
-		// validator.
-		if validator.IsFullyWithdrawable(balance, epoch) {
-			amount = balance
-		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance()),
-		) {
-			amount = balance - math.Gwei(s.cs.MaxEffectiveBalance())
+		// Increment the validator index to process the next validator.
+		validatorIndex = (validatorIndex + 1) % math.ValidatorIndex(
+			totalValidators,
+		)
+	}
+
+	return withdrawals, nil
+}
+
+// VerifyWithdrawalIndices verifies the given withdrawals carry consecutive
+// indices, starting from the next withdrawal index of the state.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, WithdrawalT, _,
+]) VerifyWithdrawalIndices(withdrawals []WithdrawalT) error {
+	withdrawalIndex, err := s.GetNextWithdrawalIndex()
+	if err != nil {
+		return err
+	}
+	for i, wd := range withdrawals {
+		if wd.GetIndex().Unwrap() != withdrawalIndex+uint64(i) {
+			return errors.Wrapf(
+				ErrWithdrawalIndexMismatch,
+				"withdrawal %d: expected index %d, got %d",
+				i, withdrawalIndex+uint64(i), wd.GetIndex(),
+			)
 		}
-		withdrawal = withdrawal.New(
-			math.U64(withdrawalIndex),
-			validatorIndex,
-			withdrawalAddress,
-			amount,
+	}
+	return nil
+}
+
+// NextWithdrawalIndices returns the next withdrawal index and the next
+// withdrawal validator index of the state once the given withdrawals, those
+// expected for the state, are processed.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, WithdrawalT, _,
+]) NextWithdrawalIndices(
+	withdrawals []WithdrawalT,
+) (uint64, math.ValidatorIndex, error) {
+	withdrawalIndex, err := s.GetNextWithdrawalIndex()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	validatorIndex, err := s.GetNextWithdrawalValidatorIndex()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	totalValidators, err := s.GetTotalValidators()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	numWithdrawals := len(withdrawals)
+	if numWithdrawals != 0 {
+		// The next withdrawal follows the latest one.
+		withdrawalIndex = withdrawals[numWithdrawals-1].GetIndex().Unwrap() + 1
+	}
+
+	//#nosec:G701 // won't overflow in practice.
+	if numWithdrawals == int(s.cs.MaxWithdrawalsPerPayload()) {
+		// A full set of withdrawals ends the sweep at the latest withdrawal,
+		// the next sweep starts from the validator following it.
+		latest := withdrawals[numWithdrawals-1]
+		validatorIndex = latest.GetValidatorIndex() + 1
+
+		// TODO: This is a bug that lives on bArtio, where the sweep starts
+		// after the withdrawal index instead. Delete this eventually.
+		const bArtioChainID = 80084
+		if s.cs.DepositEth1ChainID() == bArtioChainID {
+			validatorIndex = math.ValidatorIndex(latest.GetIndex() + 1)
+		}
+	} else {
+		// Otherwise the sweep went as far as its bound.
+		validatorIndex += math.ValidatorIndex(
+			s.cs.MaxValidatorsPerWithdrawalsSweep(),
 		)
+	}
+	if totalValidators != 0 {
+		validatorIndex %= math.ValidatorIndex(totalValidators)
+	}
+	return withdrawalIndex, validatorIndex, nil
+}
 
-		withdrawals = append(withdrawals, withdrawal)
+// UnsweptWithdrawableValidators returns the number of validators eligible
+// for a withdrawal which are not included in the expected withdrawals, i.e.
+// those the sweep does not reach in the next payload.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) UnsweptWithdrawableValidators() (uint64, error) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	epoch := s.cs.SlotToEpoch(slot)
 
-		// Increment the withdrawal index to process the next withdrawal.
-		withdrawalIndex++
+	withdrawals, err := s.ExpectedWithdrawals()
+	if err != nil {
+		return 0, err
+	}
 
-		// Cap the number of withdrawals to the maximum allowed per payload.
-		//#nosec:G701 // won't overflow in practice.
-		if len(withdrawals) == int(s.cs.MaxWithdrawalsPerPayload()) {
-			break
+	totalValidators, err := s.GetTotalValidators()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		validator ValidatorT
+		balance   math.Gwei
+		count     uint64
+	)
+	for i := range math.ValidatorIndex(totalValidators) {
+		validator, err = s.ValidatorByIndex(i)
+		if err != nil {
+			return 0, err
 		}
+		balance, err = s.GetBalance(i)
+		if err != nil {
+			return 0, err
+		}
+		if s.withdrawableAmount(validator, balance, epoch) != 0 {
+			count++
+		}
+	}
 
-		// Increment the validator index to process the next validator.
-		validatorIndex = (validatorIndex + 1) % math.ValidatorIndex(
-			totalValidators,
+	// Validators are swept at most once per payload.
+	for _, withdrawal := range withdrawals {
+		if withdrawal.GetAmount() != 0 {
+			count--
+		}
+	}
+	return count, nil
+}
+
+// withdrawableAmount returns the amount the validator with the given balance
+// may withdraw at the given epoch, which is zero if it is not eligible for a
+// withdrawal.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) withdrawableAmount(
+	validator ValidatorT,
+	balance math.Gwei,
+	epoch math.Epoch,
+) math.Gwei {
+	maxBalance := math.Gwei(s.cs.MaxEffectiveBalanceForEpoch(
+		epoch, validator.HasCompoundingWithdrawalCredential(),
+	))
+	switch {
+	case validator.IsFullyWithdrawable(balance, epoch):
+		return balance
+	case validator.IsPartiallyWithdrawable(balance, maxBalance):
+		return balance - maxBalance
+	default:
+		return 0
+	}
+}
+
+// RandaoMix returns the RANDAO mix for the given epoch. Only the mixes of the
+// current epoch and the EpochsPerHistoricalVector - 1 epochs preceding it are
+// retained in the state.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) RandaoMix(epoch math.Epoch) (common.Root, error) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	currentEpoch := s.cs.SlotToEpoch(slot)
+	if epoch > currentEpoch ||
+		epoch.Unwrap()+s.cs.EpochsPerHistoricalVector() <=
+			currentEpoch.Unwrap() {
+		return common.Root{}, errors.Wrapf(
+			ErrEpochOutOfRange,
+			"epoch: %d, current epoch: %d", epoch, currentEpoch,
 		)
 	}
 
-	return withdrawals, nil
+	mix, err := s.GetRandaoMixAtIndex(
+		epoch.Unwrap() % s.cs.EpochsPerHistoricalVector(),
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(mix), nil
+}
+
+// BlockRootAtSlot returns the root of the block at the given slot. Only the
+// roots of the SlotsPerHistoricalRoot slots preceding the current slot are
+// retained in the state; the root of the current slot is only recorded once
+// the state advances past it.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
+	currentSlot, err := s.GetSlot()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	if slot >= currentSlot ||
+		slot.Unwrap()+s.cs.SlotsPerHistoricalRoot() < currentSlot.Unwrap() {
+		return common.Root{}, errors.Wrapf(
+			ErrSlotOutOfRange,
+			"slot: %d, current slot: %d", slot, currentSlot,
+		)
+	}
+
+	return s.GetBlockRootAtIndex(
+		slot.Unwrap() % s.cs.SlotsPerHistoricalRoot(),
+	)
 }
 
 // GetMarshallable is the interface for the beacon store.
@@ -378,6 +579,11 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	historicalSummaries, err := s.GetHistoricalSummaries()
+	if err != nil {
+		return empty, err
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
 		s.cs.ActiveForkVersionForSlot(slot),
@@ -397,6 +603,7 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		historicalSummaries,
 	)
 }
 
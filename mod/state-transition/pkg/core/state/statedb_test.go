@@ -0,0 +1,686 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testKVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	testBeaconStateMarshallable = types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]
+
+	testStateDB = state.StateDB[
+		*types.BeaconBlockHeader,
+		*testBeaconStateMarshallable,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("state-tests")
+
+type testKVStoreService struct{}
+
+func (kvs *testKVStoreService) OpenKVStore(
+	ctx context.Context,
+) corestore.KVStore {
+	return components.NewKVStore(
+		sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey),
+	)
+}
+
+// testSpec returns a chain spec with small vectors suitable for tests.
+func testSpec() common.ChainSpec {
+	return chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:             4,
+			SlotsPerHistoricalRoot:    8,
+			EpochsPerHistoricalVector: 8,
+			MaxEffectiveBalance:       32e9,
+		},
+	)
+}
+
+// newTestStateDB returns a StateDB backed by an in-memory store.
+func newTestStateDB(t testing.TB, cs common.ChainSpec) *testStateDB {
+	t.Helper()
+	var (
+		nopLog = log.NewNopLogger()
+		cms    = store.NewCommitMultiStore(
+			dbm.NewMemDB(), nopLog, metrics.NewNoOpMetrics(),
+		)
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, true, nopLog)
+	kvStore := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		&testKVStoreService{},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	return (&testStateDB{}).NewFromDB(kvStore.WithContext(ctx), cs)
+}
+
+func TestRandaoMix(t *testing.T) {
+	cs := testSpec()
+	st := newTestStateDB(t, cs)
+
+	// Fill the mixes vector with distinct values.
+	for i := range cs.EpochsPerHistoricalVector() {
+		require.NoError(t, st.UpdateRandaoMixAtIndex(
+			i, common.Bytes32{byte(i + 1)},
+		))
+	}
+
+	// Move the state into epoch 10.
+	require.NoError(t, st.SetSlot(math.Slot(10*cs.SlotsPerEpoch())))
+
+	t.Run("in range", func(t *testing.T) {
+		for _, epoch := range []math.Epoch{3, 7, 10} {
+			mix, err := st.RandaoMix(epoch)
+			require.NoError(t, err)
+			require.Equal(t, common.Root{byte(
+				epoch.Unwrap()%cs.EpochsPerHistoricalVector() + 1,
+			)}, mix)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		for _, epoch := range []math.Epoch{0, 2, 11} {
+			_, err := st.RandaoMix(epoch)
+			require.ErrorIs(t, err, state.ErrEpochOutOfRange)
+		}
+	})
+}
+
+func TestBlockRootAtSlot(t *testing.T) {
+	cs := testSpec()
+	st := newTestStateDB(t, cs)
+
+	// Fill the block roots vector with distinct values.
+	for i := range cs.SlotsPerHistoricalRoot() {
+		require.NoError(t, st.UpdateBlockRootAtIndex(
+			i, common.Root{byte(i + 1)},
+		))
+	}
+
+	// Move the state to slot 20.
+	const currentSlot = math.Slot(20)
+	require.NoError(t, st.SetSlot(currentSlot))
+
+	t.Run("recent slot", func(t *testing.T) {
+		for _, slot := range []math.Slot{12, 15, 19} {
+			root, err := st.BlockRootAtSlot(slot)
+			require.NoError(t, err)
+			require.Equal(t, common.Root{byte(
+				slot.Unwrap()%cs.SlotsPerHistoricalRoot() + 1,
+			)}, root)
+		}
+	})
+
+	t.Run("current slot", func(t *testing.T) {
+		_, err := st.BlockRootAtSlot(currentSlot)
+		require.ErrorIs(t, err, state.ErrSlotOutOfRange)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		for _, slot := range []math.Slot{0, 11, 21} {
+			_, err := st.BlockRootAtSlot(slot)
+			require.ErrorIs(t, err, state.ErrSlotOutOfRange)
+		}
+	})
+}
+
+func TestValidatorQueues(t *testing.T) {
+	cs := testSpec()
+	st := newTestStateDB(t, cs)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	withEpochs := func(
+		eligibility, activation, exit math.Epoch,
+	) *types.Validator {
+		return &types.Validator{
+			ActivationEligibilityEpoch: eligibility,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+		}
+	}
+
+	// Move the state into epoch 5.
+	require.NoError(t, st.SetSlot(math.Slot(5*cs.SlotsPerEpoch())))
+
+	for i, val := range []*types.Validator{
+		// 0: active.
+		withEpochs(0, 0, farFuture),
+		// 1: queued for activation, eligible at epoch 4.
+		withEpochs(4, farFuture, farFuture),
+		// 2: queued for activation, eligible at epoch 2.
+		withEpochs(2, farFuture, farFuture),
+		// 3: queued for activation, eligible at epoch 4, after 1 by index.
+		withEpochs(4, farFuture, farFuture),
+		// 4: not yet eligible for activation.
+		withEpochs(farFuture, farFuture, farFuture),
+		// 5: exiting at epoch 9.
+		withEpochs(0, 0, 9),
+		// 6: exiting at epoch 7.
+		withEpochs(0, 0, 7),
+		// 7: already exited.
+		withEpochs(0, 0, 3),
+	} {
+		val.Pubkey = crypto.BLSPubkey{byte(i + 1)}
+		require.NoError(t, st.AddValidator(val))
+	}
+
+	activations, err := st.ActivationQueue()
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{2, 1, 3}, activations)
+
+	exits, err := st.ExitQueue()
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{6, 5}, exits)
+}
+
+func TestEstimateActivationEpoch(t *testing.T) {
+	// Two validators leave the activation queue per epoch and are activated
+	// 2 epochs after the one following the epoch they leave it in.
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:         4,
+			MinPerEpochChurnLimit: 2,
+			ChurnLimitQuotient:    1 << 16,
+			MinActivationDelay:    2,
+		},
+	)
+	st := newTestStateDB(t, cs)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	withEpochs := func(eligibility, activation math.Epoch) *types.Validator {
+		return &types.Validator{
+			ActivationEligibilityEpoch: eligibility,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  farFuture,
+		}
+	}
+
+	// Move the state into epoch 5.
+	require.NoError(t, st.SetSlot(math.Slot(5*cs.SlotsPerEpoch())))
+
+	for i, val := range []*types.Validator{
+		// 0: active.
+		withEpochs(0, 0),
+		// 1: queued for activation, eligible at epoch 4.
+		withEpochs(4, farFuture),
+		// 2: queued for activation, eligible at epoch 2.
+		withEpochs(2, farFuture),
+		// 3: not yet eligible for activation.
+		withEpochs(farFuture, farFuture),
+		// 4: queued for activation, eligible at epoch 3.
+		withEpochs(3, farFuture),
+		// 5: queued for activation, eligible at epoch 4, after 1 by index.
+		withEpochs(4, farFuture),
+		// 6: queued for activation, eligible at epoch 5.
+		withEpochs(5, farFuture),
+	} {
+		val.Pubkey = crypto.BLSPubkey{byte(i + 1)}
+		require.NoError(t, st.AddValidator(val))
+	}
+
+	queue, err := st.ActivationQueue()
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{2, 4, 1, 5, 6}, queue)
+
+	// The estimates follow the queue order.
+	expected := []math.Epoch{8, 8, 9, 9, 10}
+	var previous math.Epoch
+	for i, idx := range queue {
+		epoch, err := st.EstimateActivationEpoch(idx)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], epoch, "validator %d", idx)
+		require.GreaterOrEqual(t, epoch, previous)
+		previous = epoch
+	}
+
+	for _, idx := range []math.ValidatorIndex{0, 3} {
+		_, err = st.EstimateActivationEpoch(idx)
+		require.ErrorIs(t, err, state.ErrValidatorNotPending)
+	}
+}
+
+func TestValidatorChurnLimitUnset(t *testing.T) {
+	// A chain without a churn limit still lets one validator through per
+	// epoch.
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch: 4,
+		},
+	)
+	st := newTestStateDB(t, cs)
+	require.NoError(t, st.SetSlot(0))
+
+	churn, err := st.ValidatorChurnLimit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), churn)
+}
+
+func TestValidatorStatus(t *testing.T) {
+	cs := testSpec()
+	st := newTestStateDB(t, cs)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	// Validators are never queued for activation, such that their
+	// activation epochs are never set.
+	withEpochs := func(
+		effectiveBalance math.Gwei, exit, withdrawable math.Epoch,
+		slashed bool,
+	) *types.Validator {
+		return &types.Validator{
+			EffectiveBalance:           effectiveBalance,
+			ActivationEligibilityEpoch: farFuture,
+			ActivationEpoch:            farFuture,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          withdrawable,
+			Slashed:                    slashed,
+		}
+	}
+
+	// Move the state into epoch 5.
+	require.NoError(t, st.SetSlot(math.Slot(5*cs.SlotsPerEpoch())))
+
+	for i, tc := range []struct {
+		val     *types.Validator
+		balance math.Gwei
+		status  string
+	}{
+		{
+			val:     withEpochs(0, farFuture, farFuture, false),
+			balance: 1e8,
+			status:  state.ValidatorStatusPendingInitialized,
+		},
+		{
+			val:    withEpochs(32e9, farFuture, farFuture, false),
+			status: state.ValidatorStatusActiveOngoing,
+		},
+		{
+			val:    withEpochs(32e9, 6, 8, false),
+			status: state.ValidatorStatusActiveExiting,
+		},
+		{
+			val:    withEpochs(32e9, 6, 8, true),
+			status: state.ValidatorStatusActiveSlashed,
+		},
+		{
+			val:    withEpochs(32e9, 5, 6, false),
+			status: state.ValidatorStatusExitedUnslashed,
+		},
+		{
+			val:    withEpochs(32e9, 4, 6, true),
+			status: state.ValidatorStatusExitedSlashed,
+		},
+		{
+			val:     withEpochs(32e9, 3, 5, false),
+			balance: 1e9,
+			status:  state.ValidatorStatusWithdrawalPossible,
+		},
+		{
+			val:    withEpochs(0, 3, 5, false),
+			status: state.ValidatorStatusWithdrawalDone,
+		},
+	} {
+		idx := math.ValidatorIndex(i)
+		tc.val.Pubkey = crypto.BLSPubkey{byte(i + 1)}
+		require.NoError(t, st.AddValidator(tc.val))
+		require.NoError(t, st.IncreaseBalance(idx, tc.balance))
+
+		status, err := st.ValidatorStatus(idx)
+		require.NoError(t, err)
+		require.Equal(t, tc.status, status, "validator %d", i)
+	}
+}
+
+func TestCommitteeCountPerSlot(t *testing.T) {
+	// 4 slots per epoch and committees of 2 validators, such that every 8
+	// active validators add a committee per slot, up to 3.
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:        4,
+			MaxCommitteesPerSlot: 3,
+			TargetCommitteeSize:  2,
+		},
+	)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+
+	tests := []struct {
+		name     string
+		active   int
+		inactive int
+		expected uint64
+	}{
+		{name: "no validators", expected: 1},
+		{name: "below one committee", active: 7, expected: 1},
+		{name: "one committee", active: 8, expected: 1},
+		{name: "two committees", active: 16, expected: 2},
+		{name: "rounded down", active: 23, expected: 2},
+		{name: "max committees", active: 24, expected: 3},
+		{name: "clamped to max", active: 100, expected: 3},
+		{
+			name:     "inactive not counted",
+			active:   8,
+			inactive: 16,
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newTestStateDB(t, cs)
+			for i := range tt.active + tt.inactive {
+				val := &types.Validator{
+					Pubkey:           crypto.BLSPubkey{byte(i), byte(i >> 8)},
+					EffectiveBalance: 32e9,
+					ActivationEpoch:  farFuture,
+					ExitEpoch:        farFuture,
+				}
+				if i >= tt.active {
+					val.EffectiveBalance = 0
+				}
+				require.NoError(t, st.AddValidator(val))
+			}
+
+			count, err := st.CommitteeCountPerSlot(1)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, count)
+		})
+	}
+}
+
+func TestExpectedWithdrawalsCompounding(t *testing.T) {
+	data := chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]{
+		SlotsPerEpoch:                    4,
+		SlotsPerHistoricalRoot:           8,
+		EpochsPerHistoricalVector:        8,
+		MaxEffectiveBalance:              32e9,
+		MaxEffectiveBalanceElectra:       64e9,
+		MaxValidatorsPerWithdrawalsSweep: 8,
+		MaxWithdrawalsPerPayload:         8,
+		SkipIneligibleWithdrawals:        true,
+	}
+	legacy := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0x01},
+	)
+	compounding := legacy
+	compounding[0] = types.CompoundingCredentialPrefix
+
+	for _, tc := range []struct {
+		name    string
+		electra math.Epoch
+		amounts []math.Gwei
+	}{
+		// The excess over the ceiling of each validator is withdrawn.
+		{name: "electra", amounts: []math.Gwei{8e9, 6e9}},
+		// Compounding validators are capped at 32 before the fork.
+		{name: "before electra", electra: 10, amounts: []math.Gwei{8e9}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := data
+			data.DenebPlusForkEpoch = tc.electra
+			data.ElectraForkEpoch = tc.electra
+			st := newTestStateDB(t, chain.NewChainSpec(data))
+			require.NoError(t, st.SetSlot(math.Slot(5*data.SlotsPerEpoch)))
+			for i, val := range []struct {
+				credentials               types.WithdrawalCredentials
+				effectiveBalance, balance math.Gwei
+			}{
+				{credentials: legacy, effectiveBalance: 32e9, balance: 40e9},
+				{
+					credentials:      compounding,
+					effectiveBalance: 40e9,
+					balance:          40e9,
+				},
+				{
+					credentials:      compounding,
+					effectiveBalance: 64e9,
+					balance:          70e9,
+				},
+			} {
+				require.NoError(t, st.AddValidator(&types.Validator{
+					Pubkey:                crypto.BLSPubkey{byte(i)},
+					WithdrawalCredentials: val.credentials,
+					EffectiveBalance:      val.effectiveBalance,
+					WithdrawableEpoch: math.Epoch(
+						constants.FarFutureEpoch,
+					),
+				}))
+				require.NoError(t, st.IncreaseBalance(
+					math.ValidatorIndex(i), val.balance,
+				))
+			}
+			require.NoError(t, st.SetNextWithdrawalIndex(0))
+			require.NoError(t, st.SetNextWithdrawalValidatorIndex(0))
+
+			withdrawals, err := st.ExpectedWithdrawals()
+			require.NoError(t, err)
+			amounts := make([]math.Gwei, 0, len(withdrawals))
+			for _, withdrawal := range withdrawals {
+				amounts = append(amounts, withdrawal.GetAmount())
+			}
+			require.Equal(t, tc.amounts, amounts)
+		})
+	}
+}
+
+func TestNextWithdrawalIndices(t *testing.T) {
+	data := chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]{
+		SlotsPerEpoch:                    4,
+		SlotsPerHistoricalRoot:           8,
+		EpochsPerHistoricalVector:        8,
+		MaxEffectiveBalance:              32e9,
+		MaxValidatorsPerWithdrawalsSweep: 16,
+		MaxWithdrawalsPerPayload:         3,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		chainID uint64
+		next    math.ValidatorIndex
+	}{
+		// A full sweep resumes after the validator withdrawn last.
+		{name: "capped sweep", next: 3},
+		// On bArtio, it resumes after the withdrawal index withdrawn last.
+		{name: "bartio", chainID: 80084, next: 104 % 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := data
+			data.DepositEth1ChainID = tc.chainID
+			st := newTestStateDB(t, chain.NewChainSpec(data))
+			for i := range 5 {
+				require.NoError(t, st.AddValidator(&types.Validator{
+					Pubkey: crypto.BLSPubkey{byte(i)},
+				}))
+			}
+			require.NoError(t, st.SetNextWithdrawalIndex(101))
+			require.NoError(t, st.SetNextWithdrawalValidatorIndex(0))
+
+			withdrawals := make([]*engineprimitives.Withdrawal, 0, 3)
+			for i := range 3 {
+				withdrawals = append(
+					withdrawals, (&engineprimitives.Withdrawal{}).New(
+						math.U64(101+i), math.ValidatorIndex(i),
+						common.ExecutionAddress{}, 1,
+					),
+				)
+			}
+			withdrawalIndex, validatorIndex, err := st.NextWithdrawalIndices(
+				withdrawals,
+			)
+			require.NoError(t, err)
+			require.Equal(t, uint64(104), withdrawalIndex)
+			require.Equal(t, tc.next, validatorIndex)
+		})
+	}
+}
+
+// newWithdrawalsStateDB returns a state with n validators, of which every
+// third is fully withdrawable, every third partially withdrawable and the
+// remaining ones not eligible for a withdrawal.
+func newWithdrawalsStateDB(
+	t testing.TB,
+	cs common.ChainSpec,
+	n int,
+) *testStateDB {
+	t.Helper()
+	st := newTestStateDB(t, cs)
+	require.NoError(t, st.SetSlot(math.Slot(5*cs.SlotsPerEpoch())))
+	for i := range n {
+		val := &types.Validator{
+			Pubkey: crypto.BLSPubkey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{byte(i), byte(i >> 8)},
+			),
+			EffectiveBalance:  math.Gwei(cs.MaxEffectiveBalance()),
+			WithdrawableEpoch: math.Epoch(constants.FarFutureEpoch),
+		}
+		balance := math.Gwei(cs.MaxEffectiveBalance())
+		switch i % 3 {
+		case 0:
+			val.WithdrawableEpoch = 3
+		case 1:
+			balance += math.Gwei(i)
+		}
+		require.NoError(t, st.AddValidator(val))
+		require.NoError(t, st.IncreaseBalance(math.ValidatorIndex(i), balance))
+	}
+	return st
+}
+
+func BenchmarkExpectedWithdrawals(b *testing.B) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:                    4,
+			SlotsPerHistoricalRoot:           8,
+			EpochsPerHistoricalVector:        8,
+			MaxEffectiveBalance:              32e9,
+			MaxValidatorsPerWithdrawalsSweep: 4096,
+			MaxWithdrawalsPerPayload:         4096,
+			SkipIneligibleWithdrawals:        true,
+		},
+	)
+	st := newWithdrawalsStateDB(b, cs, 4096)
+	require.NoError(b, st.SetNextWithdrawalIndex(0))
+	require.NoError(b, st.SetNextWithdrawalValidatorIndex(0))
+	b.ResetTimer()
+	for range b.N {
+		_, err := st.ExpectedWithdrawals()
+		require.NoError(b, err)
+	}
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Validator statuses as defined by the beacon node API.
+// https://github.com/ethereum/beacon-APIs/blob/master/validator-flow.md
+const (
+	ValidatorStatusPendingInitialized = "pending_initialized"
+	ValidatorStatusPendingQueued      = "pending_queued"
+	ValidatorStatusActiveOngoing      = "active_ongoing"
+	ValidatorStatusActiveExiting      = "active_exiting"
+	ValidatorStatusActiveSlashed      = "active_slashed"
+	ValidatorStatusExitedUnslashed    = "exited_unslashed"
+	ValidatorStatusExitedSlashed      = "exited_slashed"
+	ValidatorStatusWithdrawalPossible = "withdrawal_possible"
+	ValidatorStatusWithdrawalDone     = "withdrawal_done"
+)
+
+// ValidatorStatus returns the status of the validator at the given index in
+// the current epoch. Validators join the CometBFT validator set as soon as
+// their deposit is processed rather than through the activation queue, hence
+// they are active while in it, pending_initialized until their effective
+// balance is non-zero, and never pending_queued.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) ValidatorStatus(index math.ValidatorIndex) (string, error) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return "", err
+	}
+	epoch := s.cs.SlotToEpoch(slot)
+
+	val, err := s.ValidatorByIndex(index)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case epoch < val.GetExitEpoch():
+		switch {
+		case !val.IsInValidatorSet(epoch):
+			return ValidatorStatusPendingInitialized, nil
+		case val.IsSlashed():
+			return ValidatorStatusActiveSlashed, nil
+		case val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch):
+			return ValidatorStatusActiveOngoing, nil
+		default:
+			return ValidatorStatusActiveExiting, nil
+		}
+	case epoch < val.GetWithdrawableEpoch():
+		if val.IsSlashed() {
+			return ValidatorStatusExitedSlashed, nil
+		}
+		return ValidatorStatusExitedUnslashed, nil
+	}
+
+	balance, err := s.GetBalance(index)
+	if err != nil {
+		return "", err
+	}
+	if balance > 0 {
+		return ValidatorStatusWithdrawalPossible, nil
+	}
+	return ValidatorStatusWithdrawalDone, nil
+}
@@ -55,6 +55,7 @@ type BeaconStateMarshallable[
 		nextWithdrawalIndex uint64,
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []math.U64, totalSlashing math.U64,
+		historicalSummaries []common.HistoricalSummary,
 	) (T, error)
 }
 
@@ -71,6 +72,22 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	// IsPartiallyWithdrawable checks if the validator is partially withdrawable
 	// given two Gwei amounts.
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
+	// HasCompoundingWithdrawalCredential returns true if the validator has
+	// compounding withdrawal credentials.
+	HasCompoundingWithdrawalCredential() bool
+	// IsInValidatorSet checks if the validator is active at the given epoch.
+	IsInValidatorSet(epoch math.Epoch) bool
+	// GetActivationEligibilityEpoch returns the epoch when the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// GetActivationEpoch returns the epoch when the validator is activated.
+	GetActivationEpoch() math.Epoch
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
+	GetWithdrawableEpoch() math.Epoch
+	// IsSlashed returns whether the validator has been slashed.
+	IsSlashed() bool
 }
 
 // Withdrawal represents an interface for a withdrawal.
@@ -81,6 +98,12 @@ type Withdrawal[T any] interface {
 		address common.ExecutionAddress,
 		amount math.Gwei,
 	) T
+	// GetAmount returns the amount of the withdrawal.
+	GetAmount() math.Gwei
+	// GetIndex returns the index of the withdrawal.
+	GetIndex() math.U64
+	// GetValidatorIndex returns the index of the validator.
+	GetValidatorIndex() math.ValidatorIndex
 }
 
 // WithdrawalCredentials represents an interface for withdrawal credentials.
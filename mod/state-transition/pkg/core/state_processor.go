@@ -35,11 +35,11 @@ import (
 // main state transition for the beacon chain.
 type StateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
@@ -54,6 +54,7 @@ type StateProcessor[
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		HashTreeRoot() common.Root
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -85,17 +86,29 @@ type StateProcessor[
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	]
+	// shuffler is used to shuffle validator indices.
+	shuffler Shuffler
+	// auditSink, if set, receives a record of every state-changing operation
+	// of blocks processed with auditing enabled.
+	auditSink AuditSink
+	// cfg is the configuration of the state processor.
+	cfg Config
+	// timingSink, if set, receives the phase timings of every block
+	// transitioned.
+	timingSink TimingSink
+	// lastTimings holds the phase timings of the last block transitioned.
+	lastTimings lastTimings
 }
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
 		WithdrawalsT,
 	],
@@ -109,6 +122,7 @@ func NewStateProcessor[
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		HashTreeRoot() common.Root
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -137,6 +151,9 @@ func NewStateProcessor[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	signer crypto.BLSSigner,
+	auditSink AuditSink,
+	cfg Config,
+	timingSink TimingSink,
 ) *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
@@ -152,6 +169,10 @@ func NewStateProcessor[
 		cs:              cs,
 		executionEngine: executionEngine,
 		signer:          signer,
+		shuffler:        NewSwapOrNotShuffler(ShuffleRoundCount),
+		auditSink:       auditSink,
+		cfg:             cfg,
+		timingSink:      timingSink,
 	}
 }
 
@@ -164,28 +185,122 @@ func (sp *StateProcessor[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
+	validatorUpdates, emit, err := sp.transition(
+		ctx, st, blk, ctx.GetAuditOperations(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	emit()
+	return validatorUpdates, nil
+}
+
+// TransitionDeferred processes the state transition for a given block like
+// Transition, but holds back the audit records and phase timings it gathers
+// until the returned function is called. It is used to verify a block whose
+// post state is committed later on, such that the records are emitted once,
+// when the block is finalized. The operations are audited regardless of the
+// context.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) TransitionDeferred(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (transition.ValidatorUpdates, func(), error) {
+	return sp.transition(ctx, st, blk, true)
+}
+
+// transition processes the state transition for a given block, auditing its
+// operations if audited is set, and returns a function emitting the audit
+// records and phase timings gathered.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) transition(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	audited bool,
+) (transition.ValidatorUpdates, func(), error) {
 	if blk.IsNil() {
-		return nil, nil
+		return nil, func() {}, nil
+	}
+
+	// Operations are only audited for blocks that are being finalized.
+	var audit *auditLog
+	if audited {
+		audit = newAuditLog(sp.auditSink, blk.GetSlot())
 	}
 
 	// Process the slots.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	timer := newPhaseTimer(blk.GetSlot())
+	validatorUpdates, err := sp.processSlots(st, blk.GetSlot(), timer)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Process the block.
-	if err = sp.ProcessBlock(ctx, st, blk); err != nil {
-		return nil, err
+	if err = sp.processBlock(ctx, st, blk, timer, audit); err != nil {
+		return nil, nil, err
 	}
 
-	return validatorUpdates, nil
+	// The block is valid, emit the records of the operations it applied.
+	return validatorUpdates, func() {
+		audit.flush(sp.auditSink)
+		sp.lastTimings.set(timer.timings)
+		if sp.timingSink != nil {
+			sp.timingSink.RecordTimings(timer.timings)
+		}
+	}, nil
+}
+
+// LastTransitionTimings returns the phase timings of the last block
+// transitioned successfully, for debugging slow blocks.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) LastTransitionTimings() TransitionTimings {
+	return sp.lastTimings.get()
 }
 
+// ApplyBlock runs the full state transition for the block on a copy of the
+// given pre-state and returns the root of the resulting post-state. The
+// pre-state is left untouched and nothing is written to the underlying store,
+// which makes it suitable for replaying historical blocks and for conformance
+// tests. The execution client is only called if the context requires the
+// payload to be verified.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) ApplyBlock(
+	ctx ContextT,
+	preState BeaconStateT,
+	blk BeaconBlockT,
+) (common.Root, error) {
+	st := preState.Copy()
+	if _, err := sp.Transition(ctx, st, blk); err != nil {
+		return common.Root{}, err
+	}
+	return st.HashTreeRoot(), nil
+}
+
+// ProcessSlots processes the slots up to the given slot, including the
+// epochs they end.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	return sp.processSlots(st, slot, nil)
+}
+
+// processSlots processes the slots up to the given slot, timing the phases
+// with the given timer.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processSlots(
+	st BeaconStateT, slot math.Slot, timer *phaseTimer,
 ) (transition.ValidatorUpdates, error) {
 	var (
 		validatorUpdates      transition.ValidatorUpdates
@@ -200,15 +315,19 @@ func (sp *StateProcessor[
 	// Iterate until we are "caught up".
 	for ; stateSlot < slot; stateSlot++ {
 		// Process the slot
-		if err = sp.processSlot(st); err != nil {
+		if err = timer.track(PhaseSlots, func() error {
+			return sp.processSlot(st)
+		}); err != nil {
 			return nil, err
 		}
 
 		// Process the Epoch Boundary.
 		boundary := (stateSlot.Unwrap()+1)%sp.cs.SlotsPerEpoch() == 0
 		if boundary {
-			if epochValidatorUpdates, err =
-				sp.processEpoch(st); err != nil {
+			if err = timer.track(PhaseEpoch, func() error {
+				epochValidatorUpdates, err = sp.processEpoch(st)
+				return err
+			}); err != nil {
 				return nil, err
 			}
 			validatorUpdates = append(
@@ -277,46 +396,91 @@ func (sp *StateProcessor[
 	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
+) error {
+	var audit *auditLog
+	if ctx.GetAuditOperations() {
+		audit = newAuditLog(sp.auditSink, blk.GetSlot())
+	}
+	if err := sp.processBlock(ctx, st, blk, nil, audit); err != nil {
+		return err
+	}
+	audit.flush(sp.auditSink)
+	return nil
+}
+
+// processBlock processes the block, timing the phases with the given timer
+// and buffering the records of its operations in the given audit log.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processBlock(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	timer *phaseTimer,
+	audit *auditLog,
 ) error {
 	// process the freshly created header.
-	if err := sp.processBlockHeader(st, blk); err != nil {
+	if err := timer.track(PhaseBlockHeader, func() error {
+		return sp.processBlockHeader(st, blk)
+	}); err != nil {
 		return err
 	}
 
 	// process the execution payload.
-	if err := sp.processExecutionPayload(
-		ctx, st, blk,
-	); err != nil {
+	if err := timer.track(PhaseExecutionPayload, func() error {
+		return sp.processExecutionPayload(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
 	// process the withdrawals.
-	if err := sp.processWithdrawals(
-		st, blk.GetBody(),
-	); err != nil {
+	if err := timer.track(PhaseWithdrawals, func() error {
+		return sp.processWithdrawals(st, blk.GetBody(), audit)
+	}); err != nil {
 		return err
 	}
 
 	// process the randao reveal.
-	if err := sp.processRandaoReveal(
-		st, blk, ctx.GetSkipValidateRandao(),
-	); err != nil {
+	if err := timer.track(PhaseRandao, func() error {
+		return sp.processRandaoReveal(st, blk, ctx.GetSkipValidateRandao())
+	}); err != nil {
 		return err
 	}
 
-	// process the deposits and ensure they match the local state.
-	if err := sp.processOperations(st, blk); err != nil {
+	// process the eth1 data vote.
+	if err := timer.track(PhaseEth1Data, func() error {
+		return sp.processEth1Data(st, blk)
+	}); err != nil {
 		return err
 	}
 
-	// If we are skipping validate, we can skip calculating the state
-	// root to save compute.
-	if ctx.GetSkipValidateResult() {
-		return nil
+	// process the deposits and ensure they match the local state.
+	if err := timer.track(PhaseOperations, func() error {
+		return sp.processOperations(ctx, st, blk, audit)
+	}); err != nil {
+		return err
 	}
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
+	return timer.track(PhaseStateRoot, func() error {
+		return validateStateRoot(ctx.GetSkipValidateResult(), st, blk)
+	})
+}
+
+// validateStateRoot ensures the state root on the block matches the root of
+// the post-transition state. If skipValidateResult is set, as when the
+// proposer is building its own block, the state root is not computed to save
+// compute.
+func validateStateRoot(
+	skipValidateResult bool,
+	st interface{ HashTreeRoot() common.Root },
+	blk interface{ GetStateRoot() common.Root },
+) error {
+	if skipValidateResult {
+		return nil
+	}
+
 	stateRoot := st.HashTreeRoot()
 	if blk.GetStateRoot() != stateRoot {
 		return errors.Wrapf(
@@ -324,7 +488,6 @@ func (sp *StateProcessor[
 			stateRoot, blk.GetStateRoot(),
 		)
 	}
-
 	return nil
 }
 
@@ -334,14 +497,32 @@ func (sp *StateProcessor[
 ]) processEpoch(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	if err := sp.processJustificationAndFinalization(st); err != nil {
+		return nil, err
+	} else if err = sp.processInactivityUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processRewardsAndPenalties(st); err != nil {
+		return nil, err
+	} else if err = sp.processEth1DataReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
+	} else if err = sp.processHistoricalSummariesUpdate(st); err != nil {
+		return nil, err
+	} else if err = sp.processParticipationFlagUpdates(st); err != nil {
+		return nil, err
+	}
+
+	updates, err := sp.processSyncCommitteeUpdates(st)
+	if err != nil {
+		return nil, err
 	}
-	return sp.processSyncCommitteeUpdates(st)
+	if err = sp.processValidatorUpdatesLimit(st, updates); err != nil {
+		return nil, err
+	}
+	return updates, nil
 }
 
 // processBlockHeader processes the header and ensures it matches the local
@@ -433,17 +614,29 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) getAttestationDeltas(
 	st BeaconStateT,
 ) ([]math.Gwei, []math.Gwei, error) {
-	// TODO: implement this function forreal
-	validators, err := st.GetValidators()
+	slot, err := st.GetSlot()
 	if err != nil {
 		return nil, nil, err
 	}
-	placeholder := make([]math.Gwei, len(validators))
-	return placeholder, placeholder, nil
+
+	breakdowns, err := epochRewardBreakdowns[ValidatorT](
+		st, sp.cs, sp.cs.SlotToEpoch(slot),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := make([]math.Gwei, len(breakdowns))
+	penalties := make([]math.Gwei, len(breakdowns))
+	for i, breakdown := range breakdowns {
+		rewards[i] = breakdown.Reward()
+		penalties[i] = breakdown.Penalty()
+	}
+	return rewards, penalties, nil
 }
 
 // processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// testAttestation is an attestation of the given bits, data and signature.
+type testAttestation struct {
+	bits      []byte
+	data      core.AttestationData
+	signature crypto.BLSSignature
+}
+
+func (a testAttestation) GetAggregationBits() []byte {
+	return a.bits
+}
+
+func (a testAttestation) GetData() core.AttestationData {
+	return a.data
+}
+
+func (a testAttestation) GetSignature() crypto.BLSSignature {
+	return a.signature
+}
+
+func TestProcessAttestationAggregate(t *testing.T) {
+	specData := testSpecData()
+	specData.MinAttestationInclusionDelay = 1
+	var (
+		cs = chain.NewChainSpec(specData)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st = newTestGenesisState(t, cs, sp)
+	)
+
+	// The 16 validators make up the 4 committees of the epoch, one per slot.
+	signers := make(map[crypto.BLSPubkey]*signer.LegacySigner)
+	for range 16 {
+		key, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		val := &signer.LegacySigner{PrivKey: &key}
+		signers[val.PublicKey()] = val
+		require.NoError(t, st.AddValidator(newTestValidator(
+			val.PublicKey(), common.ExecutionAddress{0x01},
+		)))
+	}
+	require.NoError(t, st.SetSlot(1))
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	require.NoError(t, err)
+
+	data := (&types.AttestationData{}).New(0, 0, common.Root{0xbb})
+	indices, err := core.BeaconCommittee(st, cs, core.NewSwapOrNotShuffler(
+		core.ShuffleRoundCount,
+	), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, indices, 4)
+
+	// sign returns the aggregate signature of the committee members at the
+	// set bits over the attestation data.
+	sign := func(bits []byte) crypto.BLSSignature {
+		domain, err := cs.DomainTypeRegistry().DomainType(
+			chain.DomainNameAttester, cs.ActiveForkVersionForEpoch(0),
+		)
+		require.NoError(t, err)
+		signingRoot := types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(0),
+			),
+			genesisValidatorsRoot,
+		).ComputeAttestationSigningRoot(domain, data)
+
+		var signatures [][]byte
+		for i, index := range indices {
+			if bits[i/8]&(1<<(i%8)) == 0 {
+				continue
+			}
+			val, err := st.ValidatorByIndex(index)
+			require.NoError(t, err)
+			signature, err := signers[val.GetPubkey()].Sign(signingRoot[:])
+			require.NoError(t, err)
+			signatures = append(signatures, signature[:])
+		}
+		aggregate := new(blst.P2Aggregate)
+		require.True(t, aggregate.AggregateCompressed(signatures, true))
+
+		var signature crypto.BLSSignature
+		copy(signature[:], aggregate.ToAffine().Compress())
+		return signature
+	}
+
+	for _, bits := range [][]byte{{0x0f}, {0x05}, {0x08}} {
+		require.NoError(t, sp.ProcessAttestation(st, testAttestation{
+			bits: bits, data: data, signature: sign(bits),
+		}))
+	}
+
+	// The aggregate of a subset of the participants does not verify.
+	err = sp.ProcessAttestation(st, testAttestation{
+		bits: []byte{0x07}, data: data, signature: sign([]byte{0x05}),
+	})
+	require.ErrorIs(t, err, core.ErrInvalidAttestationSignature)
+
+	// Nor does it for other attestation data.
+	err = sp.ProcessAttestation(st, testAttestation{
+		bits:      []byte{0x0f},
+		data:      (&types.AttestationData{}).New(0, 0, common.Root{}),
+		signature: sign([]byte{0x0f}),
+	})
+	require.ErrorIs(t, err, core.ErrInvalidAttestationSignature)
+}
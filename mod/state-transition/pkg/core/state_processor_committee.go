@@ -21,11 +21,23 @@
 package core
 
 import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/sourcegraph/conc/iter"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates processes the sync committee updates, i.e. the
+// validator set updates handed to the consensus engine. It is unrelated to
+// the Ethereum sync committee returned by SyncCommittee.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) processSyncCommitteeUpdates(
@@ -47,3 +59,200 @@ func (sp *StateProcessor[
 		},
 	)
 }
+
+// SyncCommittee returns the pubkeys of the sync committee serving the given
+// period, selected as defined in the Ethereum 2.0 specification. Only periods
+// whose seed is already fixed and still retained by the state can be derived.
+// Blocks carry no sync aggregate, so the participation of the committee is
+// neither rewarded nor penalized by the state transition.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#get_next_sync_committee_indices
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-get_next_sync_committee_indices
+//
+//nolint:lll // link.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SyncCommittee(
+	st BeaconStateT,
+	period uint64,
+) ([]crypto.BLSPubkey, error) {
+	return syncCommittee(st, sp.cs, sp.shuffler, period)
+}
+
+const (
+	// maxRandomByte is the maximum random value sampled against before the
+	// Electra fork.
+	maxRandomByte = 1<<8 - 1
+	// maxRandomValue is the maximum random value sampled against from the
+	// Electra fork onwards.
+	maxRandomValue = 1<<16 - 1
+)
+
+// syncCommitteeValidator is a validator as accessed by sync committee
+// selection.
+type syncCommitteeValidator interface {
+	IsInValidatorSet(math.Epoch) bool
+	GetPubkey() crypto.BLSPubkey
+	GetEffectiveBalance() math.Gwei
+}
+
+// syncCommitteeState is the state accessed by sync committee selection.
+type syncCommitteeState[ValidatorT syncCommitteeValidator] interface {
+	GetSlot() (math.Slot, error)
+	GetRandaoMixAtIndex(uint64) (common.Bytes32, error)
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+}
+
+// syncCommitteeSelectionEpoch returns the epoch whose seed and active
+// validators select the sync committee of the period. The committee of a
+// period is selected as the next sync committee when the period before it
+// starts, and those of the first two periods at genesis, as the epoch after
+// the genesis epoch.
+func syncCommitteeSelectionEpoch(
+	cs common.ChainSpec, period uint64,
+) uint64 {
+	if period < 2 {
+		return constants.GenesisEpoch + 1
+	}
+	return (period - 1) * cs.EpochsPerSyncCommitteePeriod()
+}
+
+// syncCommittee selects the sync committee of the period from the validators
+// active at its selection epoch, sampling them in shuffled order with a
+// probability proportional to their effective balance.
+func syncCommittee[ValidatorT syncCommitteeValidator](
+	st syncCommitteeState[ValidatorT],
+	cs common.ChainSpec,
+	shuffler Shuffler,
+	period uint64,
+) ([]crypto.BLSPubkey, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	// The seed of the selection epoch derives from the randao mix of the
+	// epoch MinSeedLookahead+1 epochs before it. The mix is final once that
+	// epoch is over, and is overwritten EpochsPerHistoricalVector epochs
+	// after it.
+	var (
+		epoch     = cs.SlotToEpoch(slot).Unwrap()
+		selection = syncCommitteeSelectionEpoch(cs, period)
+	)
+	if selection > epoch+cs.MinSeedLookahead() ||
+		epoch+cs.MinSeedLookahead()+1 >=
+			selection+cs.EpochsPerHistoricalVector() {
+		return nil, errors.Wrapf(
+			ErrSyncCommitteePeriodOutOfRange,
+			"period: %d, current epoch: %d", period, epoch,
+		)
+	}
+
+	domain, err := cs.DomainTypeRegistry().DomainType(
+		chain.DomainNameSyncCommittee,
+		cs.ActiveForkVersionForEpoch(math.Epoch(selection)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := committeeSeed(st, cs, selection, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		active       = make([]ValidatorT, 0, total)
+		totalBalance math.Gwei
+	)
+	for i := range total {
+		val, err := st.ValidatorByIndex(math.ValidatorIndex(i))
+		if err != nil {
+			return nil, err
+		}
+		if val.IsInValidatorSet(math.Epoch(selection)) {
+			active = append(active, val)
+			totalBalance += val.GetEffectiveBalance()
+		}
+	}
+	if totalBalance == 0 {
+		return nil, ErrNoActiveValidators
+	}
+
+	// From the Electra fork onwards, candidates are sampled with 16 random
+	// bits against the ceiling of compounding validators, before it with 8
+	// random bits against the ceiling of all validators.
+	var (
+		electra = cs.ActiveForkVersionForEpoch(math.Epoch(selection)) >=
+			version.Electra
+		maxBalance = math.Gwei(
+			cs.MaxEffectiveBalanceForEpoch(math.Epoch(selection), electra),
+		)
+		count     = uint64(len(active))
+		committee = make([]crypto.BLSPubkey, 0, cs.SyncCommitteeSize())
+		buf       = make([]byte, shuffleSeedSize+8)
+		random    [32]byte
+	)
+	copy(buf, seed[:])
+	for i := uint64(0); uint64(len(committee)) < cs.SyncCommitteeSize(); i++ {
+		shuffled, err := shuffler.ShuffleIndex(i%count, count, seed)
+		if err != nil {
+			return nil, err
+		}
+
+		var selected bool
+		candidate := active[shuffled]
+		if electra {
+			//nolint:mnd // 16 random values per hash.
+			if i%16 == 0 {
+				binary.LittleEndian.PutUint64(buf[shuffleSeedSize:], i/16)
+				random = sha256.Hash(buf)
+			}
+			//nolint:mnd // 2 bytes per random value.
+			value := binary.LittleEndian.Uint16(random[i%16*2:])
+			selected = candidate.GetEffectiveBalance()*maxRandomValue >=
+				maxBalance*math.Gwei(value)
+		} else {
+			//nolint:mnd // 32 random bytes per hash.
+			if i%32 == 0 {
+				binary.LittleEndian.PutUint64(buf[shuffleSeedSize:], i/32)
+				random = sha256.Hash(buf)
+			}
+			selected = candidate.GetEffectiveBalance()*maxRandomByte >=
+				maxBalance*math.Gwei(random[i%32])
+		}
+		if selected {
+			committee = append(committee, candidate.GetPubkey())
+		}
+	}
+	return committee, nil
+}
+
+// committeeSeed returns the seed of the epoch for the given domain, as
+// defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_seed
+//
+//nolint:lll // link.
+func committeeSeed[ValidatorT syncCommitteeValidator](
+	st syncCommitteeState[ValidatorT],
+	cs common.ChainSpec,
+	epoch uint64,
+	domainType common.DomainType,
+) ([32]byte, error) {
+	mix, err := st.GetRandaoMixAtIndex(
+		(epoch + cs.EpochsPerHistoricalVector() - cs.MinSeedLookahead() - 1) %
+			cs.EpochsPerHistoricalVector(),
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	buf := make([]byte, 0, len(domainType)+8+len(mix))
+	buf = append(buf, domainType[:]...)
+	buf = binary.LittleEndian.AppendUint64(buf, epoch)
+	buf = append(buf, mix[:]...)
+	return sha256.Hash(buf), nil
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testCommitteeValidator is a validator active in [activation, exit).
+type testCommitteeValidator struct {
+	pubkey     crypto.BLSPubkey
+	balance    math.Gwei
+	activation math.Epoch
+	exit       math.Epoch
+}
+
+func (v testCommitteeValidator) IsInValidatorSet(epoch math.Epoch) bool {
+	return v.activation <= epoch && epoch < v.exit
+}
+
+func (v testCommitteeValidator) GetPubkey() crypto.BLSPubkey {
+	return v.pubkey
+}
+
+func (v testCommitteeValidator) GetEffectiveBalance() math.Gwei {
+	return v.balance
+}
+
+// testCommitteeState is an in-memory sync committee state.
+type testCommitteeState struct {
+	slot       math.Slot
+	mixes      []common.Bytes32
+	validators []testCommitteeValidator
+}
+
+func (s *testCommitteeState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testCommitteeState) GetRandaoMixAtIndex(
+	index uint64,
+) (common.Bytes32, error) {
+	return s.mixes[index], nil
+}
+
+func (s *testCommitteeState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *testCommitteeState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (testCommitteeValidator, error) {
+	return s.validators[index], nil
+}
+
+// newTestCommitteeState returns a sync committee state at the given slot,
+// with the given effective balances.
+func newTestCommitteeState(
+	cs common.ChainSpec, slot math.Slot, balances ...math.Gwei,
+) *testCommitteeState {
+	st := &testCommitteeState{slot: slot}
+	for i := range cs.EpochsPerHistoricalVector() {
+		var mix common.Bytes32
+		for j := range mix {
+			mix[j] = byte(i + 1)
+		}
+		st.mixes = append(st.mixes, mix)
+	}
+	for i, balance := range balances {
+		st.validators = append(st.validators, testCommitteeValidator{
+			pubkey:  crypto.BLSPubkey{byte(i)},
+			balance: balance * 1e9,
+			exit:    math.Epoch(^uint64(0)),
+		})
+	}
+	// Validator 3 exits at epoch 3 and validator 6 is not activated yet.
+	st.validators[3].exit = 3
+	st.validators[6].activation = 100
+	return st
+}
+
+func TestSyncCommittee(t *testing.T) {
+	var (
+		data = chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:                4,
+			MinSeedLookahead:             1,
+			EpochsPerHistoricalVector:    8,
+			MaxEffectiveBalance:          32e9,
+			MaxEffectiveBalanceElectra:   64e9,
+			DomainTypeSyncCommittee:      common.DomainType{0x07},
+			SyncCommitteeSize:            16,
+			EpochsPerSyncCommitteePeriod: 2,
+			DenebPlusForkEpoch:           math.Epoch(^uint64(0)),
+			ElectraForkEpoch:             math.Epoch(^uint64(0)),
+		}
+		shuffler = NewSwapOrNotShuffler(ShuffleRoundCount)
+	)
+	pubkeys := func(indices ...byte) []crypto.BLSPubkey {
+		keys := make([]crypto.BLSPubkey, len(indices))
+		for i, index := range indices {
+			keys[i] = crypto.BLSPubkey{index}
+		}
+		return keys
+	}
+
+	// Vectors computed with the get_seed, compute_shuffled_index and
+	// get_next_sync_committee_indices functions of the consensus-specs; the
+	// committee of a period is selected at the start of the period before
+	// it, those of the first two periods at the epoch after genesis.
+	t.Run("deneb", func(t *testing.T) {
+		var (
+			cs = chain.NewChainSpec(data)
+			st = newTestCommitteeState(
+				cs, 4*4, 32, 32, 16, 8, 32, 24, 32, 1,
+			)
+		)
+		for period, expected := range map[uint64][]crypto.BLSPubkey{
+			0: pubkeys(1, 0, 5, 4, 1, 3, 0, 5, 4, 1, 0, 2, 5, 4, 1, 0),
+			1: pubkeys(1, 0, 5, 4, 1, 3, 0, 5, 4, 1, 0, 2, 5, 4, 1, 0),
+			2: pubkeys(2, 3, 5, 4, 0, 1, 2, 5, 4, 0, 1, 2, 5, 4, 0, 1),
+			3: pubkeys(1, 0, 4, 5, 2, 1, 0, 4, 2, 1, 0, 4, 5, 2, 1, 0),
+		} {
+			committee, err := syncCommittee(st, cs, shuffler, period)
+			require.NoError(t, err)
+			require.Equal(t, expected, committee, "period %d", period)
+		}
+	})
+
+	t.Run("electra", func(t *testing.T) {
+		data := data
+		data.ElectraForkEpoch = 0
+		var (
+			cs = chain.NewChainSpec(data)
+			st = newTestCommitteeState(
+				cs, 4*4, 64, 32, 16, 8, 64, 48, 64, 1,
+			)
+		)
+		for period, expected := range map[uint64][]crypto.BLSPubkey{
+			1: pubkeys(0, 5, 4, 0, 5, 4, 1, 0, 4, 3, 0, 5, 4, 0, 5, 4),
+			2: pubkeys(2, 5, 4, 0, 1, 4, 0, 5, 4, 0, 1, 2, 5, 4, 0, 2),
+			3: pubkeys(1, 0, 4, 5, 2, 0, 4, 5, 1, 0, 4, 5, 1, 0, 4, 5),
+		} {
+			committee, err := syncCommittee(st, cs, shuffler, period)
+			require.NoError(t, err)
+			require.Equal(t, expected, committee, "period %d", period)
+		}
+	})
+
+	t.Run("range", func(t *testing.T) {
+		var (
+			cs = chain.NewChainSpec(data)
+			st = newTestCommitteeState(
+				cs, 4*4, 32, 32, 16, 8, 32, 24, 32, 1,
+			)
+		)
+
+		// The next period is only known MinSeedLookahead epochs before the
+		// start of the period before it.
+		_, err := syncCommittee(st, cs, shuffler, 4)
+		require.ErrorIs(t, err, ErrSyncCommitteePeriodOutOfRange)
+		st.slot = 5 * 4
+		_, err = syncCommittee(st, cs, shuffler, 4)
+		require.NoError(t, err)
+
+		// Periods whose randao mix was overwritten are no longer known.
+		_, err = syncCommittee(st, cs, shuffler, 1)
+		require.NoError(t, err)
+		st.slot = 7 * 4
+		_, err = syncCommittee(st, cs, shuffler, 1)
+		require.ErrorIs(t, err, ErrSyncCommitteePeriodOutOfRange)
+
+		// A committee cannot be selected without active balance.
+		for i := range st.validators {
+			st.validators[i].balance = 0
+		}
+		_, err = syncCommittee(st, cs, shuffler, 4)
+		require.ErrorIs(t, err, ErrNoActiveValidators)
+	})
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// eth1DataVoteState is the subset of the beacon state used to tally eth1
+// data votes.
+type eth1DataVoteState[Eth1DataT any] interface {
+	SetEth1Data(Eth1DataT) error
+	GetEth1DataVotes() ([]Eth1DataT, error)
+	AppendEth1DataVote(Eth1DataT) error
+	ResetEth1DataVotes() error
+}
+
+// processEth1Data as defined in the Ethereum 2.0 specification. Votes are
+// only tallied from the Electra fork onwards.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#eth1-data
+//
+//nolint:lll
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEth1Data(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil
+	}
+	return processEth1DataVote(
+		st, blk.GetBody().GetEth1Data(),
+		sp.cs.SlotsPerEth1VotingPeriod(),
+	)
+}
+
+// processEth1DataReset as defined in the Ethereum 2.0 specification, from
+// the Electra fork onwards.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#eth1-data-votes-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEth1DataReset(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	epoch := sp.cs.SlotToEpoch(slot)
+	if sp.cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+	return resetEth1DataVotes(
+		st, epoch+1, sp.cs.EpochsPerEth1VotingPeriod(),
+	)
+}
+
+// processEth1DataVote records the vote and, once more than half of the slots
+// in the voting period have voted for the same eth1 data, makes it the
+// canonical eth1 data of the state. Votes are not tallied if the voting
+// period is empty.
+func processEth1DataVote[
+	Eth1DataT interface{ HashTreeRoot() common.Root },
+](
+	st eth1DataVoteState[Eth1DataT],
+	vote Eth1DataT,
+	slotsPerVotingPeriod uint64,
+) error {
+	if slotsPerVotingPeriod == 0 {
+		return nil
+	}
+
+	if err := st.AppendEth1DataVote(vote); err != nil {
+		return err
+	}
+
+	votes, err := st.GetEth1DataVotes()
+	if err != nil {
+		return err
+	}
+
+	var (
+		count    uint64
+		voteRoot = vote.HashTreeRoot()
+	)
+	for _, v := range votes {
+		if v.HashTreeRoot() == voteRoot {
+			count++
+		}
+	}
+
+	if count*2 > slotsPerVotingPeriod {
+		return st.SetEth1Data(vote)
+	}
+	return nil
+}
+
+// resetEth1DataVotes clears the eth1 data votes when the next epoch starts a
+// new voting period.
+func resetEth1DataVotes[Eth1DataT any](
+	st eth1DataVoteState[Eth1DataT],
+	nextEpoch math.Epoch,
+	epochsPerVotingPeriod uint64,
+) error {
+	if epochsPerVotingPeriod == 0 ||
+		nextEpoch.Unwrap()%epochsPerVotingPeriod != 0 {
+		return nil
+	}
+	return st.ResetEth1DataVotes()
+}
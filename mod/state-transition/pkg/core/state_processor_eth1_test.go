@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// testEth1Data is eth1 data identified by its root.
+type testEth1Data struct {
+	root common.Root
+}
+
+func (d testEth1Data) HashTreeRoot() common.Root { return d.root }
+
+// testEth1DataState is an in-memory eth1 data vote state.
+type testEth1DataState struct {
+	eth1Data testEth1Data
+	votes    []testEth1Data
+}
+
+func (s *testEth1DataState) SetEth1Data(data testEth1Data) error {
+	s.eth1Data = data
+	return nil
+}
+
+func (s *testEth1DataState) GetEth1DataVotes() ([]testEth1Data, error) {
+	return s.votes, nil
+}
+
+func (s *testEth1DataState) AppendEth1DataVote(vote testEth1Data) error {
+	s.votes = append(s.votes, vote)
+	return nil
+}
+
+func (s *testEth1DataState) ResetEth1DataVotes() error {
+	s.votes = nil
+	return nil
+}
+
+func TestProcessEth1DataVote(t *testing.T) {
+	const (
+		epochsPerVotingPeriod = 2
+		slotsPerVotingPeriod  = epochsPerVotingPeriod * 2
+	)
+	var (
+		genesis = testEth1Data{root: common.Root{0x01}}
+		vote    = testEth1Data{root: common.Root{0x02}}
+		other   = testEth1Data{root: common.Root{0x03}}
+		st      = &testEth1DataState{eth1Data: genesis}
+	)
+
+	// Half of the voting period is not a majority.
+	for _, v := range []testEth1Data{vote, other, vote} {
+		require.NoError(t, processEth1DataVote(st, v, slotsPerVotingPeriod))
+	}
+	require.Equal(t, genesis, st.eth1Data)
+
+	// A third vote out of four slots is a majority.
+	require.NoError(t, processEth1DataVote(st, vote, slotsPerVotingPeriod))
+	require.Equal(t, vote, st.eth1Data)
+	require.Len(t, st.votes, 4)
+
+	// Votes are kept within the voting period.
+	require.NoError(t, resetEth1DataVotes(st, 1, epochsPerVotingPeriod))
+	require.Len(t, st.votes, 4)
+
+	// Votes are reset at the voting period boundary, while the canonical
+	// eth1 data is kept.
+	require.NoError(t, resetEth1DataVotes(st, 2, epochsPerVotingPeriod))
+	require.Empty(t, st.votes)
+	require.Equal(t, vote, st.eth1Data)
+
+	// Votes from the previous period no longer count towards a majority.
+	for range 2 {
+		require.NoError(t, processEth1DataVote(st, other, slotsPerVotingPeriod))
+	}
+	require.Equal(t, vote, st.eth1Data)
+}
+
+func TestProcessEth1DataVoteWithoutVotingPeriod(t *testing.T) {
+	st := &testEth1DataState{}
+	vote := testEth1Data{root: common.Root{0x02}}
+
+	require.NoError(t, processEth1DataVote(st, vote, 0))
+	require.Empty(t, st.votes)
+	require.Equal(t, testEth1Data{}, st.eth1Data)
+	require.NoError(t, resetEth1DataVotes(st, 0, 0))
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// InitiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+// The validator exits in the earliest epoch an exit initiated in the current
+// epoch may take effect, unless validators exit later already. As no more
+// than the churn limit of validators may exit per epoch, the validator exits
+// one epoch after the last exit epoch once that epoch is full. It is a no-op
+// for validators that have already initiated an exit.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) InitiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	return sp.initiateValidatorExit(st, idx, nil)
+}
+
+// initiateValidatorExit initiates the exit of the validator at the given
+// index as in InitiateValidatorExit, recording the status change in the given
+// audit log.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	audit *auditLog,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// compute_activation_exit_epoch of the current epoch.
+	exitQueueEpoch := sp.cs.SlotToEpoch(slot) + 1 +
+		math.Epoch(sp.cs.MinActivationDelay())
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	for _, v := range vals {
+		if exitEpoch := v.GetExitEpoch(); exitEpoch !=
+			math.Epoch(constants.FarFutureEpoch) {
+			exitQueueEpoch = max(exitQueueEpoch, exitEpoch)
+		}
+	}
+
+	var exitQueueChurn uint64
+	for _, v := range vals {
+		if v.GetExitEpoch() == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+
+	churnLimit, err := st.ValidatorChurnLimit()
+	if err != nil {
+		return err
+	}
+	if exitQueueChurn >= churnLimit {
+		exitQueueEpoch++
+	}
+
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return err
+	}
+	audit.record(AuditOpValidatorStatus, idx, 0)
+	return nil
+}
+
+// processValidatorUpdatesLimit ensures the validator updates of the epoch,
+// which announce the voting power of each validator in the registry for the
+// next epoch, stay within the maximum the churn allows, from the Electra fork
+// onwards. Validators join the set through the deposits activating them, at
+// most once each, and leave it through the exits queued by the churn limit,
+// hence a larger update set signals a logic error, which must fail the block
+// rather than reach CometBFT as an oversized update set.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processValidatorUpdatesLimit(
+	st BeaconStateT,
+	updates transition.ValidatorUpdates,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	if sp.cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	if len(updates) > len(vals) {
+		return errors.Wrapf(
+			ErrTooManyValidatorUpdates,
+			"%d validator updates for %d validators",
+			len(updates), len(vals),
+		)
+	}
+
+	// The churn limit is recomputed from the validators in the set now,
+	// whereas the exits were queued under the churn limit of the epoch they
+	// were initiated in. As the registry never shrinks, the churn limit of
+	// the whole registry bounds both.
+	churnLimit, err := st.ValidatorChurnLimit()
+	if err != nil {
+		return err
+	}
+	if quotient := sp.cs.ChurnLimitQuotient(); quotient > 0 {
+		churnLimit = max(churnLimit, uint64(len(vals))/quotient)
+	}
+
+	byPubkey := make(map[crypto.BLSPubkey]ValidatorT, len(vals))
+	for _, v := range vals {
+		byPubkey[v.GetPubkey()] = v
+	}
+
+	var exits uint64
+	for _, update := range updates {
+		v, found := byPubkey[update.Pubkey]
+		if found && v.IsInValidatorSet(epoch) &&
+			!v.IsInValidatorSet(epoch+1) {
+			exits++
+		}
+	}
+	if exits > churnLimit {
+		return errors.Wrapf(
+			ErrTooManyValidatorUpdates,
+			"%d validators exit in epoch %d, churn limit: %d",
+			exits, epoch+1, churnLimit,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitiateValidatorExit(t *testing.T) {
+	// At most 2 validators exit per epoch, and exits initiated in epoch 0
+	// take effect in epoch 2 at the earliest.
+	data := testSpecData()
+	data.MinPerEpochChurnLimit = 2
+	data.ChurnLimitQuotient = 1 << 16
+	data.MinActivationDelay = 1
+	data.MinValidatorWithdrawabilityDelay = 4
+	var (
+		cs = chain.NewChainSpec(data)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st = newTestGenesisState(t, cs, sp)
+	)
+	for i := range 7 {
+		require.NoError(t, st.AddValidator(newTestValidator(
+			crypto.BLSPubkey{byte(i + 1)}, common.ExecutionAddress{},
+		)))
+	}
+
+	// More validators than the churn limit exit at once, spreading them
+	// across consecutive epochs.
+	for i := range 5 {
+		require.NoError(t, sp.InitiateValidatorExit(st, math.ValidatorIndex(i)))
+	}
+	// Initiating an exit again leaves it untouched.
+	require.NoError(t, sp.InitiateValidatorExit(st, 0))
+
+	for i, exitEpoch := range []math.Epoch{
+		2, 2, 3, 3, 4,
+		math.Epoch(constants.FarFutureEpoch),
+		math.Epoch(constants.FarFutureEpoch),
+	} {
+		val, err := st.ValidatorByIndex(math.ValidatorIndex(i))
+		require.NoError(t, err)
+		require.Equal(t, exitEpoch, val.GetExitEpoch(), "validator %d", i)
+		if exitEpoch != math.Epoch(constants.FarFutureEpoch) {
+			require.Equal(t, exitEpoch+4, val.GetWithdrawableEpoch())
+		}
+	}
+
+	exits, err := st.ExitQueue()
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0, 1, 2, 3, 4}, exits)
+
+	// The validator updates of the epochs in which the queued validators
+	// exit are within the churn limit.
+	_, err = sp.ProcessSlots(st, 12)
+	require.NoError(t, err)
+
+	// Validators exiting other than through the queue take more than the
+	// churn limit out of the validator set in epoch 4, failing the epoch.
+	for _, idx := range []math.ValidatorIndex{5, 6} {
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		val.SetExitEpoch(4)
+		require.NoError(t, st.UpdateValidatorAtIndex(idx, val))
+	}
+	_, err = sp.ProcessSlots(st, 16)
+	require.ErrorIs(t, err, core.ErrTooManyValidatorUpdates)
+}
+
+func TestValidatorUpdatesLimit(t *testing.T) {
+	// The churn limit of 4 validators spreads the exits of all 8 validators
+	// across epochs 2 and 3, after which the validator set of epoch 2 only
+	// holds the 4 validators exiting in epoch 3, lowering the churn limit
+	// to 2.
+	newState := func(
+		electra math.Epoch,
+	) (*testStateProcessor, *testStateDB) {
+		data := testSpecData()
+		data.MinPerEpochChurnLimit = 1
+		data.ChurnLimitQuotient = 2
+		data.MinActivationDelay = 1
+		data.ElectraForkEpoch = electra
+		var (
+			cs = chain.NewChainSpec(data)
+			sp = newTestStateProcessor(
+				cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+			)
+			st = newTestGenesisState(t, cs, sp)
+		)
+		for i := range 8 {
+			require.NoError(t, st.AddValidator(newTestValidator(
+				crypto.BLSPubkey{byte(i + 1)}, common.ExecutionAddress{},
+			)))
+		}
+		for i := range 8 {
+			require.NoError(
+				t, sp.InitiateValidatorExit(st, math.ValidatorIndex(i)),
+			)
+		}
+		return sp, st
+	}
+
+	// exitEarly moves the exit of validator 0 to epoch 3, taking more than
+	// the churn limit out of the validator set in epoch 3.
+	exitEarly := func(st *testStateDB) {
+		val, err := st.ValidatorByIndex(0)
+		require.NoError(t, err)
+		val.SetExitEpoch(3)
+		require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+	}
+
+	t.Run("exits queued under a higher churn limit", func(t *testing.T) {
+		sp, st := newState(0)
+		_, err := sp.ProcessSlots(st, 12)
+		require.NoError(t, err)
+	})
+
+	t.Run("exits beyond the churn limit", func(t *testing.T) {
+		sp, st := newState(0)
+		exitEarly(st)
+		_, err := sp.ProcessSlots(st, 12)
+		require.ErrorIs(t, err, core.ErrTooManyValidatorUpdates)
+	})
+
+	t.Run("exits beyond the churn limit before Electra", func(t *testing.T) {
+		sp, st := newState(8)
+		exitEarly(st)
+		_, err := sp.ProcessSlots(st, 12)
+		require.NoError(t, err)
+	})
+}
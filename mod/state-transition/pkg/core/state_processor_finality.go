@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// finalityState is the subset of the beacon state used to track the
+// justified and finalized checkpoints.
+type finalityState interface {
+	GetJustifiedCheckpoint() (common.Checkpoint, error)
+	SetJustifiedCheckpoint(common.Checkpoint) error
+	SetFinalizedCheckpoint(common.Checkpoint) error
+}
+
+// processJustificationAndFinalization as defined in the Ethereum 2.0
+// specification, adapted to blocks final as soon as CometBFT commits them.
+// Checkpoints are only tracked from the Electra fork onwards, as
+// participation is recorded. An epoch is justified once validators holding
+// two thirds of the active balance attested to its target, finalizing the
+// checkpoint of the epoch before it.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#justification-and-finalization
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processJustificationAndFinalization(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	epoch := sp.cs.SlotToEpoch(slot)
+	if sp.cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+	if justified, err := isEpochJustified[ValidatorT](
+		st, epoch,
+	); err != nil || !justified {
+		return err
+	}
+
+	// The checkpoint root is the root of the block at the start slot of the
+	// epoch, as recorded by processSlot.
+	root, err := st.GetBlockRootAtIndex(
+		(epoch.Unwrap() * sp.cs.SlotsPerEpoch()) %
+			sp.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return justifyCheckpoint(st, common.Checkpoint{Epoch: epoch, Root: root})
+}
+
+// justifyCheckpoint makes the checkpoint the current justified checkpoint.
+// As per the two epoch finality rule, the previously justified checkpoint is
+// finalized if it is the checkpoint of the epoch preceding it.
+func justifyCheckpoint(
+	st finalityState,
+	checkpoint common.Checkpoint,
+) error {
+	justified, err := st.GetJustifiedCheckpoint()
+	if err != nil {
+		return err
+	}
+
+	if justified.Epoch+1 == checkpoint.Epoch {
+		if err = st.SetFinalizedCheckpoint(justified); err != nil {
+			return err
+		}
+	}
+	return st.SetJustifiedCheckpoint(checkpoint)
+}
+
+// isEpochJustified returns whether the current epoch of the state is
+// justified, i.e. validators holding two thirds of the active balance
+// attested to its target.
+func isEpochJustified[ValidatorT inactivityValidator](
+	st participationState[ValidatorT],
+	epoch math.Epoch,
+) (bool, error) {
+	total, attesting, err := participationBalances(
+		st, epoch, st.GetCurrentEpochParticipation,
+	)
+	if err != nil {
+		return false, err
+	}
+	return total != 0 && 3*attesting[TimelyTargetFlagIndex] >= 2*total, nil
+}
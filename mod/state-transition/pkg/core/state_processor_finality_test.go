@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testFinalityState is an in-memory finality state.
+type testFinalityState struct {
+	justified common.Checkpoint
+	finalized common.Checkpoint
+}
+
+func (s *testFinalityState) GetJustifiedCheckpoint() (
+	common.Checkpoint, error,
+) {
+	return s.justified, nil
+}
+
+func (s *testFinalityState) SetJustifiedCheckpoint(
+	checkpoint common.Checkpoint,
+) error {
+	s.justified = checkpoint
+	return nil
+}
+
+func (s *testFinalityState) SetFinalizedCheckpoint(
+	checkpoint common.Checkpoint,
+) error {
+	s.finalized = checkpoint
+	return nil
+}
+
+func TestJustifyCheckpoint(t *testing.T) {
+	var (
+		st          = &testFinalityState{}
+		checkpoints = []common.Checkpoint{
+			{Epoch: 0, Root: common.Root{0x01}},
+			{Epoch: 1, Root: common.Root{0x02}},
+			{Epoch: 2, Root: common.Root{0x03}},
+			{Epoch: 5, Root: common.Root{0x04}},
+			{Epoch: 6, Root: common.Root{0x05}},
+		}
+	)
+
+	// The genesis epoch is justified, but nothing precedes it to finalize.
+	require.NoError(t, justifyCheckpoint(st, checkpoints[0]))
+	require.Equal(t, checkpoints[0], st.justified)
+	require.Equal(t, common.Checkpoint{}, st.finalized)
+
+	// Each following epoch finalizes the one justified before it.
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, justifyCheckpoint(st, checkpoints[i]))
+		require.Equal(t, checkpoints[i], st.justified)
+		require.Equal(t, checkpoints[i-1], st.finalized)
+	}
+
+	// Justifying after a gap does not finalize the stale checkpoint.
+	require.NoError(t, justifyCheckpoint(st, checkpoints[3]))
+	require.Equal(t, checkpoints[3], st.justified)
+	require.Equal(t, checkpoints[1], st.finalized)
+
+	// Finality resumes with the next consecutive epoch.
+	require.NoError(t, justifyCheckpoint(st, checkpoints[4]))
+	require.Equal(t, checkpoints[4], st.justified)
+	require.Equal(t, checkpoints[3], st.finalized)
+}
+
+func TestIsEpochJustified(t *testing.T) {
+	st := &testParticipationState{
+		testInactivityState: testInactivityState{
+			slot: 2,
+			validators: []testInactivityValidator{
+				{balance: 32}, {balance: 32}, {balance: 32},
+			},
+		},
+		current: map[math.ValidatorIndex]byte{
+			0: 1 << TimelyTargetFlagIndex,
+		},
+	}
+
+	// A third of the balance does not justify the epoch.
+	justified, err := isEpochJustified(st, 2)
+	require.NoError(t, err)
+	require.False(t, justified)
+
+	// Two thirds of it do.
+	st.current[2] = 1<<TimelyTargetFlagIndex | 1<<TimelySourceFlagIndex
+	justified, err = isEpochJustified(st, 2)
+	require.NoError(t, err)
+	require.True(t, justified)
+}
@@ -21,12 +21,15 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"golang.org/x/sync/errgroup"
 )
 
 //nolint:lll // temporary.
@@ -53,6 +56,10 @@ func (sp *StateProcessor[
 		fork      ForkT
 		eth1Data  Eth1DataT
 	)
+	if err := sp.validateGenesisVersion(genesisVersion); err != nil {
+		return nil, err
+	}
+
 	fork = fork.New(
 		genesisVersion,
 		genesisVersion,
@@ -98,10 +105,8 @@ func (sp *StateProcessor[
 		}
 	}
 
-	for _, deposit := range deposits {
-		if err := sp.processDeposit(st, deposit); err != nil {
-			return nil, err
-		}
+	if err := sp.processGenesisDeposits(st, deposits); err != nil {
+		return nil, err
 	}
 
 	// TODO: process activations.
@@ -159,3 +164,149 @@ func (sp *StateProcessor[
 	}
 	return updates, nil
 }
+
+// validateGenesisVersion verifies, if so configured, that the genesis fork
+// version is the one the chain spec activates at the genesis epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) validateGenesisVersion(genesisVersion common.Version) error {
+	if !sp.cfg.ValidateGenesisForkVersion {
+		return nil
+	}
+	expected := version.FromUint32[common.Version](
+		sp.cs.ActiveForkVersionForEpoch(math.Epoch(constants.GenesisEpoch)),
+	)
+	if genesisVersion != expected {
+		return errors.Wrapf(
+			ErrGenesisForkVersionMismatch,
+			"genesis fork version: %s, expected: %s",
+			genesisVersion, expected,
+		)
+	}
+	return nil
+}
+
+// processGenesisDeposits processes the genesis deposits. Deposits for a
+// public key seen before are applied as top-ups of its validator, unless
+// configured to reject them. If configured with more than one worker, the
+// signatures of the deposits creating validators are verified concurrently
+// before the deposits are applied in order, so the resulting validator set
+// does not depend on the number of workers. Genesis deposits are not audited
+// as they are not part of a block.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) processGenesisDeposits(
+	st BeaconStateT,
+	deposits []DepositT,
+) error {
+	if sp.cfg.RejectDuplicateGenesisPubkeys {
+		if err := validateUniquePubkeys(deposits); err != nil {
+			return err
+		}
+	}
+
+	if sp.cfg.GenesisVerificationWorkers <= 1 {
+		return sp.processDeposits(st, deposits, sp.signer.VerifySignature, nil)
+	}
+
+	forkData, domain, err := sp.depositForkData(st)
+	if err != nil {
+		return err
+	}
+
+	// Only the signatures of deposits creating a validator are verified,
+	// those of the top-ups that follow them are not.
+	creating := newValidatorDeposits(deposits)
+	failed, err := verifyConcurrently(
+		len(creating), sp.cfg.GenesisVerificationWorkers,
+		func(i int) error {
+			return deposits[creating[i]].VerifySignature(
+				forkData, domain, sp.signer.VerifySignature,
+			)
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err, "genesis deposit %d", deposits[creating[failed]].GetIndex(),
+		)
+	}
+
+	// Every signature checked while applying the deposits is now known to be
+	// valid.
+	return sp.processDeposits(st, deposits, verifiedSignature, nil)
+}
+
+// newValidatorDeposits returns the positions of the deposits that create a
+// validator when applied to an empty registry, i.e. the first deposit of
+// every public key.
+func newValidatorDeposits[
+	DepositT interface{ GetPubkey() crypto.BLSPubkey },
+](deposits []DepositT) []int {
+	var (
+		seen     = make(map[crypto.BLSPubkey]struct{}, len(deposits))
+		creating = make([]int, 0, len(deposits))
+	)
+	for i, dep := range deposits {
+		if _, ok := seen[dep.GetPubkey()]; ok {
+			continue
+		}
+		seen[dep.GetPubkey()] = struct{}{}
+		creating = append(creating, i)
+	}
+	return creating
+}
+
+// validateUniquePubkeys ensures that no two of the given deposits are for the
+// same public key.
+func validateUniquePubkeys[DepositT interface {
+	GetPubkey() crypto.BLSPubkey
+	GetIndex() math.U64
+}](deposits []DepositT) error {
+	seen := make(map[crypto.BLSPubkey]math.U64, len(deposits))
+	for _, dep := range deposits {
+		if index, ok := seen[dep.GetPubkey()]; ok {
+			return errors.Wrapf(
+				ErrDuplicateGenesisPubkey,
+				"genesis deposits %d and %d for pubkey %s",
+				index, dep.GetIndex(), dep.GetPubkey(),
+			)
+		}
+		seen[dep.GetPubkey()] = dep.GetIndex()
+	}
+	return nil
+}
+
+// verifyConcurrently runs verify for every index in [0, n) on up to workers
+// goroutines. If any of them fails, the lowest failing index is returned
+// along with its error.
+func verifyConcurrently(
+	n, workers int, verify func(int) error,
+) (int, error) {
+	var (
+		errs = make([]error, n)
+		g    errgroup.Group
+	)
+	g.SetLimit(workers)
+	for i := range n {
+		g.Go(func() error {
+			errs[i] = verify(i)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return i, err
+		}
+	}
+	return 0, nil
+}
+
+// verifiedSignature is a signatureVerifier for signatures that have already
+// been verified.
+func verifiedSignature(
+	crypto.BLSPubkey, []byte, crypto.BLSSignature,
+) error {
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisForkVersion(t *testing.T) {
+	genesis := func(
+		cs common.ChainSpec, validate bool, forkVersion uint32,
+	) error {
+		_, err := newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{
+				cfg: core.Config{ValidateGenesisForkVersion: validate},
+			},
+		).InitializePreminedBeaconStateFromEth1(
+			newTestStateDB(t, cs),
+			nil,
+			(&types.ExecutionPayloadHeader{}).Empty(),
+			version.FromUint32[common.Version](forkVersion),
+		)
+		return err
+	}
+
+	// The chain spec activates Deneb at the genesis epoch.
+	data := testSpecData()
+	data.DenebPlusForkEpoch = 1
+	data.ElectraForkEpoch = 2
+	cs := chain.NewChainSpec(data)
+	require.NoError(t, genesis(cs, true, version.Deneb))
+	require.ErrorIs(
+		t, genesis(cs, true, version.DenebPlus),
+		core.ErrGenesisForkVersionMismatch,
+	)
+
+	// The fork version is not validated unless so configured, the test
+	// chain spec activating Electra at the genesis epoch.
+	cs = testChainSpec()
+	require.ErrorIs(
+		t, genesis(cs, true, version.Deneb),
+		core.ErrGenesisForkVersionMismatch,
+	)
+	require.NoError(t, genesis(cs, false, version.Deneb))
+}
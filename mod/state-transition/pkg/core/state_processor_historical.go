@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// historicalSummariesState is the subset of the beacon state used to
+// accumulate the historical summaries.
+type historicalSummariesState interface {
+	GetSlot() (math.Slot, error)
+	GetBlockRootAtIndex(uint64) (common.Root, error)
+	StateRootAtIndex(uint64) (common.Root, error)
+	GetHistoricalSummaries() ([]common.HistoricalSummary, error)
+	AppendHistoricalSummary(common.HistoricalSummary) error
+}
+
+// processHistoricalSummariesUpdate as defined in the Ethereum 2.0
+// specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#historical-summaries-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processHistoricalSummariesUpdate(
+	st BeaconStateT,
+) error {
+	return appendHistoricalSummary(st, sp.cs)
+}
+
+// appendHistoricalSummary appends the summary of the block and state roots
+// once the epoch being processed completes a historical roots period. The
+// summaries are part of the state from the Electra fork onwards, and are
+// bounded by HistoricalRootsLimit.
+func appendHistoricalSummary(
+	st historicalSummariesState,
+	cs common.ChainSpec,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	epoch := cs.SlotToEpoch(slot)
+	if cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+	nextEpoch := epoch.Unwrap() + 1
+	if (nextEpoch*cs.SlotsPerEpoch())%cs.SlotsPerHistoricalRoot() != 0 {
+		return nil
+	}
+
+	summaries, err := st.GetHistoricalSummaries()
+	if err != nil {
+		return err
+	}
+	if uint64(len(summaries)) >= cs.HistoricalRootsLimit() {
+		return errors.Wrapf(
+			ErrHistoricalSummariesFull, "limit: %d", cs.HistoricalRootsLimit(),
+		)
+	}
+
+	blockSummaryRoot, err := historicalRootsRoot(
+		st.GetBlockRootAtIndex, cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return err
+	}
+
+	stateSummaryRoot, err := historicalRootsRoot(
+		st.StateRootAtIndex, cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return st.AppendHistoricalSummary(common.HistoricalSummary{
+		BlockSummaryRoot: blockSummaryRoot,
+		StateSummaryRoot: stateSummaryRoot,
+	})
+}
+
+// historicalRootsRoot computes the hash tree root of a vector of historical
+// roots of the given length.
+func historicalRootsRoot(
+	rootAtIndex func(uint64) (common.Root, error),
+	length uint64,
+) (common.Root, error) {
+	var (
+		err   error
+		roots = make([]common.Root, length)
+	)
+	for i := range length {
+		if roots[i], err = rootAtIndex(i); err != nil {
+			return common.Root{}, err
+		}
+	}
+
+	tree, err := merkle.NewTreeWithMaxLeaves(roots, length)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.Root(), nil
+}
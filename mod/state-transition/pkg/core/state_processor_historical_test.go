@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testHistoricalState is an in-memory historical summaries state.
+type testHistoricalState struct {
+	slot       math.Slot
+	blockRoots []common.Root
+	stateRoots []common.Root
+	summaries  []common.HistoricalSummary
+}
+
+func (s *testHistoricalState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testHistoricalState) GetBlockRootAtIndex(
+	index uint64,
+) (common.Root, error) {
+	return s.blockRoots[index], nil
+}
+
+func (s *testHistoricalState) StateRootAtIndex(
+	index uint64,
+) (common.Root, error) {
+	return s.stateRoots[index], nil
+}
+
+func (s *testHistoricalState) GetHistoricalSummaries() (
+	[]common.HistoricalSummary, error,
+) {
+	return s.summaries, nil
+}
+
+func (s *testHistoricalState) AppendHistoricalSummary(
+	summary common.HistoricalSummary,
+) error {
+	s.summaries = append(s.summaries, summary)
+	return nil
+}
+
+func TestAppendHistoricalSummary(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch:          2,
+				SlotsPerHistoricalRoot: 4,
+				HistoricalRootsLimit:   2,
+				DenebPlusForkEpoch:     2,
+				ElectraForkEpoch:       2,
+			},
+		)
+		st = &testHistoricalState{
+			blockRoots: []common.Root{{0x01}, {0x02}, {0x03}, {0x04}},
+			stateRoots: []common.Root{{0x05}, {0x06}, {0x07}, {0x08}},
+		}
+	)
+
+	// vectorRoot is the hash tree root of a vector of four roots.
+	vectorRoot := func(roots []common.Root) common.Root {
+		left := sha256.Hash(append(roots[0][:], roots[1][:]...))
+		right := sha256.Hash(append(roots[2][:], roots[3][:]...))
+		return sha256.Hash(append(left[:], right[:]...))
+	}
+
+	// Epoch 0 does not end a historical roots period, epoch 1 ends the
+	// first period of 4 slots, but before the Electra fork.
+	st.slot = 1
+	require.NoError(t, appendHistoricalSummary(st, cs))
+	st.slot = 3
+	require.NoError(t, appendHistoricalSummary(st, cs))
+	require.Empty(t, st.summaries)
+
+	// Epoch 2 does not, epoch 3 ends the second period.
+	st.slot = 4
+	require.NoError(t, appendHistoricalSummary(st, cs))
+	require.Empty(t, st.summaries)
+	st.slot = 7
+	require.NoError(t, appendHistoricalSummary(st, cs))
+	require.Equal(t, []common.HistoricalSummary{{
+		BlockSummaryRoot: vectorRoot(st.blockRoots),
+		StateSummaryRoot: vectorRoot(st.stateRoots),
+	}}, st.summaries)
+
+	// Epoch 5 ends the third period, after which the summaries are full.
+	st.slot = 11
+	require.NoError(t, appendHistoricalSummary(st, cs))
+	require.Len(t, st.summaries, 2)
+	st.slot = 15
+	require.ErrorIs(
+		t, appendHistoricalSummary(st, cs), ErrHistoricalSummariesFull,
+	)
+	require.Len(t, st.summaries, 2)
+}
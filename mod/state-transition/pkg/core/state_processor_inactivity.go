@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// inactivityValidator is a validator as accessed by the inactivity leak.
+type inactivityValidator interface {
+	IsInValidatorSet(math.Epoch) bool
+	IsSlashed() bool
+	GetWithdrawableEpoch() math.Epoch
+	GetEffectiveBalance() math.Gwei
+}
+
+// inactivityState is the subset of the beacon state used to track the
+// inactivity scores of the validators.
+type inactivityState[ValidatorT inactivityValidator] interface {
+	GetSlot() (math.Slot, error)
+	GetFinalizedCheckpoint() (common.Checkpoint, error)
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
+	SetInactivityScore(math.ValidatorIndex, uint64) error
+	GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+}
+
+// processInactivityUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#inactivity-scores
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processInactivityUpdates(
+	st BeaconStateT,
+) error {
+	return updateInactivityScores[ValidatorT](st, sp.cs)
+}
+
+// updateInactivityScores updates the inactivity scores of the validators
+// eligible for the previous epoch. Validators which attested to its target
+// see their score decrease, the others accrue the inactivity score bias.
+// Outside of an inactivity leak every score also recovers, such that scores
+// only build up while finality lags and return to zero once it resumes.
+func updateInactivityScores[ValidatorT inactivityValidator](
+	st inactivityState[ValidatorT],
+	cs common.ChainSpec,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	epoch := cs.SlotToEpoch(slot)
+	if epoch == math.Epoch(constants.GenesisEpoch) ||
+		!inactivityLeakEnabled(cs, epoch) {
+		return nil
+	}
+
+	previous := epoch - 1
+	leaking, err := isInInactivityLeak(st, cs, previous)
+	if err != nil {
+		return err
+	}
+
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	for i := range totalValidators {
+		idx := math.ValidatorIndex(i)
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return err
+		}
+		if !isEligibleValidator(val, previous) {
+			continue
+		}
+
+		score, err := st.GetInactivityScore(idx)
+		if err != nil {
+			return err
+		}
+		flags, err := st.GetPreviousEpochParticipation(idx)
+		if err != nil {
+			return err
+		}
+		if !val.IsSlashed() && flags&(1<<TimelyTargetFlagIndex) != 0 {
+			score -= min(1, score)
+		} else {
+			score += cs.InactivityScoreBias()
+		}
+		if !leaking {
+			score -= min(cs.InactivityScoreRecoveryRate(), score)
+		}
+		if err = st.SetInactivityScore(idx, score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inactivityLeakEnabled returns whether the inactivity leak applies in the
+// given epoch. It tracks participation, hence applies from the Electra fork
+// onwards, unless disabled by a zero score bias or penalty quotient.
+func inactivityLeakEnabled(cs common.ChainSpec, epoch math.Epoch) bool {
+	return cs.ActiveForkVersionForEpoch(epoch) >= version.Electra &&
+		cs.InactivityScoreBias() > 0 && cs.InactivityPenaltyQuotient() > 0
+}
+
+// isInInactivityLeak returns whether the finalized checkpoint lags the given
+// previous epoch by more than MinEpochsToInactivityPenalty epochs. As
+// checkpoints are only tracked from the Electra fork onwards, the lag is not
+// counted from before the fork.
+func isInInactivityLeak(
+	st interface {
+		GetFinalizedCheckpoint() (common.Checkpoint, error)
+	},
+	cs common.ChainSpec,
+	previous math.Epoch,
+) (bool, error) {
+	finalized, err := st.GetFinalizedCheckpoint()
+	if err != nil {
+		return false, err
+	}
+	since := max(finalized.Epoch, cs.ElectraForkEpoch())
+	return since < previous &&
+		previous-since > math.Epoch(cs.MinEpochsToInactivityPenalty()), nil
+}
+
+// isEligibleValidator returns whether the validator is eligible for rewards
+// and penalties in the given previous epoch.
+func isEligibleValidator[ValidatorT inactivityValidator](
+	val ValidatorT,
+	previous math.Epoch,
+) bool {
+	return val.IsInValidatorSet(previous) ||
+		(val.IsSlashed() && previous+1 < val.GetWithdrawableEpoch())
+}
+
+// inactivityPenalty returns the penalty of a validator with the given
+// inactivity score.
+func inactivityPenalty[ValidatorT inactivityValidator](
+	val ValidatorT,
+	score uint64,
+	cs common.ChainSpec,
+) math.Gwei {
+	return val.GetEffectiveBalance() * math.Gwei(score) / math.Gwei(
+		cs.InactivityScoreBias()*cs.InactivityPenaltyQuotient(),
+	)
+}
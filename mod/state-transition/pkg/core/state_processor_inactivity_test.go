@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testInactivityValidator is a validator that is always active unless
+// slashed.
+type testInactivityValidator struct {
+	balance      math.Gwei
+	slashed      bool
+	withdrawable math.Epoch
+}
+
+func (v testInactivityValidator) IsInValidatorSet(math.Epoch) bool {
+	return !v.slashed
+}
+
+func (v testInactivityValidator) IsSlashed() bool {
+	return v.slashed
+}
+
+func (v testInactivityValidator) GetWithdrawableEpoch() math.Epoch {
+	return v.withdrawable
+}
+
+func (v testInactivityValidator) GetEffectiveBalance() math.Gwei {
+	return v.balance
+}
+
+// testInactivityState is an in-memory inactivity scores state.
+type testInactivityState struct {
+	slot          math.Slot
+	finalized     math.Epoch
+	validators    []testInactivityValidator
+	scores        map[math.ValidatorIndex]uint64
+	participation map[math.ValidatorIndex]byte
+}
+
+func (s *testInactivityState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testInactivityState) GetFinalizedCheckpoint() (
+	common.Checkpoint, error,
+) {
+	return common.Checkpoint{Epoch: s.finalized}, nil
+}
+
+func (s *testInactivityState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *testInactivityState) ValidatorByIndex(
+	idx math.ValidatorIndex,
+) (testInactivityValidator, error) {
+	return s.validators[idx], nil
+}
+
+func (s *testInactivityState) GetInactivityScore(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	return s.scores[idx], nil
+}
+
+func (s *testInactivityState) SetInactivityScore(
+	idx math.ValidatorIndex,
+	score uint64,
+) error {
+	if s.scores == nil {
+		s.scores = make(map[math.ValidatorIndex]uint64)
+	}
+	s.scores[idx] = score
+	return nil
+}
+
+func (s *testInactivityState) GetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return s.participation[idx], nil
+}
+
+// testInactivitySpec returns a chain spec with one slot per epoch, such that
+// slots and epochs coincide, activating the Electra fork at the given epoch.
+func testInactivitySpec(
+	bias, quotient uint64,
+	electra math.Epoch,
+) common.ChainSpec {
+	return chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:                1,
+			DenebPlusForkEpoch:           electra,
+			ElectraForkEpoch:             electra,
+			MinEpochsToInactivityPenalty: 4,
+			InactivityPenaltyQuotient:    quotient,
+			InactivityScoreBias:          bias,
+			InactivityScoreRecoveryRate:  16,
+		},
+	)
+}
+
+func TestUpdateInactivityScores(t *testing.T) {
+	var (
+		cs = testInactivitySpec(4, 1<<10, 0)
+		st = &testInactivityState{
+			validators: []testInactivityValidator{
+				{balance: 32e9},
+				// Slashed and already withdrawable, hence not eligible.
+				{balance: 32e9, slashed: true},
+				// Attests to the target in every epoch.
+				{balance: 32e9},
+			},
+			scores: map[math.ValidatorIndex]uint64{2: 3},
+			participation: map[math.ValidatorIndex]byte{
+				2: 1 << TimelyTargetFlagIndex,
+			},
+		}
+	)
+
+	// Advance epochs without finalizing anything past genesis.
+	for epoch := range math.Slot(11) {
+		st.slot = epoch
+		require.NoError(t, updateInactivityScores(st, cs))
+	}
+
+	// The leak starts once the previous epoch lags finality by more than
+	// 4 epochs, i.e. from epoch 6. Before, the recovery rate offsets the
+	// bias. Epochs 6 through 10 each add 4 to the score.
+	require.Equal(t, uint64(20), st.scores[0])
+	require.Zero(t, st.scores[1])
+	// Attesting to the target lowers the score instead.
+	require.Zero(t, st.scores[2])
+
+	breakdowns, err := epochRewardBreakdowns(st, cs, 10)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9*20/(4<<10)), breakdowns[0].Penalty())
+	require.Zero(t, breakdowns[1].Penalty())
+
+	// Once finality resumes the score recovers by 12 every epoch.
+	st.finalized = 10
+	st.slot = 11
+	require.NoError(t, updateInactivityScores(st, cs))
+	require.Equal(t, uint64(8), st.scores[0])
+	st.slot = 12
+	require.NoError(t, updateInactivityScores(st, cs))
+	require.Zero(t, st.scores[0])
+
+	breakdowns, err = epochRewardBreakdowns(st, cs, 12)
+	require.NoError(t, err)
+	require.Zero(t, breakdowns[0].Penalty())
+}
+
+func TestUpdateInactivityScoresDisabled(t *testing.T) {
+	for _, cs := range []common.ChainSpec{
+		testInactivitySpec(0, 1<<10, 0),
+		testInactivitySpec(4, 0, 0),
+		// Scores are only tracked from the Electra fork onwards.
+		testInactivitySpec(4, 1<<10, 11),
+	} {
+		st := &testInactivityState{
+			slot:       10,
+			validators: []testInactivityValidator{{balance: 32e9}},
+			scores:     map[math.ValidatorIndex]uint64{0: 20},
+		}
+		require.NoError(t, updateInactivityScores(st, cs))
+		require.Equal(t, uint64(20), st.scores[0])
+
+		breakdowns, err := epochRewardBreakdowns(st, cs, 10)
+		require.NoError(t, err)
+		require.Zero(t, breakdowns[0].Penalty())
+	}
+}
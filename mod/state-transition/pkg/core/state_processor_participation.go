@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Participation flag indices as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#participation-flag-indices
+//
+//nolint:lll
+const (
+	TimelySourceFlagIndex uint8 = iota
+	TimelyTargetFlagIndex
+	TimelyHeadFlagIndex
+)
+
+// validatorsState is the subset of the beacon state used to iterate over the
+// validators.
+type validatorsState[ValidatorT inactivityValidator] interface {
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+}
+
+// participationState is the subset of the beacon state used to compute the
+// participation of an epoch.
+type participationState[ValidatorT inactivityValidator] interface {
+	GetSlot() (math.Slot, error)
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+	GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+	GetCurrentEpochParticipation(math.ValidatorIndex) (byte, error)
+}
+
+// ParticipationRate returns, for each of the timely source, target and head
+// flags, the effective balance of the unslashed validators attesting with
+// the flag over the total effective balance of the validators active in the
+// given epoch. The epoch must be the current or previous epoch of the state.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) ParticipationRate(
+	st BeaconStateT,
+	epoch math.Epoch,
+) (float64, float64, float64, error) {
+	return participationRate[ValidatorT](st, sp.cs, epoch)
+}
+
+// participationRate computes the timely source, target and head
+// participation rates of an epoch.
+//
+// Participation is recorded by attestation processing from the Electra fork
+// onwards; an epoch without any recorded participation has rates of zero.
+func participationRate[ValidatorT inactivityValidator](
+	st participationState[ValidatorT],
+	cs common.ChainSpec,
+	epoch math.Epoch,
+) (float64, float64, float64, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	getParticipation := st.GetCurrentEpochParticipation
+	current := cs.SlotToEpoch(slot)
+	switch {
+	case epoch == current:
+	case epoch+1 == current:
+		getParticipation = st.GetPreviousEpochParticipation
+	default:
+		return 0, 0, 0, errors.Wrapf(
+			ErrEpochParticipationUnavailable, "requested: %d, current: %d",
+			epoch, current,
+		)
+	}
+
+	total, attesting, err := participationBalances(st, epoch, getParticipation)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if total == 0 {
+		return 0, 0, 0, ErrNoActiveValidators
+	}
+
+	return float64(attesting[TimelySourceFlagIndex]) / float64(total),
+		float64(attesting[TimelyTargetFlagIndex]) / float64(total),
+		float64(attesting[TimelyHeadFlagIndex]) / float64(total),
+		nil
+}
+
+// participationBalances returns the total effective balance of the
+// validators active in the epoch and, for each flag, that of the unslashed
+// ones attesting with it as per the given participation.
+func participationBalances[ValidatorT inactivityValidator](
+	st validatorsState[ValidatorT],
+	epoch math.Epoch,
+	getParticipation func(math.ValidatorIndex) (byte, error),
+) (math.Gwei, [TimelyHeadFlagIndex + 1]math.Gwei, error) {
+	var (
+		total     math.Gwei
+		attesting [TimelyHeadFlagIndex + 1]math.Gwei
+	)
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return 0, attesting, err
+	}
+
+	for i := range totalValidators {
+		idx := math.ValidatorIndex(i)
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return 0, attesting, err
+		}
+		if !val.IsInValidatorSet(epoch) {
+			continue
+		}
+		total += val.GetEffectiveBalance()
+		if val.IsSlashed() {
+			continue
+		}
+
+		flags, err := getParticipation(idx)
+		if err != nil {
+			return 0, attesting, err
+		}
+		for flag := range attesting {
+			if flags&(1<<flag) != 0 {
+				attesting[flag] += val.GetEffectiveBalance()
+			}
+		}
+	}
+	return total, attesting, nil
+}
+
+// processParticipationFlagUpdates as defined in the Ethereum 2.0
+// specification. As they are only recorded from the Electra fork onwards,
+// the flags are only rotated from then.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#participation-flags-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processParticipationFlagUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if sp.cs.ActiveForkVersionForEpoch(
+		sp.cs.SlotToEpoch(slot),
+	) < version.Electra {
+		return nil
+	}
+	return st.RotateEpochParticipation()
+}
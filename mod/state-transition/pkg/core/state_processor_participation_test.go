@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testParticipationState is an in-memory epoch participation state.
+type testParticipationState struct {
+	testInactivityState
+	previous map[math.ValidatorIndex]byte
+	current  map[math.ValidatorIndex]byte
+}
+
+func (s *testParticipationState) GetPreviousEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return s.previous[idx], nil
+}
+
+func (s *testParticipationState) GetCurrentEpochParticipation(
+	idx math.ValidatorIndex,
+) (byte, error) {
+	return s.current[idx], nil
+}
+
+func TestParticipationRate(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch: 4,
+			},
+		)
+		st = &testParticipationState{
+			testInactivityState: testInactivityState{
+				slot: 9,
+				validators: []testInactivityValidator{
+					{balance: 32},
+					{balance: 32},
+					{balance: 16},
+					{balance: 16},
+					// Slashed validators are neither active nor attesting.
+					{balance: 32, slashed: true},
+				},
+			},
+			previous: map[math.ValidatorIndex]byte{
+				0: 0b001,
+				4: 0b111,
+			},
+			current: map[math.ValidatorIndex]byte{
+				0: 0b111,
+				1: 0b011,
+				2: 0b001,
+				4: 0b111,
+			},
+		}
+	)
+
+	// The current epoch has 80 of 96 attesting to the source, 64 to the
+	// target and 32 to the head.
+	source, target, head, err := participationRate(st, cs, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 5.0/6, source, 1e-9)
+	require.InDelta(t, 2.0/3, target, 1e-9)
+	require.InDelta(t, 1.0/3, head, 1e-9)
+
+	// The previous epoch has 32 of 96 attesting to the source only.
+	source, target, head, err = participationRate(st, cs, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0/3, source, 1e-9)
+	require.Zero(t, target)
+	require.Zero(t, head)
+
+	// Only the current and previous epochs of the state can be computed.
+	_, _, _, err = participationRate(st, cs, 0)
+	require.ErrorIs(t, err, ErrEpochParticipationUnavailable)
+	_, _, _, err = participationRate(st, cs, 3)
+	require.ErrorIs(t, err, ErrEpochParticipationUnavailable)
+
+	// Without active validators there is no rate.
+	st.validators = nil
+	_, _, _, err = participationRate(st, cs, 2)
+	require.ErrorIs(t, err, ErrNoActiveValidators)
+}
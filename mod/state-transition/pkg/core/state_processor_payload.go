@@ -25,6 +25,8 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -121,6 +123,23 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// Verify the blob gas used accounts for exactly the blobs in the block.
+	return validateBlobGasUsed(
+		payload.GetBlobGasUsed(), len(blobKzgCommitments),
+	)
+}
+
+// validateBlobGasUsed ensures that the blob gas used by the payload is the
+// gas consumed by the given number of blobs.
+func validateBlobGasUsed(blobGasUsed math.U64, numBlobs int) error {
+	//#nosec:G701 // the number of blobs is bounded by MaxBlobsPerBlock.
+	expected := uint64(numBlobs) * constants.GasPerBlob
+	if blobGasUsed.Unwrap() != expected {
+		return errors.Wrapf(
+			ErrBlobGasUsedMismatch,
+			"expected: %d, got: %d", expected, blobGasUsed.Unwrap(),
+		)
+	}
 	return nil
 }
 
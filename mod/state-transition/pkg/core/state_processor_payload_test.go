@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBlobGasUsed(t *testing.T) {
+	tests := []struct {
+		name        string
+		blobGasUsed math.U64
+		numBlobs    int
+		expectErr   error
+	}{
+		{
+			name: "no blobs",
+		},
+		{
+			name:        "consistent blob gas",
+			blobGasUsed: math.U64(3 * constants.GasPerBlob),
+			numBlobs:    3,
+		},
+		{
+			name:        "blob gas without blobs",
+			blobGasUsed: math.U64(constants.GasPerBlob),
+			expectErr:   ErrBlobGasUsedMismatch,
+		},
+		{
+			name:        "blob gas for fewer blobs",
+			blobGasUsed: math.U64(2 * constants.GasPerBlob),
+			numBlobs:    3,
+			expectErr:   ErrBlobGasUsedMismatch,
+		},
+		{
+			name:        "partial blob gas",
+			blobGasUsed: math.U64(constants.GasPerBlob - 1),
+			numBlobs:    1,
+			expectErr:   ErrBlobGasUsedMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlobGasUsed(tt.blobGasUsed, tt.numBlobs)
+			if tt.expectErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
@@ -21,6 +21,7 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -30,7 +31,8 @@ import (
 )
 
 // processRandaoReveal processes the randao reveal and
-// ensures it matches the local state.
+// ensures it matches the local state. The reveal must be signed over the
+// epoch of the block's slot.
 func (sp *StateProcessor[
 	BeaconBlockT, _, _, BeaconStateT,
 	_, _, _, _, _, _, ForkDataT, _, _, _, _, _, _,
@@ -39,11 +41,6 @@ func (sp *StateProcessor[
 	blk BeaconBlockT,
 	skipVerification bool,
 ) error {
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-
 	// Ensure the proposer index is valid.
 	proposer, err := st.ValidatorByIndex(blk.GetProposerIndex())
 	if err != nil {
@@ -55,20 +52,25 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	epoch := sp.cs.SlotToEpoch(slot)
+	epoch := sp.cs.SlotToEpoch(blk.GetSlot())
+	forkVersion := sp.cs.ActiveForkVersionForEpoch(epoch)
 	body := blk.GetBody()
 
 	var fd ForkDataT
 	fd = fd.New(
-		version.FromUint32[common.Version](
-			sp.cs.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
+		version.FromUint32[common.Version](forkVersion), genesisValidatorsRoot,
 	)
 
 	if !skipVerification {
-		signingRoot := fd.ComputeRandaoSigningRoot(
-			sp.cs.DomainTypeRandao(), epoch,
+		var domain common.DomainType
+		domain, err = sp.cs.DomainTypeRegistry().DomainType(
+			chain.DomainNameRandao, forkVersion,
 		)
+		if err != nil {
+			return err
+		}
+
+		signingRoot := fd.ComputeRandaoSigningRoot(domain, epoch)
 		reveal := body.GetRandaoReveal()
 		if err = sp.signer.VerifySignature(
 			proposer.GetPubkey(),
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessRandaoRevealEpoch(t *testing.T) {
+	var (
+		cs = testChainSpec()
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+	)
+	key, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	proposer := &signer.LegacySigner{PrivKey: &key}
+
+	// Build a genesis state with a single validator holding the key.
+	preState := newTestGenesisState(
+		t, cs, sp, newTestValidator(
+			proposer.PublicKey(), common.ExecutionAddress{0x01},
+		),
+	)
+	require.NoError(t, preState.IncreaseBalance(0, 32e9))
+	genesisValidatorsRoot, err := preState.GetGenesisValidatorsRoot()
+	require.NoError(t, err)
+
+	sealed := preState.Copy()
+	_, err = sp.ProcessSlots(sealed, 1)
+	require.NoError(t, err)
+	parent, err := sealed.GetLatestBlockHeader()
+	require.NoError(t, err)
+	withdrawals, err := sealed.ExpectedWithdrawals()
+	require.NoError(t, err)
+
+	// sign returns the reveal of the proposer over the given epoch.
+	sign := func(epoch math.Epoch) crypto.BLSSignature {
+		forkVersion := cs.ActiveForkVersionForEpoch(epoch)
+		domain, err := cs.DomainTypeRegistry().DomainType(
+			chain.DomainNameRandao, forkVersion,
+		)
+		require.NoError(t, err)
+		signingRoot := types.NewForkData(
+			version.FromUint32[common.Version](forkVersion),
+			genesisValidatorsRoot,
+		).ComputeRandaoSigningRoot(domain, epoch)
+		reveal, err := proposer.Sign(signingRoot[:])
+		require.NoError(t, err)
+		return reveal
+	}
+	newBlock := func(reveal crypto.BLSSignature) *types.BeaconBlock {
+		blk, err := (&types.BeaconBlock{}).NewWithVersion(
+			1, 0, parent.HashTreeRoot(), version.Deneb,
+		)
+		require.NoError(t, err)
+		blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		blk.Body.RandaoReveal = reveal
+		blk.Body.ExecutionPayload.Withdrawals = withdrawals
+		return blk
+	}
+	apply := func(
+		blk *types.BeaconBlock, skipValidateRandao bool,
+	) error {
+		_, err := sp.ApplyBlock(&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      skipValidateRandao,
+			SkipValidateResult:      true,
+		}, preState, blk)
+		return err
+	}
+
+	t.Run("correct epoch", func(t *testing.T) {
+		require.NoError(t, apply(newBlock(sign(0)), false))
+	})
+
+	t.Run("off-by-one epoch", func(t *testing.T) {
+		require.Error(t, apply(newBlock(sign(1)), false))
+	})
+
+	t.Run("invalid reveal", func(t *testing.T) {
+		require.Error(t, apply(newBlock(crypto.BLSSignature{0x01}), false))
+	})
+
+	t.Run("skip mode", func(t *testing.T) {
+		require.NoError(t, apply(newBlock(sign(1)), true))
+	})
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Participation flag weights as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#incentivization-weights
+//
+//nolint:lll
+const (
+	timelySourceWeight = 14
+	timelyTargetWeight = 26
+	timelyHeadWeight   = 14
+	weightDenominator  = 64
+)
+
+// participationFlagWeights are the weights of the participation flags,
+// ordered by flag index.
+//
+//nolint:gochecknoglobals // const array.
+var participationFlagWeights = [TimelyHeadFlagIndex + 1]uint64{
+	timelySourceWeight, timelyTargetWeight, timelyHeadWeight,
+}
+
+// RewardBreakdown is the reward and penalty a validator accrues for its
+// participation in an epoch, split by the components of the specification.
+type RewardBreakdown struct {
+	// SourceReward is the reward for a timely source vote.
+	SourceReward math.Gwei
+	// SourcePenalty is the penalty for a missed source vote.
+	SourcePenalty math.Gwei
+	// TargetReward is the reward for a timely target vote.
+	TargetReward math.Gwei
+	// TargetPenalty is the penalty for a missed target vote.
+	TargetPenalty math.Gwei
+	// HeadReward is the reward for a timely head vote.
+	HeadReward math.Gwei
+	// InactivityPenalty is the penalty accrued during an inactivity leak.
+	InactivityPenalty math.Gwei
+}
+
+// Reward returns the sum of the rewards of the breakdown.
+func (b RewardBreakdown) Reward() math.Gwei {
+	return b.SourceReward + b.TargetReward + b.HeadReward
+}
+
+// Penalty returns the sum of the penalties of the breakdown.
+func (b RewardBreakdown) Penalty() math.Gwei {
+	return b.SourcePenalty + b.TargetPenalty + b.InactivityPenalty
+}
+
+// rewardsState is the subset of the beacon state used to compute the
+// rewards and penalties of an epoch.
+type rewardsState[ValidatorT inactivityValidator] interface {
+	GetSlot() (math.Slot, error)
+	GetFinalizedCheckpoint() (common.Checkpoint, error)
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
+	GetPreviousEpochParticipation(math.ValidatorIndex) (byte, error)
+}
+
+// ComputeEpochRewards returns the reward breakdown of every validator for
+// the given epoch, without mutating the state. The breakdown is the one
+// applied by the epoch processing, hence the epoch must be the current
+// epoch of the state.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) ComputeEpochRewards(
+	st BeaconStateT,
+	epoch math.Epoch,
+) (map[math.ValidatorIndex]RewardBreakdown, error) {
+	breakdowns, err := epochRewardBreakdowns[ValidatorT](st, sp.cs, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make(map[math.ValidatorIndex]RewardBreakdown, len(breakdowns))
+	for i, breakdown := range breakdowns {
+		rewards[math.ValidatorIndex(i)] = breakdown
+	}
+	return rewards, nil
+}
+
+// epochRewardBreakdowns returns the reward breakdown of every validator,
+// ordered by validator index, for the current epoch of the state.
+//
+// The source, target and head components follow get_flag_index_deltas of
+// the specification, over the participation recorded in the previous epoch
+// from the Electra fork onwards. They are disabled by a zero base reward
+// factor. Eligible validators are also charged the inactivity penalty of
+// their current inactivity score. The deltas applied by
+// processRewardsAndPenalties are derived from these breakdowns, such that
+// both always agree.
+func epochRewardBreakdowns[ValidatorT inactivityValidator](
+	st rewardsState[ValidatorT],
+	cs common.ChainSpec,
+	epoch math.Epoch,
+) ([]RewardBreakdown, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	if current := cs.SlotToEpoch(slot); epoch != current {
+		return nil, errors.Wrapf(
+			ErrEpochRewardsUnavailable, "requested: %d, current: %d",
+			epoch, current,
+		)
+	}
+
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	breakdowns := make([]RewardBreakdown, totalValidators)
+	if epoch == math.Epoch(constants.GenesisEpoch) ||
+		cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return breakdowns, nil
+	}
+
+	var (
+		previous = epoch - 1
+		deltas   *flagDeltas
+	)
+	if cs.BaseRewardFactor() > 0 {
+		if deltas, err = newFlagDeltas(st, cs, previous); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range breakdowns {
+		idx := math.ValidatorIndex(i)
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		if !isEligibleValidator(val, previous) {
+			continue
+		}
+
+		if deltas != nil {
+			flags, err := st.GetPreviousEpochParticipation(idx)
+			if err != nil {
+				return nil, err
+			}
+			if val.IsSlashed() || !val.IsInValidatorSet(previous) {
+				flags = 0
+			}
+			deltas.apply(&breakdowns[i], val.GetEffectiveBalance(), flags)
+		}
+
+		if !inactivityLeakEnabled(cs, epoch) {
+			continue
+		}
+		score, err := st.GetInactivityScore(idx)
+		if err != nil {
+			return nil, err
+		}
+		breakdowns[i].InactivityPenalty = inactivityPenalty(val, score, cs)
+	}
+	return breakdowns, nil
+}
+
+// flagDeltas computes the participation flag rewards and penalties of the
+// validators for a previous epoch.
+type flagDeltas struct {
+	// increment is the effective balance increment.
+	increment math.Gwei
+	// baseRewardPerIncrement is the base reward per effective balance
+	// increment.
+	baseRewardPerIncrement math.Gwei
+	// activeIncrements is the number of increments of the total active
+	// balance.
+	activeIncrements math.Gwei
+	// attestingIncrements is the number of increments of the balance of the
+	// unslashed validators attesting with each flag.
+	attestingIncrements [TimelyHeadFlagIndex + 1]math.Gwei
+	// leaking is whether the chain is in an inactivity leak, in which case
+	// no rewards are paid.
+	leaking bool
+}
+
+// newFlagDeltas returns the flag deltas of the given previous epoch.
+func newFlagDeltas[ValidatorT inactivityValidator](
+	st rewardsState[ValidatorT],
+	cs common.ChainSpec,
+	previous math.Epoch,
+) (*flagDeltas, error) {
+	increment := math.Gwei(cs.EffectiveBalanceIncrement())
+	total, attesting, err := participationBalances[ValidatorT](
+		st, previous, st.GetPreviousEpochParticipation,
+	)
+	if err != nil {
+		return nil, err
+	}
+	leaking, err := isInInactivityLeak(st, cs, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	// Balances are at least one increment to avoid divisions by zero.
+	total = max(total, increment)
+	deltas := &flagDeltas{
+		increment: increment,
+		baseRewardPerIncrement: increment *
+			math.Gwei(cs.BaseRewardFactor()) /
+			math.Gwei(integerSquareRoot(uint64(total))),
+		activeIncrements: total / increment,
+		leaking:          leaking,
+	}
+	for flag, balance := range attesting {
+		deltas.attestingIncrements[flag] = max(balance, increment) / increment
+	}
+	return deltas, nil
+}
+
+// apply adds to the breakdown the flag rewards and penalties of a validator
+// with the given effective balance and participation flags.
+func (d *flagDeltas) apply(
+	breakdown *RewardBreakdown,
+	balance math.Gwei,
+	flags byte,
+) {
+	baseReward := balance / d.increment * d.baseRewardPerIncrement
+	for flag, weight := range participationFlagWeights {
+		var reward, penalty math.Gwei
+		switch {
+		case flags&(1<<flag) != 0:
+			if !d.leaking {
+				reward = baseReward * math.Gwei(weight) *
+					d.attestingIncrements[flag] /
+					(d.activeIncrements * weightDenominator)
+			}
+		case uint8(flag) != TimelyHeadFlagIndex:
+			penalty = baseReward * math.Gwei(weight) / weightDenominator
+		}
+
+		switch uint8(flag) {
+		case TimelySourceFlagIndex:
+			breakdown.SourceReward, breakdown.SourcePenalty = reward, penalty
+		case TimelyTargetFlagIndex:
+			breakdown.TargetReward, breakdown.TargetPenalty = reward, penalty
+		case TimelyHeadFlagIndex:
+			breakdown.HeadReward = reward
+		}
+	}
+}
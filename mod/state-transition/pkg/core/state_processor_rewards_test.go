@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochRewardBreakdowns(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch: 4,
+			},
+		)
+		st = &testInactivityState{
+			slot:       9,
+			validators: make([]testInactivityValidator, 4),
+		}
+	)
+
+	// Without a base reward factor nor an inactivity score bias every
+	// component is zero.
+	breakdowns, err := epochRewardBreakdowns(st, cs, 2)
+	require.NoError(t, err)
+	require.Equal(t, make([]RewardBreakdown, 4), breakdowns)
+
+	// Only the current epoch of the state can be computed.
+	_, err = epochRewardBreakdowns(st, cs, 1)
+	require.ErrorIs(t, err, ErrEpochRewardsUnavailable)
+	_, err = epochRewardBreakdowns(st, cs, 3)
+	require.ErrorIs(t, err, ErrEpochRewardsUnavailable)
+}
+
+func TestEpochRewardBreakdownsParticipation(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch:                1,
+				EffectiveBalanceIncrement:    1e9,
+				MinEpochsToInactivityPenalty: 4,
+				BaseRewardFactor:             64,
+			},
+		)
+		allFlags = byte(1<<TimelySourceFlagIndex |
+			1<<TimelyTargetFlagIndex | 1<<TimelyHeadFlagIndex)
+		st = &testInactivityState{
+			slot: 2,
+			validators: []testInactivityValidator{
+				{balance: 32e9},
+				{balance: 32e9},
+				{balance: 32e9},
+				// Slashed, hence eligible but not rewarded.
+				{balance: 32e9, slashed: true, withdrawable: 100},
+			},
+			participation: map[math.ValidatorIndex]byte{
+				0: allFlags,
+				1: 1<<TimelySourceFlagIndex | 1<<TimelyTargetFlagIndex,
+				3: allFlags,
+			},
+		}
+	)
+
+	// The total active balance is 96 increments, hence a base reward of
+	// 32 * 64e9 / isqrt(96e9) per validator.
+	breakdowns, err := epochRewardBreakdowns(st, cs, 2)
+	require.NoError(t, err)
+	require.Equal(t, RewardBreakdown{
+		SourceReward: 963942,
+		TargetReward: 1790178,
+		HeadReward:   481971,
+	}, breakdowns[0])
+	require.Equal(t, RewardBreakdown{
+		SourceReward: 963942,
+		TargetReward: 1790178,
+	}, breakdowns[1])
+	missed := RewardBreakdown{
+		SourcePenalty: 1445913,
+		TargetPenalty: 2685267,
+	}
+	require.Equal(t, missed, breakdowns[2])
+	require.Equal(t, missed, breakdowns[3])
+
+	// During an inactivity leak only the penalties apply.
+	st.slot = 10
+	breakdowns, err = epochRewardBreakdowns(st, cs, 10)
+	require.NoError(t, err)
+	require.Zero(t, breakdowns[0].Reward())
+	require.Zero(t, breakdowns[0].Penalty())
+	require.Zero(t, breakdowns[1].Reward())
+	require.Equal(t, missed, breakdowns[2])
+}
+
+func TestRewardBreakdownTotals(t *testing.T) {
+	breakdown := RewardBreakdown{
+		SourceReward:      1,
+		SourcePenalty:     2,
+		TargetReward:      4,
+		TargetPenalty:     8,
+		HeadReward:        16,
+		InactivityPenalty: 32,
+	}
+	require.Equal(t, math.Gwei(21), breakdown.Reward())
+	require.Equal(t, math.Gwei(42), breakdown.Penalty())
+}
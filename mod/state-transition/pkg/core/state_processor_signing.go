@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// SyncCommitteeSigningRoot returns the signing root of the block root signed
+// by the sync committee at the given slot, under the sync committee domain of
+// the fork active at the slot.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+]) SyncCommitteeSigningRoot(
+	st BeaconStateT,
+	blockRoot common.Root,
+	slot math.Slot,
+) (common.Root, error) {
+	return syncCommitteeSigningRoot[ForkDataT](st, sp.cs, blockRoot, slot)
+}
+
+// syncCommitteeSigningRoot returns the signing root of the block root signed
+// by the sync committee at the given slot.
+func syncCommitteeSigningRoot[ForkDataT ForkData[ForkDataT]](
+	st interface {
+		GetGenesisValidatorsRoot() (common.Root, error)
+	},
+	cs common.ChainSpec,
+	blockRoot common.Root,
+	slot math.Slot,
+) (common.Root, error) {
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	forkVersion := cs.ActiveForkVersionForSlot(slot)
+	domain, err := cs.DomainTypeRegistry().DomainType(
+		chain.DomainNameSyncCommittee, forkVersion,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	var fd ForkDataT
+	return fd.New(
+		version.FromUint32[common.Version](forkVersion), genesisValidatorsRoot,
+	).ComputeSyncCommitteeSigningRoot(domain, blockRoot), nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeSigningRoot(t *testing.T) {
+	var (
+		cs = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType,
+				math.Epoch,
+				common.ExecutionAddress,
+				math.Slot,
+				any,
+			]{
+				SlotsPerEpoch:           4,
+				DenebPlusForkEpoch:      2,
+				ElectraForkEpoch:        3,
+				DomainTypeSyncCommittee: common.DomainType{0x07},
+			},
+		)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st        = newTestStateDB(t, cs)
+		blockRoot = common.Root{0xbb}
+	)
+	require.NoError(t, st.SetGenesisValidatorsRoot(common.Root{0xaa}))
+
+	// Vectors computed as sha256(block_root || domain), with the domain of
+	// the DenebPlus and Electra fork versions respectively.
+	for slot, expected := range map[math.Slot]string{
+		8:  "0x514f91c131e4ee3a8c5e26fe38cf1d0fc1369c12bf3178fa35bde736bd56c25a",
+		11: "0x514f91c131e4ee3a8c5e26fe38cf1d0fc1369c12bf3178fa35bde736bd56c25a",
+		12: "0x0537cbe871d673fc30c3ddc0c2dd1bdcc0f6eb41bea955b6ed322e4fb1047004",
+	} {
+		root, err := sp.SyncCommitteeSigningRoot(st, blockRoot, slot)
+		require.NoError(t, err)
+		require.Equal(t, expected, root.String(), "slot: %d", slot)
+	}
+
+	// The signing root is that of the fork data at the slot.
+	root, err := sp.SyncCommitteeSigningRoot(st, blockRoot, 12)
+	require.NoError(t, err)
+	fd := (&types.ForkData{}).New(
+		version.FromUint32[common.Version](version.Electra), common.Root{0xaa},
+	)
+	require.Equal(
+		t,
+		fd.ComputeSyncCommitteeSigningRoot(common.DomainType{0x07}, blockRoot),
+		root,
+	)
+}
@@ -22,6 +22,7 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
@@ -56,6 +57,211 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// processMisbehaviors slashes the validators the block holds evidence of
+// misbehavior against, identified by their CometBFT addresses, with the
+// proposer of the block as the whistleblower, from the Electra fork onwards.
+// Validators which are no longer slashable, e.g. as they were slashed for
+// earlier evidence already, are skipped.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processMisbehaviors(
+	st BeaconStateT,
+	addresses [][]byte,
+	audit *auditLog,
+) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	if sp.cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return err
+	}
+	for _, address := range addresses {
+		idx, err := st.ValidatorIndexByCometBFTAddress(address)
+		if err != nil {
+			return err
+		}
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return err
+		}
+		if !val.IsSlashable(epoch) {
+			continue
+		}
+		if err = sp.slashValidator(
+			st, idx, header.GetProposerIndex(), audit,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SlashValidator as defined in the Ethereum 2.0 specification, with the
+// proposer of the block including the slashing as the whistleblower, as is
+// the case for proposer slashings.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SlashValidator(
+	st BeaconStateT,
+	slashedIdx math.ValidatorIndex,
+) error {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return err
+	}
+	return sp.slashValidator(st, slashedIdx, header.GetProposerIndex(), nil)
+}
+
+// SlashValidatorWithWhistleblower as defined in the Ethereum 2.0
+// specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+//
+// The slashed validator is exited and penalized by its effective balance
+// divided by MinSlashingPenaltyQuotient. Its effective balance divided by
+// WhistleblowerRewardQuotient is paid as the whistleblower reward, of which
+// the proposer of the block including the slashing receives the part
+// divided by ProposerRewardQuotient and the whistleblower the rest. A zero
+// quotient disables the penalty, the reward or the proposer part
+// respectively.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SlashValidatorWithWhistleblower(
+	st BeaconStateT,
+	slashedIdx math.ValidatorIndex,
+	whistleblowerIdx math.ValidatorIndex,
+) error {
+	return sp.slashValidator(st, slashedIdx, whistleblowerIdx, nil)
+}
+
+// slashValidator slashes the validator at slashedIdx as in
+// SlashValidatorWithWhistleblower, recording the exit it initiates and the
+// penalty it applies in the given audit log.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) slashValidator(
+	st BeaconStateT,
+	slashedIdx math.ValidatorIndex,
+	whistleblowerIdx math.ValidatorIndex,
+	audit *auditLog,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	if err = sp.initiateValidatorExit(st, slashedIdx, audit); err != nil {
+		return err
+	}
+	val, err := st.ValidatorByIndex(slashedIdx)
+	if err != nil {
+		return err
+	}
+	val.SetSlashed()
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(slashedIdx, val); err != nil {
+		return err
+	}
+
+	// Record the effective balance of the validator in the slashings.
+	effectiveBalance := val.GetEffectiveBalance()
+	index := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(
+		index, slashing+effectiveBalance,
+	); err != nil {
+		return err
+	}
+
+	var penalty math.Gwei
+	if quotient := sp.cs.MinSlashingPenaltyQuotient(); quotient != 0 {
+		penalty = effectiveBalance / math.Gwei(quotient)
+	}
+	if err = st.DecreaseBalance(slashedIdx, penalty); err != nil {
+		return err
+	}
+	audit.record(AuditOpSlashing, slashedIdx, penalty)
+
+	// Pay the whistleblower reward.
+	var whistleblowerReward, proposerReward math.Gwei
+	if quotient := sp.cs.WhistleblowerRewardQuotient(); quotient != 0 {
+		whistleblowerReward = effectiveBalance / math.Gwei(quotient)
+	}
+	if quotient := sp.cs.ProposerRewardQuotient(); quotient != 0 {
+		proposerReward = whistleblowerReward / math.Gwei(quotient)
+	}
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return err
+	}
+	if err = st.IncreaseBalance(
+		header.GetProposerIndex(), proposerReward,
+	); err != nil {
+		return err
+	}
+	return st.IncreaseBalance(
+		whistleblowerIdx, whistleblowerReward-proposerReward,
+	)
+}
+
+// SlashedValidators returns the indices of the validators slashed in the
+// given epoch, in ascending order. As in processSlashings, the epoch of a
+// slashing is read off the withdrawable epoch of the slashed validator, which
+// slashing sets to EpochsPerSlashingsVector epochs after it. A validator
+// whose withdrawable epoch was already later is attributed to the epoch that
+// many epochs before its withdrawable epoch.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SlashedValidators(
+	st BeaconStateT,
+	epoch math.Epoch,
+) ([]math.ValidatorIndex, error) {
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		withdrawableEpoch = epoch +
+			math.Epoch(sp.cs.EpochsPerSlashingsVector())
+		slashed []math.ValidatorIndex
+	)
+	for i := range totalValidators {
+		idx := math.ValidatorIndex(i)
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		if val.IsSlashed() &&
+			val.GetWithdrawableEpoch() == withdrawableEpoch {
+			slashed = append(slashed, idx)
+		}
+	}
+	return slashed, nil
+}
+
 // processSlashings as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings
 //
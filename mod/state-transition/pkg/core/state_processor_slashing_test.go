@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// newSlashingState returns a state of 4 validators holding 32 BERA each,
+// at a block proposed by validator 1.
+func newSlashingState(
+	t *testing.T,
+	opts testStateProcessorOptions,
+) (*testStateProcessor, *testStateDB) {
+	t.Helper()
+	data := testSpecData()
+	data.MinPerEpochChurnLimit = 4
+	data.MinActivationDelay = 1
+	data.MinValidatorWithdrawabilityDelay = 4
+	data.MinSlashingPenaltyQuotient = 128
+	data.WhistleblowerRewardQuotient = 512
+	data.ProposerRewardQuotient = 8
+	var (
+		cs = chain.NewChainSpec(data)
+		sp = newTestStateProcessor(cs, &signer.LegacySigner{}, opts)
+		st = newTestGenesisState(t, cs, sp)
+	)
+	for i := range 4 {
+		require.NoError(t, st.AddValidator(newTestValidator(
+			crypto.BLSPubkey{byte(i + 1)},
+			common.ExecutionAddress{byte(i + 1)},
+		)))
+		require.NoError(t, st.IncreaseBalance(math.ValidatorIndex(i), 32e9))
+	}
+	require.NoError(t, st.SetLatestBlockHeader(
+		types.NewBeaconBlockHeader(
+			0, 1, common.Root{}, common.Root{}, common.Root{},
+		),
+	))
+	return sp, st
+}
+
+func TestSlashValidator(t *testing.T) {
+	const (
+		proposer      = math.ValidatorIndex(1)
+		whistleblower = math.ValidatorIndex(2)
+		slashed       = math.ValidatorIndex(3)
+	)
+	balance := func(
+		t *testing.T, st *testStateDB, idx math.ValidatorIndex,
+	) math.Gwei {
+		t.Helper()
+		bal, err := st.GetBalance(idx)
+		require.NoError(t, err)
+		return bal
+	}
+
+	t.Run("proposer as whistleblower", func(t *testing.T) {
+		sp, st := newSlashingState(t, testStateProcessorOptions{})
+		require.NoError(t, sp.SlashValidator(st, slashed))
+
+		// The proposer receives the whole whistleblower reward of 32 BERA /
+		// 512, and the slashed validator loses 32 BERA / 128.
+		require.Equal(t, math.Gwei(32e9+62_500_000), balance(t, st, proposer))
+		require.Equal(t, math.Gwei(32e9-250_000_000), balance(t, st, slashed))
+		require.Equal(t, math.Gwei(32e9), balance(t, st, whistleblower))
+
+		val, err := st.ValidatorByIndex(slashed)
+		require.NoError(t, err)
+		require.True(t, val.IsSlashed())
+		require.Equal(t, math.Epoch(2), val.GetExitEpoch())
+		require.Equal(t, math.Epoch(8), val.GetWithdrawableEpoch())
+
+		slashing, err := st.GetSlashingAtIndex(0)
+		require.NoError(t, err)
+		require.Equal(t, math.Gwei(32e9), slashing)
+		total, err := st.GetTotalSlashing()
+		require.NoError(t, err)
+		require.Equal(t, math.Gwei(32e9), total)
+	})
+
+	t.Run("separate whistleblower", func(t *testing.T) {
+		sp, st := newSlashingState(t, testStateProcessorOptions{})
+		require.NoError(t, sp.SlashValidatorWithWhistleblower(
+			st, slashed, whistleblower,
+		))
+
+		// The proposer receives an eighth of the whistleblower reward, and
+		// the whistleblower the rest.
+		require.Equal(t, math.Gwei(32e9+7_812_500), balance(t, st, proposer))
+		require.Equal(
+			t, math.Gwei(32e9+54_687_500), balance(t, st, whistleblower),
+		)
+		require.Equal(t, math.Gwei(32e9-250_000_000), balance(t, st, slashed))
+	})
+}
+
+func TestProcessMisbehaviors(t *testing.T) {
+	audit := &testAuditSink{}
+	sp, st := newSlashingState(
+		t, testStateProcessorOptions{auditSink: audit},
+	)
+
+	// Validator 1 proposes a block holding evidence against validator 3
+	// twice, which slashes it once.
+	sealed := st.Copy()
+	_, err := sp.ProcessSlots(sealed, 1)
+	require.NoError(t, err)
+	parent, err := sealed.GetLatestBlockHeader()
+	require.NoError(t, err)
+	withdrawals, err := sealed.ExpectedWithdrawals()
+	require.NoError(t, err)
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		1, 1, parent.HashTreeRoot(), version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	blk.Body.ExecutionPayload.Withdrawals = withdrawals
+
+	pubkey := crypto.BLSPubkey{4}
+	address := sha256.Sum256(pubkey[:])
+	_, err = sp.Transition(&transition.Context{
+		Context: transition.WithMisbehavingValidators(
+			context.Background(), [][]byte{address[:20], address[:20]},
+		),
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+		AuditOperations:         true,
+	}, st, blk)
+	require.NoError(t, err)
+
+	val, err := st.ValidatorByIndex(3)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+	proposerBalance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9+62_500_000), proposerBalance)
+	slashedBalance, err := st.GetBalance(3)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9-250_000_000), slashedBalance)
+
+	// The exit and the penalty of the slashing are audited after the
+	// withdrawals of the block.
+	require.Len(t, audit.records, len(withdrawals)+2)
+	require.Equal(t, []core.AuditRecord{
+		{
+			Op:             core.AuditOpValidatorStatus,
+			ValidatorIndex: 3,
+			Slot:           1,
+		},
+		{
+			Op:             core.AuditOpSlashing,
+			ValidatorIndex: 3,
+			Amount:         250_000_000,
+			Slot:           1,
+		},
+	}, audit.records[len(withdrawals):])
+}
+
+func TestSlashedValidators(t *testing.T) {
+	sp, st := newSlashingState(t, testStateProcessorOptions{})
+	slashedIn := func(epoch math.Epoch) []math.ValidatorIndex {
+		t.Helper()
+		slashed, err := sp.SlashedValidators(st, epoch)
+		require.NoError(t, err)
+		return slashed
+	}
+
+	// Slash validator 3 in epoch 0, then validators 2 and 0 in epoch 2.
+	require.NoError(t, sp.SlashValidator(st, 3))
+	require.NoError(t, st.SetSlot(8))
+	require.NoError(t, sp.SlashValidator(st, 2))
+	require.NoError(t, sp.SlashValidator(st, 0))
+
+	require.Equal(t, []math.ValidatorIndex{3}, slashedIn(0))
+	require.Empty(t, slashedIn(1))
+	require.Equal(t, []math.ValidatorIndex{0, 2}, slashedIn(2))
+	require.Empty(t, slashedIn(3))
+}
@@ -21,21 +21,38 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
 
+// signatureVerifier verifies the signature of a message by a public key.
+type signatureVerifier func(
+	pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+) error
+
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) processOperations(
+	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
+	audit *auditLog,
 ) error {
+	// Slash the validators the block holds evidence of misbehavior against.
+	if err := sp.processMisbehaviors(
+		st, ctx.GetMisbehavingValidators(), audit,
+	); err != nil {
+		return err
+	}
+
 	// Verify that outstanding deposits are processed up to the maximum number
 	// of deposits.
 	deposits := blk.GetBody().GetDeposits()
@@ -56,20 +73,36 @@ func (sp *StateProcessor[
 	// if uint64(len(deposits)) != depositCount {
 	// 	return errors.New("deposit count mismatch")
 	// }
-	return sp.processDeposits(st, deposits)
+	return sp.processDeposits(
+		st, deposits, sp.signer.VerifySignature, audit,
+	)
 }
 
 // processDeposits processes the deposits and ensures  they match the
-// local state.
+// local state. The signatures of deposits creating validators are checked
+// with the given verifier.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
 ]) processDeposits(
 	st BeaconStateT,
 	deposits []DepositT,
+	verify signatureVerifier,
+	audit *auditLog,
 ) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	// Ensure the deposit indices follow on from the local state before
+	// applying any of them.
+	if err = validateDepositIndices(depositIndex, deposits); err != nil {
+		return err
+	}
+
 	// Ensure the deposits match the local state.
 	for _, dep := range deposits {
-		if err := sp.processDeposit(st, dep); err != nil {
+		if err := sp.processDeposit(st, dep, verify, audit); err != nil {
 			return err
 		}
 	}
@@ -82,6 +115,8 @@ func (sp *StateProcessor[
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
+	verify signatureVerifier,
+	audit *auditLog,
 ) error {
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
@@ -94,7 +129,32 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	return sp.applyDeposit(st, dep)
+	return sp.applyDeposit(st, dep, verify, audit)
+}
+
+// validateDepositIndices ensures that the indices of the given deposits are
+// strictly increasing and contiguous, starting at the given index.
+func validateDepositIndices[DepositT interface{ GetIndex() math.U64 }](
+	startIndex uint64,
+	deposits []DepositT,
+) error {
+	for i, dep := range deposits {
+		//#nosec:G701 // i is bounded by the number of deposits.
+		expected := startIndex + uint64(i)
+		switch index := dep.GetIndex().Unwrap(); {
+		case index < expected:
+			return errors.Wrapf(
+				ErrDuplicateDepositIndex,
+				"expected: %d, got: %d", expected, index,
+			)
+		case index > expected:
+			return errors.Wrapf(
+				ErrDepositIndexGap,
+				"expected: %d, got: %d", expected, index,
+			)
+		}
+	}
+	return nil
 }
 
 // applyDeposit processes the deposit and ensures it matches the local state.
@@ -103,6 +163,8 @@ func (sp *StateProcessor[
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
+	verify signatureVerifier,
+	audit *auditLog,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
 	// If the validator already exists, we update the balance.
@@ -113,34 +175,89 @@ func (sp *StateProcessor[
 			return err
 		}
 
-		// TODO: Modify balance here and then effective balance once per epoch.
-		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
-			math.Gwei(sp.cs.MaxEffectiveBalance())))
-		return st.UpdateValidatorAtIndex(idx, val)
+		var ceiling math.Gwei
+		ceiling, err = sp.maxEffectiveBalance(st, val)
+		if err != nil {
+			return err
+		}
+		if sp.cs.CreditDepositTopUps() {
+			// The full amount is credited to the balance, of which the
+			// effective balance is capped at the ceiling. The excess is
+			// withdrawn by the sweep.
+			if err = st.IncreaseBalance(idx, dep.GetAmount()); err != nil {
+				return err
+			}
+			var balance math.Gwei
+			balance, err = st.GetBalance(idx)
+			if err != nil {
+				return err
+			}
+			increment := math.Gwei(sp.cs.EffectiveBalanceIncrement())
+			val.SetEffectiveBalance(min(balance-balance%increment, ceiling))
+		} else {
+			// TODO: Modify balance here and then effective balance once per
+			// epoch.
+			val.SetEffectiveBalance(
+				min(val.GetEffectiveBalance()+dep.GetAmount(), ceiling),
+			)
+		}
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+		audit.record(AuditOpDeposit, idx, dep.GetAmount())
+		return nil
 	}
 
 	// If the validator does not exist, we add the validator.
 	// Add the validator to the registry.
-	return sp.createValidator(st, dep)
+	return sp.createValidator(st, dep, verify, audit)
 }
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
+	verify signatureVerifier,
+	audit *auditLog,
 ) error {
+	forkData, domain, err := sp.depositForkData(st)
+	if err != nil {
+		return err
+	}
+
+	// Verify that the message was signed correctly.
+	if err = dep.VerifySignature(forkData, domain, verify); err != nil {
+		return err
+	}
+
+	// Add the validator to the registry.
+	return sp.addValidatorToRegistry(st, dep, audit)
+}
+
+// depositForkData returns the fork data and the domain deposit signatures
+// are verified against in the current state.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+]) depositForkData(st BeaconStateT) (ForkDataT, common.DomainType, error) {
 	var (
 		genesisValidatorsRoot common.Root
-		epoch                 math.Epoch
-		err                   error
+		forkData              ForkDataT
 	)
 
 	// Get the current slot.
 	slot, err := st.GetSlot()
 	if err != nil {
-		return err
+		return forkData, common.DomainType{}, err
+	}
+
+	forkVersion := sp.cs.ActiveForkVersionForEpoch(sp.cs.SlotToEpoch(slot))
+	domain, err := sp.cs.DomainTypeRegistry().DomainType(
+		chain.DomainNameDeposit, forkVersion,
+	)
+	if err != nil {
+		return forkData, common.DomainType{}, err
 	}
 
 	// At genesis, the validators sign over an empty root.
@@ -150,29 +267,13 @@ func (sp *StateProcessor[
 		// Get the genesis validators root to be used to find fork data later.
 		genesisValidatorsRoot, err = st.GetGenesisValidatorsRoot()
 		if err != nil {
-			return err
+			return forkData, common.DomainType{}, err
 		}
 	}
 
-	// Get the current epoch.
-	epoch = sp.cs.SlotToEpoch(slot)
-
-	// Verify that the message was signed correctly.
-	var d ForkDataT
-	if err = dep.VerifySignature(
-		d.New(
-			version.FromUint32[common.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
-		sp.signer.VerifySignature,
-	); err != nil {
-		return err
-	}
-
-	// Add the validator to the registry.
-	return sp.addValidatorToRegistry(st, dep)
+	return forkData.New(
+		version.FromUint32[common.Version](forkVersion), genesisValidatorsRoot,
+	), domain, nil
 }
 
 // addValidatorToRegistry adds a validator to the registry.
@@ -181,23 +282,42 @@ func (sp *StateProcessor[
 ]) addValidatorToRegistry(
 	st BeaconStateT,
 	dep DepositT,
+	audit *auditLog,
 ) error {
-	var val ValidatorT
+	var (
+		val        ValidatorT
+		maxBalance = math.Gwei(sp.cs.MaxEffectiveBalance())
+	)
 	val = val.New(
 		dep.GetPubkey(),
 		dep.GetWithdrawalCredentials(),
 		dep.GetAmount(),
 		math.Gwei(sp.cs.EffectiveBalanceIncrement()),
-		math.Gwei(sp.cs.MaxEffectiveBalance()),
+		maxBalance,
 	)
 
+	// Compounding validators may be capped at a higher effective balance.
+	ceiling, err := sp.maxEffectiveBalance(st, val)
+	if err != nil {
+		return err
+	}
+	if ceiling != maxBalance {
+		val = val.New(
+			dep.GetPubkey(),
+			dep.GetWithdrawalCredentials(),
+			dep.GetAmount(),
+			math.Gwei(sp.cs.EffectiveBalanceIncrement()),
+			ceiling,
+		)
+	}
+
 	// TODO: This is a bug that lives on bArtio. Delete this eventually.
 	const bArtioChainID = 80084
 	if sp.cs.DepositEth1ChainID() == bArtioChainID {
-		if err := st.AddValidatorBartio(val); err != nil {
+		if err = st.AddValidatorBartio(val); err != nil {
 			return err
 		}
-	} else if err := st.AddValidator(val); err != nil {
+	} else if err = st.AddValidator(val); err != nil {
 		return err
 	}
 
@@ -206,7 +326,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	return st.IncreaseBalance(idx, dep.GetAmount())
+	if err = st.IncreaseBalance(idx, dep.GetAmount()); err != nil {
+		return err
+	}
+	audit.record(AuditOpValidatorStatus, idx, 0)
+	audit.record(AuditOpDeposit, idx, dep.GetAmount())
+	return nil
 }
 
 // processWithdrawals as per the Ethereum 2.0 specification.
@@ -218,10 +343,10 @@ func (sp *StateProcessor[
 ]) processWithdrawals(
 	st BeaconStateT,
 	body BeaconBlockBodyT,
+	audit *auditLog,
 ) error {
 	// Dequeue and verify the logs.
 	var (
-		nextValidatorIndex math.ValidatorIndex
 		payload            = body.GetExecutionPayload()
 		payloadWithdrawals = payload.GetWithdrawals()
 	)
@@ -242,6 +367,11 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// Ensure the withdrawals continue from the next withdrawal index.
+	if err = st.VerifyWithdrawalIndices(payloadWithdrawals); err != nil {
+		return err
+	}
+
 	// Compare and process each withdrawal.
 	for i, wd := range expectedWithdrawals {
 		// Ensure the withdrawals match the local state.
@@ -259,41 +389,37 @@ func (sp *StateProcessor[
 		); err != nil {
 			return err
 		}
+		audit.record(
+			AuditOpWithdrawal, wd.GetValidatorIndex(), wd.GetAmount(),
+		)
 	}
 
-	// Update the next withdrawal index if this block contained withdrawals
-	if numWithdrawals != 0 {
-		// Next sweep starts after the latest withdrawal's validator index
-		if err = st.SetNextWithdrawalIndex(
-			(expectedWithdrawals[numWithdrawals-1].GetIndex() + 1).Unwrap(),
-		); err != nil {
-			return err
-		}
-	}
-
-	totalValidators, err := st.GetTotalValidators()
+	// Advance the sweep past the processed withdrawals.
+	nextWithdrawalIndex, nextValidatorIndex, err := st.NextWithdrawalIndices(
+		expectedWithdrawals,
+	)
 	if err != nil {
 		return err
 	}
-
-	// Update the next validator index to start the next withdrawal sweep
-	//#nosec:G701 // won't overflow in practice.
-	if numWithdrawals == int(sp.cs.MaxWithdrawalsPerPayload()) {
-		// Next sweep starts after the latest withdrawal's validator index
-		nextValidatorIndex =
-			(expectedWithdrawals[len(expectedWithdrawals)-1].GetIndex() + 1) %
-				math.U64(totalValidators)
-	} else {
-		// Advance sweep by the max length of the sweep if there was not
-		// a full set of withdrawals
-		nextValidatorIndex, err = st.GetNextWithdrawalValidatorIndex()
-		if err != nil {
-			return err
-		}
-		nextValidatorIndex += math.ValidatorIndex(
-			sp.cs.MaxValidatorsPerWithdrawalsSweep())
-		nextValidatorIndex %= math.ValidatorIndex(totalValidators)
+	if err = st.SetNextWithdrawalIndex(nextWithdrawalIndex); err != nil {
+		return err
 	}
-
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// maxEffectiveBalance returns the effective balance ceiling of the validator
+// at the current slot of the state, as defined by its withdrawal credentials.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) maxEffectiveBalance(
+	st BeaconStateT,
+	val ValidatorT,
+) (math.Gwei, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	return math.Gwei(sp.cs.MaxEffectiveBalanceForEpoch(
+		sp.cs.SlotToEpoch(slot), val.HasCompoundingWithdrawalCredential(),
+	)), nil
+}
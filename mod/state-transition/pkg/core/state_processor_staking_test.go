@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a minimal deposit carrying only an index.
+type testDeposit struct {
+	index math.U64
+}
+
+func (d testDeposit) GetIndex() math.U64 { return d.index }
+
+func depositsWithIndices(indices ...uint64) []testDeposit {
+	deposits := make([]testDeposit, len(indices))
+	for i, index := range indices {
+		deposits[i] = testDeposit{index: math.U64(index)}
+	}
+	return deposits
+}
+
+func TestValidateDepositIndices(t *testing.T) {
+	tests := []struct {
+		name       string
+		startIndex uint64
+		deposits   []testDeposit
+		expectErr  error
+	}{
+		{
+			name:       "no deposits",
+			startIndex: 5,
+			deposits:   depositsWithIndices(),
+		},
+		{
+			name:       "contiguous batch from genesis",
+			startIndex: 0,
+			deposits:   depositsWithIndices(0, 1, 2, 3),
+		},
+		{
+			name:       "contiguous batch",
+			startIndex: 7,
+			deposits:   depositsWithIndices(7, 8, 9),
+		},
+		{
+			name:       "gap at start",
+			startIndex: 7,
+			deposits:   depositsWithIndices(8, 9),
+			expectErr:  ErrDepositIndexGap,
+		},
+		{
+			name:       "gap within batch",
+			startIndex: 7,
+			deposits:   depositsWithIndices(7, 9),
+			expectErr:  ErrDepositIndexGap,
+		},
+		{
+			name:       "already processed index",
+			startIndex: 7,
+			deposits:   depositsWithIndices(6, 7),
+			expectErr:  ErrDuplicateDepositIndex,
+		},
+		{
+			name:       "duplicate index within batch",
+			startIndex: 7,
+			deposits:   depositsWithIndices(7, 8, 8),
+			expectErr:  ErrDuplicateDepositIndex,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDepositIndices(tt.startIndex, tt.deposits)
+			if tt.expectErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
+
+func TestVerifyConcurrently(t *testing.T) {
+	errBad := errors.New("bad")
+	for _, workers := range []int{1, 2, 8} {
+		failed, err := verifyConcurrently(16, workers, func(i int) error {
+			if i == 5 || i == 11 {
+				return errBad
+			}
+			return nil
+		})
+		require.ErrorIs(t, err, errBad)
+		require.Equal(t, 5, failed)
+
+		_, err = verifyConcurrently(16, workers, func(int) error {
+			return nil
+		})
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// testRootedState is a state whose root is fixed, counting how often it is
+// computed.
+type testRootedState struct {
+	root  common.Root
+	calls int
+}
+
+func (s *testRootedState) HashTreeRoot() common.Root {
+	s.calls++
+	return s.root
+}
+
+// testRootedBlock is a block carrying only a state root.
+type testRootedBlock struct {
+	stateRoot common.Root
+}
+
+func (b testRootedBlock) GetStateRoot() common.Root { return b.stateRoot }
+
+func TestValidateStateRoot(t *testing.T) {
+	postStateRoot := common.Root{0x01}
+	tests := []struct {
+		name      string
+		skip      bool
+		blockRoot common.Root
+		expectErr error
+		computed  bool
+	}{
+		{
+			name:      "matching root",
+			blockRoot: postStateRoot,
+			computed:  true,
+		},
+		{
+			name:      "mismatched root is rejected",
+			blockRoot: common.Root{0x02},
+			expectErr: ErrStateRootMismatch,
+			computed:  true,
+		},
+		{
+			name:      "skip mode allows a mismatched root",
+			skip:      true,
+			blockRoot: common.Root{0x02},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &testRootedState{root: postStateRoot}
+			err := validateStateRoot(
+				tt.skip, st, testRootedBlock{stateRoot: tt.blockRoot},
+			)
+			if tt.expectErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.expectErr)
+			}
+			require.Equal(t, tt.computed, st.calls > 0)
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalSweep(t *testing.T) {
+	// Cap the sweep at 3 withdrawals per payload, below the 5 validators.
+	data := testSpecData()
+	data.MaxWithdrawalsPerPayload = 3
+	var (
+		cs = chain.NewChainSpec(data)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st = newTestGenesisState(t, cs, sp)
+	)
+	for i := range 5 {
+		require.NoError(t, st.AddValidator(newTestValidator(
+			crypto.BLSPubkey{byte(i + 1)},
+			common.ExecutionAddress{byte(i + 1)},
+		)))
+		// Each validator holds an excess balance of i+1 Gwei.
+		require.NoError(t, st.IncreaseBalance(
+			math.ValidatorIndex(i), 32e9+math.Gwei(i+1),
+		))
+	}
+	// Withdrawal indices are independent of validator indices, e.g. after
+	// the validator set changed.
+	require.NoError(t, st.SetNextWithdrawalIndex(101))
+
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+	}
+
+	// transitionBlock transitions the state through a block at the given
+	// slot including the expected withdrawals, which it returns.
+	transitionBlock := func(slot math.Slot) engineprimitives.Withdrawals {
+		sealed := st.Copy()
+		_, err := sp.ProcessSlots(sealed, slot)
+		require.NoError(t, err)
+		parent, err := sealed.GetLatestBlockHeader()
+		require.NoError(t, err)
+		withdrawals, err := sealed.ExpectedWithdrawals()
+		require.NoError(t, err)
+
+		blk, err := (&types.BeaconBlock{}).NewWithVersion(
+			slot, 0, parent.HashTreeRoot(), version.Deneb,
+		)
+		require.NoError(t, err)
+		blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		blk.Body.ExecutionPayload.Withdrawals = withdrawals
+		_, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+		return withdrawals
+	}
+
+	// assertSweep asserts the withdrawals are those of the given validators
+	// and amounts, with consecutive withdrawal indices from the given one.
+	assertSweep := func(
+		withdrawals engineprimitives.Withdrawals,
+		index uint64,
+		validators []math.ValidatorIndex,
+		amounts []math.Gwei,
+	) {
+		require.Len(t, withdrawals, len(validators))
+		for i, wd := range withdrawals {
+			require.Equal(t, math.U64(index+uint64(i)), wd.GetIndex())
+			require.Equal(t, validators[i], wd.GetValidatorIndex())
+			require.Equal(t, amounts[i], wd.GetAmount())
+		}
+	}
+
+	// The first sweep is capped after 3 validators and resumes from the
+	// validator following the last one withdrawn.
+	assertSweep(
+		transitionBlock(1), 101,
+		[]math.ValidatorIndex{0, 1, 2}, []math.Gwei{1, 2, 3},
+	)
+	next, err := st.GetNextWithdrawalValidatorIndex()
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(3), next)
+
+	// The following sweep wraps around the validator set, where the excess
+	// balance of validator 0 has already been withdrawn.
+	assertSweep(
+		transitionBlock(2), 104,
+		[]math.ValidatorIndex{3, 4, 0}, []math.Gwei{4, 5, 0},
+	)
+	next, err = st.GetNextWithdrawalValidatorIndex()
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(1), next)
+	withdrawalIndex, err := st.GetNextWithdrawalIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(107), withdrawalIndex)
+
+	// The excess balances of all validators have been withdrawn.
+	for i := range 5 {
+		balance, err := st.GetBalance(math.ValidatorIndex(i))
+		require.NoError(t, err)
+		require.Equal(t, math.Gwei(32e9), balance)
+	}
+}
+
+func TestWithdrawalSweepSkipsIneligible(t *testing.T) {
+	// Cap the sweep at 2 withdrawals per payload, skipping validators not
+	// eligible for a withdrawal.
+	data := testSpecData()
+	data.MaxWithdrawalsPerPayload = 2
+	data.SkipIneligibleWithdrawals = true
+	var (
+		cs = chain.NewChainSpec(data)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st          = newTestGenesisState(t, cs, sp)
+		farFuture   = math.Epoch(constants.FarFutureEpoch)
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+	)
+	for i, v := range []struct {
+		credentials       types.WithdrawalCredentials
+		effectiveBalance  math.Gwei
+		balance           math.Gwei
+		withdrawableEpoch math.Epoch
+	}{
+		// An excess balance is partially withdrawn.
+		{credentials, 32e9, 32e9 + 1, farFuture},
+		// A fully withdrawn validator is skipped.
+		{credentials, 0, 0, 0},
+		// A balance without excess is skipped.
+		{credentials, 32e9, 32e9, farFuture},
+		// An exited validator is skipped until it is withdrawable.
+		{credentials, 16e9, 16e9, 4},
+		// A validator without eth1 credentials is skipped.
+		{types.WithdrawalCredentials{}, 32e9, 32e9 + 5, farFuture},
+		// A withdrawable validator is fully withdrawn.
+		{credentials, 10e9, 10e9, 0},
+		// An excess balance is partially withdrawn.
+		{credentials, 32e9, 32e9 + 7, farFuture},
+	} {
+		require.NoError(t, st.AddValidator(&types.Validator{
+			Pubkey:                [48]byte{byte(i + 1)},
+			WithdrawalCredentials: v.credentials,
+			EffectiveBalance:      v.effectiveBalance,
+			ExitEpoch:             v.withdrawableEpoch,
+			WithdrawableEpoch:     v.withdrawableEpoch,
+		}))
+		require.NoError(t, st.IncreaseBalance(
+			math.ValidatorIndex(i), v.balance,
+		))
+	}
+
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+	}
+
+	// assertSweep asserts the expected withdrawals of the state at the given
+	// slot are those of the given validators and amounts, and transitions
+	// the state through a block including them.
+	assertSweep := func(
+		slot math.Slot,
+		validators []math.ValidatorIndex,
+		amounts []math.Gwei,
+		unswept uint64,
+	) {
+		sealed := st.Copy()
+		_, err := sp.ProcessSlots(sealed, slot)
+		require.NoError(t, err)
+		withdrawals, err := sealed.ExpectedWithdrawals()
+		require.NoError(t, err)
+		require.Len(t, withdrawals, len(validators))
+		for i, wd := range withdrawals {
+			require.Equal(t, validators[i], wd.GetValidatorIndex())
+			require.Equal(t, amounts[i], wd.GetAmount())
+		}
+		count, err := sealed.UnsweptWithdrawableValidators()
+		require.NoError(t, err)
+		require.Equal(t, unswept, count)
+
+		parent, err := sealed.GetLatestBlockHeader()
+		require.NoError(t, err)
+		blk, err := (&types.BeaconBlock{}).NewWithVersion(
+			slot, 0, parent.HashTreeRoot(), version.Deneb,
+		)
+		require.NoError(t, err)
+		blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		blk.Body.ExecutionPayload.Withdrawals = withdrawals
+		_, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+	}
+
+	// Only eligible validators are swept, leaving the last one for the
+	// next payload.
+	assertSweep(
+		1, []math.ValidatorIndex{0, 5}, []math.Gwei{1, 10e9}, 1,
+	)
+	assertSweep(2, []math.ValidatorIndex{6}, []math.Gwei{7}, 0)
+}
+
+func TestWithdrawalIndicesAcrossEpochs(t *testing.T) {
+	// Cap the sweep at 3 withdrawals per payload, below the 5 validators,
+	// over epochs of 4 slots.
+	data := testSpecData()
+	data.MaxWithdrawalsPerPayload = 3
+	var (
+		cs = chain.NewChainSpec(data)
+		sp = newTestStateProcessor(
+			cs, &signer.LegacySigner{}, testStateProcessorOptions{},
+		)
+		st = newTestGenesisState(t, cs, sp)
+	)
+	for i := range 5 {
+		require.NoError(t, st.AddValidator(newTestValidator(
+			crypto.BLSPubkey{byte(i + 1)},
+			common.ExecutionAddress{byte(i + 1)},
+		)))
+		require.NoError(t, st.IncreaseBalance(
+			math.ValidatorIndex(i), 32e9+math.Gwei(i+1),
+		))
+	}
+	require.NoError(t, st.SetNextWithdrawalIndex(101))
+
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+	}
+
+	// buildBlock builds the block at the given slot including the
+	// withdrawals expected for it, returning the block and the next
+	// withdrawal indices it is expected to leave in the state.
+	buildBlock := func(slot math.Slot) (
+		*types.BeaconBlock, uint64, math.ValidatorIndex,
+	) {
+		sealed := st.Copy()
+		_, err := sp.ProcessSlots(sealed, slot)
+		require.NoError(t, err)
+		parent, err := sealed.GetLatestBlockHeader()
+		require.NoError(t, err)
+		withdrawals, err := sealed.ExpectedWithdrawals()
+		require.NoError(t, err)
+		require.NoError(t, sealed.VerifyWithdrawalIndices(withdrawals))
+		withdrawalIndex, validatorIndex, err := sealed.NextWithdrawalIndices(
+			withdrawals,
+		)
+		require.NoError(t, err)
+
+		blk, err := (&types.BeaconBlock{}).NewWithVersion(
+			slot, 0, parent.HashTreeRoot(), version.Deneb,
+		)
+		require.NoError(t, err)
+		blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		blk.Body.ExecutionPayload.Withdrawals = withdrawals
+		return blk, withdrawalIndex, validatorIndex
+	}
+
+	// Every sweep is capped after 3 validators, once their excess balances
+	// are withdrawn too, across the epoch boundaries at slots 4 and 8.
+	for slot := math.Slot(1); slot <= 9; slot++ {
+		blk, withdrawalIndex, validatorIndex := buildBlock(slot)
+		n := uint64(slot)
+		require.Equal(t, 101+3*n, withdrawalIndex, "slot %d", slot)
+		require.Equal(
+			t, math.ValidatorIndex((3*n)%5), validatorIndex, "slot %d", slot,
+		)
+		for i, wd := range blk.Body.ExecutionPayload.Withdrawals {
+			require.Equal(t, math.U64(101+3*(n-1)+uint64(i)), wd.GetIndex())
+			require.Equal(
+				t, math.ValidatorIndex((3*(n-1)+uint64(i))%5),
+				wd.GetValidatorIndex(),
+			)
+		}
+
+		_, err := sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+		next, err := st.GetNextWithdrawalIndex()
+		require.NoError(t, err)
+		require.Equal(t, withdrawalIndex, next, "slot %d", slot)
+		nextValidator, err := st.GetNextWithdrawalValidatorIndex()
+		require.NoError(t, err)
+		require.Equal(t, validatorIndex, nextValidator, "slot %d", slot)
+	}
+
+	// A payload whose withdrawals skip an index is rejected.
+	blk, _, _ := buildBlock(10)
+	for _, wd := range blk.Body.ExecutionPayload.Withdrawals {
+		wd.Index++
+	}
+	_, err := sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, state.ErrWithdrawalIndexMismatch)
+}
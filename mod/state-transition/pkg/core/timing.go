@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Phase is a phase of the state transition of a block.
+type Phase uint8
+
+const (
+	// PhaseSlots is the processing of the slots up to the block, excluding
+	// the processing of the epochs they end.
+	PhaseSlots Phase = iota
+	// PhaseEpoch is the processing of the epochs ended by the slots.
+	PhaseEpoch
+	// PhaseBlockHeader is the processing of the block header.
+	PhaseBlockHeader
+	// PhaseExecutionPayload is the processing of the execution payload.
+	PhaseExecutionPayload
+	// PhaseWithdrawals is the processing of the withdrawals.
+	PhaseWithdrawals
+	// PhaseRandao is the processing of the randao reveal.
+	PhaseRandao
+	// PhaseEth1Data is the processing of the eth1 data vote.
+	PhaseEth1Data
+	// PhaseOperations is the processing of the deposits.
+	PhaseOperations
+	// PhaseStateRoot is the validation of the post-state root.
+	PhaseStateRoot
+)
+
+// Phases are all the phases of the state transition, in the order they run.
+//
+//nolint:gochecknoglobals // read-only.
+var Phases = []Phase{
+	PhaseSlots,
+	PhaseEpoch,
+	PhaseBlockHeader,
+	PhaseExecutionPayload,
+	PhaseWithdrawals,
+	PhaseRandao,
+	PhaseEth1Data,
+	PhaseOperations,
+	PhaseStateRoot,
+}
+
+// String returns the name of the phase.
+func (p Phase) String() string {
+	switch p {
+	case PhaseSlots:
+		return "slots"
+	case PhaseEpoch:
+		return "epoch"
+	case PhaseBlockHeader:
+		return "block-header"
+	case PhaseExecutionPayload:
+		return "execution-payload"
+	case PhaseWithdrawals:
+		return "withdrawals"
+	case PhaseRandao:
+		return "randao"
+	case PhaseEth1Data:
+		return "eth1-data"
+	case PhaseOperations:
+		return "operations"
+	case PhaseStateRoot:
+		return "state-root"
+	default:
+		return "unknown"
+	}
+}
+
+// TransitionTimings is the time spent in each phase of the state transition
+// of a block.
+type TransitionTimings struct {
+	// Slot is the slot of the block.
+	Slot math.Slot
+	// Durations is the time spent in each phase. Phases which did not run
+	// are absent.
+	Durations map[Phase]time.Duration
+}
+
+// TimingSink receives the phase timings of every block transitioned
+// successfully. Timings are delivered synchronously once the block has been
+// fully processed.
+type TimingSink interface {
+	// RecordTimings records the phase timings of a block.
+	RecordTimings(timings TransitionTimings)
+}
+
+// phaseTimer accumulates the time spent in each phase of the transition of a
+// single block. A nil phaseTimer runs the phases without timing them.
+type phaseTimer struct {
+	timings TransitionTimings
+}
+
+// newPhaseTimer returns a phase timer for the block at the given slot.
+func newPhaseTimer(slot math.Slot) *phaseTimer {
+	return &phaseTimer{
+		timings: TransitionTimings{
+			Slot:      slot,
+			Durations: make(map[Phase]time.Duration, len(Phases)),
+		},
+	}
+}
+
+// track runs fn, adding the time it takes to the given phase.
+func (t *phaseTimer) track(phase Phase, fn func() error) error {
+	if t == nil {
+		return fn()
+	}
+	start := time.Now()
+	err := fn()
+	t.timings.Durations[phase] += time.Since(start)
+	return err
+}
+
+// lastTimings holds the phase timings of the last block transitioned.
+type lastTimings struct {
+	mu      sync.RWMutex
+	timings TransitionTimings
+}
+
+// set replaces the last timings with a copy of the given ones.
+func (l *lastTimings) set(timings TransitionTimings) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.timings = TransitionTimings{
+		Slot:      timings.Slot,
+		Durations: maps.Clone(timings.Durations),
+	}
+}
+
+// get returns a copy of the last timings.
+func (l *lastTimings) get() TransitionTimings {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return TransitionTimings{
+		Slot:      l.timings.Slot,
+		Durations: maps.Clone(l.timings.Durations),
+	}
+}
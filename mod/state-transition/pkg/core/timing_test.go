@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// testTimingSink collects the timings it receives.
+type testTimingSink struct {
+	timings []core.TransitionTimings
+}
+
+func (s *testTimingSink) RecordTimings(timings core.TransitionTimings) {
+	s.timings = append(s.timings, timings)
+}
+
+// testAuditSink collects the audit records it receives.
+type testAuditSink struct {
+	records []core.AuditRecord
+}
+
+func (s *testAuditSink) Record(record core.AuditRecord) {
+	s.records = append(s.records, record)
+}
+
+func TestTransitionTimings(t *testing.T) {
+	var (
+		cs    = testChainSpec()
+		sink  = &testTimingSink{}
+		audit = &testAuditSink{}
+		sp    = newTestStateProcessor(
+			cs, &signer.LegacySigner{},
+			testStateProcessorOptions{timingSink: sink, auditSink: audit},
+		)
+		// Build a genesis state with a single validator, whose balance in
+		// excess of its stake is withdrawn.
+		st = newTestGenesisState(
+			t, cs, sp, newTestValidator(
+				crypto.BLSPubkey{}, common.ExecutionAddress{0x01},
+			),
+		)
+	)
+	require.NoError(t, st.IncreaseBalance(0, 33e9))
+
+	// The block is the first of epoch 1, such that its slots end epoch 0.
+	slot := math.Slot(cs.SlotsPerEpoch())
+	sealed := st.Copy()
+	_, err := sp.ProcessSlots(sealed, slot)
+	require.NoError(t, err)
+	parent, err := sealed.GetLatestBlockHeader()
+	require.NoError(t, err)
+	withdrawals, err := sealed.ExpectedWithdrawals()
+	require.NoError(t, err)
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		slot, 0, parent.HashTreeRoot(), version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body = (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	blk.Body.ExecutionPayload.Withdrawals = withdrawals
+	blk.StateRoot, err = sp.ApplyBlock(&transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+	}, st, blk)
+	require.NoError(t, err)
+	sink.timings = nil
+
+	_, err = sp.Transition(&transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+	}, st.Copy(), blk)
+	require.NoError(t, err)
+
+	// Every phase ran and is reported, both to the sink and the accessor.
+	require.Len(t, sink.timings, 1)
+	timings := sink.timings[0]
+	require.Equal(t, slot, timings.Slot)
+	require.Len(t, timings.Durations, len(core.Phases))
+	for _, phase := range core.Phases {
+		require.Positive(t, timings.Durations[phase], phase.String())
+	}
+	require.Equal(t, timings, sp.LastTransitionTimings())
+
+	// A failing block does not replace the timings of the last block.
+	stateRoot := blk.StateRoot
+	blk.StateRoot = common.Root{0x01}
+	_, err = sp.Transition(&transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+	}, st.Copy(), blk)
+	require.Error(t, err)
+	require.Len(t, sink.timings, 1)
+	require.Equal(t, timings, sp.LastTransitionTimings())
+
+	// A deferred transition holds its timings and audit records back until
+	// they are emitted, auditing the operations regardless of the context.
+	require.Empty(t, audit.records)
+	blk.StateRoot = stateRoot
+	_, emit, err := sp.TransitionDeferred(&transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+	}, st.Copy(), blk)
+	require.NoError(t, err)
+	require.Len(t, sink.timings, 1)
+	require.Empty(t, audit.records)
+
+	emit()
+	require.Len(t, sink.timings, 2)
+	require.Equal(t, sink.timings[1], sp.LastTransitionTimings())
+	require.Equal(t, []core.AuditRecord{{
+		Op:             core.AuditOpWithdrawal,
+		ValidatorIndex: 0,
+		Amount:         1e9,
+		Slot:           slot,
+	}}, audit.records)
+}
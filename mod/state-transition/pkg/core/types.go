@@ -37,9 +37,10 @@ import (
 type BeaconBlock[
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -64,6 +65,7 @@ type BeaconBlock[
 type BeaconBlockBody[
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -77,6 +79,8 @@ type BeaconBlockBody[
 	GetExecutionPayload() ExecutionPayloadT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetEth1Data returns the eth1 data vote of the block.
+	GetEth1Data() Eth1DataT
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -118,6 +122,12 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetAuditOperations returns whether to emit the state-changing
+	// operations of the block to the audit sink.
+	GetAuditOperations() bool
+	// GetMisbehavingValidators returns the CometBFT addresses of the
+	// validators the block holds evidence of misbehavior against.
+	GetMisbehavingValidators() [][]byte
 }
 
 // Deposit is the interface for a deposit.
@@ -127,6 +137,8 @@ type Deposit[
 ] interface {
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
+	// GetIndex returns the index of the deposit in the deposit contract.
+	GetIndex() math.U64
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetWithdrawalCredentials returns the withdrawal credentials.
@@ -200,6 +212,50 @@ type ForkData[ForkDataT any] interface {
 		domainType common.DomainType,
 		epoch math.Epoch,
 	) common.Root
+	// ComputeSyncCommitteeSigningRoot returns the signing root of the block
+	// root signed by the sync committee.
+	ComputeSyncCommitteeSigningRoot(
+		domainType common.DomainType,
+		blockRoot common.Root,
+	) common.Root
+	// ComputeAttestationSigningRoot returns the signing root of the
+	// attestation data signed by the members of a beacon committee.
+	ComputeAttestationSigningRoot(
+		domainType common.DomainType,
+		data interface{ HashTreeRoot() common.Root },
+	) common.Root
+}
+
+// Attestation is the aggregate of the attestations of the members of a beacon
+// committee to the same attestation data.
+type Attestation[AttestationDataT AttestationData] interface {
+	// GetAggregationBits returns the bitvector of the committee members
+	// participating in the aggregate.
+	GetAggregationBits() []byte
+	// GetData returns the attested data.
+	GetData() AttestationDataT
+	// GetSignature returns the aggregate signature of the participants.
+	GetSignature() crypto.BLSSignature
+}
+
+// AttestationData is the data attested to by a beacon committee.
+type AttestationData interface {
+	// GetSlot returns the slot of the attestation.
+	GetSlot() math.U64
+	// GetIndex returns the index of the committee within the slot.
+	GetIndex() math.U64
+	// GetBeaconBlockRoot returns the root of the block attested as head.
+	GetBeaconBlockRoot() common.Root
+	// HashTreeRoot returns the hash tree root of the data.
+	HashTreeRoot() common.Root
+}
+
+// Shuffler computes the shuffled position of an index within a list, e.g. to
+// select committees or proposers.
+type Shuffler interface {
+	// ShuffleIndex returns the shuffled position of the index in a list of
+	// count elements, for the given seed.
+	ShuffleIndex(index, count uint64, seed [32]byte) (uint64, error)
 }
 
 // Validator represents an interface for a validator with generic type
@@ -220,6 +276,17 @@ type Validator[
 	) ValidatorT
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
+	// IsSlashable returns true if the validator may be slashed at the given
+	// epoch.
+	IsSlashable(math.Epoch) bool
+	// SetSlashed marks the validator as slashed.
+	SetSlashed()
+	// HasCompoundingWithdrawalCredential returns true if the validator has
+	// compounding withdrawal credentials.
+	HasCompoundingWithdrawalCredential() bool
+	// IsInValidatorSet returns true if the validator is active at the given
+	// epoch.
+	IsInValidatorSet(math.Epoch) bool
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator in
@@ -227,8 +294,14 @@ type Validator[
 	GetEffectiveBalance() math.Gwei
 	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
 	SetEffectiveBalance(math.Gwei)
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch when the validator exits.
+	SetExitEpoch(math.Epoch)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
 }
 
 type Validators interface {
@@ -20,6 +20,11 @@
 
 package beacondb
 
+import (
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
 // GetLatestExecutionPayloadHeader retrieves the latest execution payload
 // header from the BeaconStore.
 func (kv *KVStore[
@@ -89,3 +94,66 @@ func (kv *KVStore[
 ) error {
 	return kv.eth1Data.Set(kv.ctx, data)
 }
+
+// GetEth1DataVotes retrieves the eth1 data votes of the current voting period
+// in the order they were cast.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEth1DataVotes() ([]Eth1DataT, error) {
+	var votes []Eth1DataT
+	iter, err := kv.eth1DataVotes.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var vote Eth1DataT
+		vote, err = iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, vote)
+	}
+	return votes, err
+}
+
+// AppendEth1DataVote appends an eth1 data vote to the current voting period.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AppendEth1DataVote(
+	vote Eth1DataT,
+) (err error) {
+	// The next vote is stored after the most recent one.
+	iter, err := kv.eth1DataVotes.Iterate(
+		kv.ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	var index uint64
+	if iter.Valid() {
+		if index, err = iter.Key(); err != nil {
+			return err
+		}
+		index++
+	}
+	return kv.eth1DataVotes.Set(kv.ctx, index, vote)
+}
+
+// ResetEth1DataVotes removes all eth1 data votes, starting a new voting
+// period.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ResetEth1DataVotes() error {
+	return kv.eth1DataVotes.Clear(kv.ctx, nil)
+}
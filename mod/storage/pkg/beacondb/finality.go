@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// epochSize is the size of the SSZ encoding of an epoch.
+	epochSize = 8
+	// checkpointSize is the size of the SSZ encoding of a checkpoint, the
+	// epoch followed by the root.
+	checkpointSize = epochSize + common.RootSize
+)
+
+// ErrInvalidCheckpoint is returned when a stored checkpoint cannot be
+// decoded.
+var ErrInvalidCheckpoint = errors.New("invalid checkpoint encoding")
+
+// GetJustifiedCheckpoint retrieves the current justified checkpoint from the
+// store. The zero checkpoint is returned if none has been justified yet.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetJustifiedCheckpoint() (common.Checkpoint, error) {
+	return getCheckpoint(kv.justifiedCheckpoint.Get(kv.ctx))
+}
+
+// SetJustifiedCheckpoint sets the current justified checkpoint in the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetJustifiedCheckpoint(
+	checkpoint common.Checkpoint,
+) error {
+	return kv.justifiedCheckpoint.Set(kv.ctx, encodeCheckpoint(checkpoint))
+}
+
+// GetFinalizedCheckpoint retrieves the finalized checkpoint from the store.
+// The zero checkpoint is returned if none has been finalized yet.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetFinalizedCheckpoint() (common.Checkpoint, error) {
+	return getCheckpoint(kv.finalizedCheckpoint.Get(kv.ctx))
+}
+
+// SetFinalizedCheckpoint sets the finalized checkpoint in the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetFinalizedCheckpoint(
+	checkpoint common.Checkpoint,
+) error {
+	return kv.finalizedCheckpoint.Set(kv.ctx, encodeCheckpoint(checkpoint))
+}
+
+// getCheckpoint decodes a checkpoint read from the store, resolving a missing
+// checkpoint to the zero checkpoint.
+func getCheckpoint(bz []byte, err error) (common.Checkpoint, error) {
+	if errors.Is(err, collections.ErrNotFound) {
+		return common.Checkpoint{}, nil
+	} else if err != nil {
+		return common.Checkpoint{}, err
+	}
+	if len(bz) != checkpointSize {
+		return common.Checkpoint{}, errors.Wrapf(
+			ErrInvalidCheckpoint, "length: %d", len(bz),
+		)
+	}
+	return common.Checkpoint{
+		Epoch: math.Epoch(binary.LittleEndian.Uint64(bz)),
+		Root:  common.Root(bz[epochSize:]),
+	}, nil
+}
+
+// encodeCheckpoint returns the SSZ encoding of the checkpoint.
+func encodeCheckpoint(checkpoint common.Checkpoint) []byte {
+	bz := make([]byte, checkpointSize)
+	binary.LittleEndian.PutUint64(bz, checkpoint.Epoch.Unwrap())
+	copy(bz[epochSize:], checkpoint.Root[:])
+	return bz
+}
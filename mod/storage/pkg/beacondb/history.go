@@ -20,7 +20,20 @@
 
 package beacondb
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// historicalSummarySize is the size of the SSZ encoding of a historical
+// summary, the block summary root followed by the state summary root.
+const historicalSummarySize = 2 * common.RootSize
+
+// ErrInvalidHistoricalSummary is returned when a stored historical summary
+// cannot be decoded.
+var ErrInvalidHistoricalSummary = errors.New(
+	"invalid historical summary encoding")
 
 // UpdateBlockRootAtIndex sets a block root in the BeaconStore.
 func (kv *KVStore[
@@ -91,3 +104,68 @@ func (kv *KVStore[
 	}
 	return common.Root(bz), nil
 }
+
+// GetHistoricalSummaries retrieves the historical summaries in the order they
+// were appended.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetHistoricalSummaries() ([]common.HistoricalSummary, error) {
+	var summaries []common.HistoricalSummary
+	iter, err := kv.historicalSummaries.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var bz []byte
+		if bz, err = iter.Value(); err != nil {
+			return nil, err
+		}
+		if len(bz) != historicalSummarySize {
+			return nil, errors.Wrapf(
+				ErrInvalidHistoricalSummary, "length: %d", len(bz),
+			)
+		}
+		summaries = append(summaries, common.HistoricalSummary{
+			BlockSummaryRoot: common.Root(bz[:common.RootSize]),
+			StateSummaryRoot: common.Root(bz[common.RootSize:]),
+		})
+	}
+	return summaries, err
+}
+
+// AppendHistoricalSummary appends a historical summary after the most recent
+// one.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AppendHistoricalSummary(
+	summary common.HistoricalSummary,
+) (err error) {
+	iter, err := kv.historicalSummaries.Iterate(
+		kv.ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	var index uint64
+	if iter.Valid() {
+		if index, err = iter.Key(); err != nil {
+			return err
+		}
+		index++
+	}
+
+	bz := make([]byte, 0, historicalSummarySize)
+	bz = append(bz, summary.BlockSummaryRoot[:]...)
+	bz = append(bz, summary.StateSummaryRoot[:]...)
+	return kv.historicalSummaries.Set(kv.ctx, index, bz)
+}
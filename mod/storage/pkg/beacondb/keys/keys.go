@@ -44,6 +44,13 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	Eth1DataVotesPrefix
+	JustifiedCheckpointPrefix
+	FinalizedCheckpointPrefix
+	HistoricalSummariesPrefix
+	InactivityScoresPrefix
+	PreviousEpochParticipationPrefix
+	CurrentEpochParticipationPrefix
 )
 
 //nolint:lll
@@ -71,4 +78,11 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	Eth1DataVotesPrefixHumanReadable                    = "Eth1DataVotesPrefix"
+	JustifiedCheckpointPrefixHumanReadable              = "JustifiedCheckpointPrefix"
+	FinalizedCheckpointPrefixHumanReadable              = "FinalizedCheckpointPrefix"
+	HistoricalSummariesPrefixHumanReadable              = "HistoricalSummariesPrefix"
+	InactivityScoresPrefixHumanReadable                 = "InactivityScoresPrefix"
+	PreviousEpochParticipationPrefixHumanReadable       = "PreviousEpochParticipationPrefix"
+	CurrentEpochParticipationPrefixHumanReadable        = "CurrentEpochParticipationPrefix"
 )
@@ -56,6 +56,9 @@ type KVStore[
 	ValidatorsT ~[]ValidatorT,
 ] struct {
 	ctx context.Context
+	// write writes the changes of a copy of the store into the store it was
+	// copied from. It is nil if the store is not a copy.
+	write func()
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
 	genesisValidatorsRoot sdkcollections.Item[[]byte]
@@ -75,6 +78,8 @@ type KVStore[
 	eth1Data sdkcollections.Item[Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// eth1DataVotes stores the eth1 data votes of the current voting period.
+	eth1DataVotes sdkcollections.Map[uint64, Eth1DataT]
 	// latestExecutionPayloadVersion stores the latest execution payload
 	// version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
@@ -106,6 +111,24 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Finality
+	// justifiedCheckpoint stores the SSZ encoding of the current justified
+	// checkpoint.
+	justifiedCheckpoint sdkcollections.Item[[]byte]
+	// finalizedCheckpoint stores the SSZ encoding of the finalized
+	// checkpoint.
+	finalizedCheckpoint sdkcollections.Item[[]byte]
+	// historicalSummaries stores the SSZ encodings of the historical
+	// summaries, in the order they were appended.
+	historicalSummaries sdkcollections.Map[uint64, []byte]
+	// inactivityScores stores the inactivity score of each validator.
+	inactivityScores sdkcollections.Map[uint64, uint64]
+	// previousEpochParticipation stores the participation flags of each
+	// validator in the previous epoch.
+	previousEpochParticipation sdkcollections.Map[uint64, uint64]
+	// currentEpochParticipation stores the participation flags of each
+	// validator in the current epoch.
+	currentEpochParticipation sdkcollections.Map[uint64, uint64]
 }
 
 // New creates a new instance of Store.
@@ -188,6 +211,13 @@ func New[
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		eth1DataVotes: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.Eth1DataVotesPrefix}),
+			keys.Eth1DataVotesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[Eth1DataT]{},
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -259,6 +289,50 @@ func New[
 			keys.TotalSlashingPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		justifiedCheckpoint: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.JustifiedCheckpointPrefix}),
+			keys.JustifiedCheckpointPrefixHumanReadable,
+			sdkcollections.BytesValue,
+		),
+		finalizedCheckpoint: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.FinalizedCheckpointPrefix}),
+			keys.FinalizedCheckpointPrefixHumanReadable,
+			sdkcollections.BytesValue,
+		),
+		historicalSummaries: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.HistoricalSummariesPrefix}),
+			keys.HistoricalSummariesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		previousEpochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PreviousEpochParticipationPrefix},
+			),
+			keys.PreviousEpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		currentEpochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.CurrentEpochParticipationPrefix},
+			),
+			keys.CurrentEpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		latestBlockHeader: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -279,11 +353,23 @@ func (kv *KVStore[
 	ForkT, ValidatorT, ValidatorsT,
 ] {
 	// TODO: Decouple the KVStore type from the Cosmos-SDK.
-	cctx, _ := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
+	cctx, write := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
 	ss := kv.WithContext(cctx)
+	ss.write = write
 	return ss
 }
 
+// Save writes the changes made to a copy of the Store into the Store it was
+// copied from. It is a no-op if the Store is not a copy.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Save() {
+	if kv.write != nil {
+		kv.write()
+	}
+}
+
 // Context returns the context of the Store.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -304,5 +390,6 @@ func (kv *KVStore[
 ] {
 	cpy := *kv
 	cpy.ctx = ctx
+	cpy.write = nil
 	return &cpy
 }
@@ -289,7 +289,7 @@ func (kv *KVStore[
 	err = indexes.ScanValues(
 		kv.ctx, kv.validators, iter, func(v ValidatorT,
 		) bool {
-			if v.IsActive(epoch) {
+			if v.IsInValidatorSet(epoch) {
 				totalActiveBalances += v.GetEffectiveBalance()
 			}
 			return false
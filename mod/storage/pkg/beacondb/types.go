@@ -35,6 +35,6 @@ type Validator[SelfT any] interface {
 	// GetEffectiveBalance returns the effective balance of the validator in
 	// Gwei.
 	GetEffectiveBalance() math.Gwei
-	// IsActive checks if the validator is active at the given epoch.
-	IsActive(epoch math.Epoch) bool
+	// IsInValidatorSet checks if the validator is active at the given epoch.
+	IsInValidatorSet(epoch math.Epoch) bool
 }
@@ -21,6 +21,7 @@
 package block
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -30,9 +31,13 @@ import (
 	lru "github.com/hashicorp/golang-lru/v2"
 )
 
+// blockKey is the key under which the full block of a slot is persisted.
+var blockKey = []byte("block")
+
 // KVStore is a simple memory store based implementation that stores metadata of
-// beacon blocks.
-type KVStore[BeaconBlockT BeaconBlock] struct {
+// beacon blocks. The full blocks of the most recent slots may additionally be
+// persisted to disk.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
 	// Beacon block root to slot mapping is injective for finalized blocks.
 	blockRoots *lru.Cache[common.Root, math.Slot]
 
@@ -44,14 +49,29 @@ type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Beacon state root to slot mapping is injective for finalized blocks.
 	stateRoots *lru.Cache[common.Root, math.Slot]
 
+	// Slot indexed database of the SSZ encoded most recent finalized blocks.
+	// It is nil if full blocks are not retained.
+	blocks IndexDB
+
+	// Number of most recent slots whose full blocks are retained.
+	blocksWindow uint64
+
+	// Chain spec used to decode the persisted blocks of their fork.
+	chainSpec ChainSpec
+
 	// Logger for the store.
 	logger log.Logger
 }
 
-// NewStore creates a new block store.
-func NewStore[BeaconBlockT BeaconBlock](
+// NewStore creates a new block store. The full blocks of the last
+// blocksWindow slots are persisted to blocks in addition to their metadata,
+// none if blocks is nil or blocksWindow is 0.
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 	logger log.Logger,
 	availabilityWindow int,
+	blocks IndexDB,
+	blocksWindow uint64,
+	chainSpec ChainSpec,
 ) *KVStore[BeaconBlockT] {
 	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
 	if err != nil {
@@ -65,25 +85,69 @@ func NewStore[BeaconBlockT BeaconBlock](
 	if err != nil {
 		panic(err)
 	}
+	if blocksWindow == 0 {
+		blocks = nil
+	}
 	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+		blockRoots:   blockRoots,
+		timestamps:   timestamps,
+		stateRoots:   stateRoots,
+		blocks:       blocks,
+		blocksWindow: blocksWindow,
+		chainSpec:    chainSpec,
+		logger:       logger,
 	}
 }
 
 // Set sets the block by a given index in the store, storing the block root,
 // timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
+// entries from the store if the availability window is reached, pruning the
+// persisted blocks that fall out of the blocks window.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 	slot := blk.GetSlot()
 	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
 	kv.timestamps.Add(blk.GetTimestamp(), slot)
 	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	if kv.blocks == nil {
+		return nil
+	}
+
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err = kv.blocks.Set(slot.Unwrap(), blockKey, bz); err != nil {
+		return err
+	}
+	if slot.Unwrap()+1 > kv.blocksWindow {
+		return kv.blocks.Prune(
+			context.Background(), 0, slot.Unwrap()+1-kv.blocksWindow,
+		)
+	}
 	return nil
 }
 
+// GetBlockBySlot retrieves the block at a given slot from the store.
+func (kv *KVStore[BeaconBlockT]) GetBlockBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	if kv.blocks == nil {
+		return blk, errors.New("blocks are not retained by the store")
+	}
+	stored, err := kv.blocks.Has(slot.Unwrap(), blockKey)
+	if err != nil {
+		return blk, err
+	} else if !stored {
+		return blk, fmt.Errorf("block not found at slot: %d", slot)
+	}
+	bz, err := kv.blocks.Get(slot.Unwrap(), blockKey)
+	if err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(bz, kv.chainSpec.ActiveForkVersionForSlot(slot))
+}
+
 // GetSlotByRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
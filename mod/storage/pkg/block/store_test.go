@@ -21,12 +21,15 @@
 package block_test
 
 import (
+	"encoding/binary"
+	"os"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,8 +53,36 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, m.slot.Unwrap()), nil
+}
+
+func (*MockBeaconBlock) NewFromSSZ(
+	bz []byte, _ uint32,
+) (*MockBeaconBlock, error) {
+	slot := math.Slot(binary.LittleEndian.Uint64(bz))
+	return &MockBeaconBlock{slot: slot}, nil
+}
+
+type mockChainSpec struct{}
+
+func (mockChainSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return 0
+}
+
+func newBlocksDB(dir string) *filedb.RangeDB {
+	return filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(dir),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(noop.NewLogger[any]()),
+	))
+}
+
 func TestBlockStore(t *testing.T) {
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	blockStore := block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 5, nil, 0, mockChainSpec{},
+	)
 
 	var (
 		slot math.Slot
@@ -86,3 +117,36 @@ func TestBlockStore(t *testing.T) {
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
 }
+
+func TestBlockStoreBlocks(t *testing.T) {
+	dir := t.TempDir()
+
+	// Without a blocks window no full blocks are retained.
+	blockStore := block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 5, newBlocksDB(dir), 0, mockChainSpec{},
+	)
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 1}))
+	_, err := blockStore.GetBlockBySlot(1)
+	require.ErrorContains(t, err, "not retained")
+
+	// The full blocks of the last 3 slots are retained.
+	blockStore = block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 5, newBlocksDB(dir), 3, mockChainSpec{},
+	)
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+	_, err = blockStore.GetBlockBySlot(2)
+	require.ErrorContains(t, err, "not found")
+
+	// The blocks are persisted, so a store reopened on the same directory
+	// still finds them.
+	blockStore = block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 5, newBlocksDB(dir), 3, mockChainSpec{},
+	)
+	for i := math.Slot(3); i <= 5; i++ {
+		blk, err := blockStore.GetBlockBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, &MockBeaconBlock{slot: i}, blk)
+	}
+}
@@ -21,15 +21,32 @@
 package block
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
-type BeaconBlock interface {
+// tree root), timestamp, and state root, and can be SSZ encoded.
+type BeaconBlock[T any] interface {
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetTimestamp() math.U64
 	GetStateRoot() common.Root
+	MarshalSSZ() ([]byte, error)
+	NewFromSSZ([]byte, uint32) (T, error)
+}
+
+// ChainSpec is the subset of the chain spec used to decode persisted blocks.
+type ChainSpec interface {
+	ActiveForkVersionForSlot(slot math.Slot) uint32
+}
+
+// IndexDB is a database that allows prefixing by index.
+type IndexDB interface {
+	Get(index uint64, key []byte) ([]byte, error)
+	Has(index uint64, key []byte) (bool, error)
+	Set(index uint64, key []byte, value []byte) error
+	Prune(ctx context.Context, start uint64, end uint64) error
 }
@@ -30,13 +30,30 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix        = "deposit"
+	KeyPendingBlockPrefix   = "pending_block"
+	KeyPendingDepositPrefix = "pending_deposit"
+	KeyPrunedIndexPrefix    = "pruned_index"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// pendingBlocks maps the number of each execution block whose deposits
+	// are buffered to the hash of the block they were read from.
+	pendingBlocks sdkcollections.Map[uint64, []byte]
+	// pendingDeposits holds the buffered deposits, keyed by execution block
+	// number and their position in it.
+	pendingDeposits sdkcollections.Map[
+		sdkcollections.Pair[uint64, uint64], DepositT,
+	]
+	// prunedIndex is the index of the first deposit which has not been
+	// pruned. Deposits are only pruned once applied in a finalized block, so
+	// deposits below it are not enqueued again.
+	prunedIndex sdkcollections.Item[uint64]
+	mu          sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -52,6 +69,28 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		pendingBlocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyPendingBlockPrefix)),
+			KeyPendingBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		pendingDeposits: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyPendingDepositPrefix)),
+			KeyPendingDepositPrefix,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key, sdkcollections.Uint64Key,
+			),
+			encoding.SSZValueCodec[DepositT]{},
+		),
+		prunedIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyPrunedIndexPrefix)),
+			KeyPrunedIndexPrefix,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -80,17 +119,24 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
-	kv.mu.Lock()
-	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	return kv.EnqueueDeposits([]DepositT{deposit})
 }
 
-// EnqueueDeposits pushes multiple deposits to the queue.
+// EnqueueDeposits pushes multiple deposits to the queue, skipping those which
+// were applied and pruned already.
 func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
+	var ctx = context.TODO()
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+	prunedIndex, err := kv.getPrunedIndex(ctx)
+	if err != nil {
+		return err
+	}
 	for _, deposit := range deposits {
-		if err := kv.setDeposit(deposit); err != nil {
+		if deposit.GetIndex().Unwrap() < prunedIndex {
+			continue
+		}
+		if err = kv.setDeposit(ctx, deposit); err != nil {
 			return err
 		}
 	}
@@ -98,20 +144,142 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 }
 
 // setDeposit sets the deposit in the store.
-func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
-	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
+func (kv *KVStore[DepositT]) setDeposit(
+	ctx context.Context,
+	deposit DepositT,
+) error {
+	return kv.store.Set(ctx, deposit.GetIndex().Unwrap(), deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
-func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
-	var ctx = context.TODO()
+// Prune removes the [start, end) deposits from the store. The pruned index is
+// persisted, such that pruning resumes from it rather than from start.
+func (kv *KVStore[DepositT]) Prune(
+	ctx context.Context,
+	start, end uint64,
+) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+	prunedIndex, err := kv.getPrunedIndex(ctx)
+	if err != nil {
+		return err
+	}
+	if end <= prunedIndex {
+		return nil
+	}
+	for i := max(start, prunedIndex); i < end; i++ {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(ctx, start+i); err != nil {
+		if err = kv.store.Remove(ctx, i); err != nil {
 			return err
 		}
 	}
-	return nil
+	return kv.prunedIndex.Set(ctx, end)
+}
+
+// getPrunedIndex returns the index of the first deposit which has not been
+// pruned.
+func (kv *KVStore[DepositT]) getPrunedIndex(
+	ctx context.Context,
+) (uint64, error) {
+	prunedIndex, err := kv.prunedIndex.Get(ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return prunedIndex, err
+}
+
+// SetPendingDeposits buffers the deposits read from the execution block of
+// the given number and hash, replacing any deposits buffered for it before.
+func (kv *KVStore[DepositT]) SetPendingDeposits(
+	blockNum uint64,
+	blockHash []byte,
+	deposits []DepositT,
+) error {
+	var ctx = context.TODO()
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.removePendingDeposits(ctx, blockNum); err != nil {
+		return err
+	}
+	for i, deposit := range deposits {
+		if err := kv.pendingDeposits.Set(
+			ctx, sdkcollections.Join(blockNum, uint64(i)), deposit,
+		); err != nil {
+			return err
+		}
+	}
+	return kv.pendingBlocks.Set(ctx, blockNum, blockHash)
+}
+
+// PendingDepositBlocks returns the numbers of the execution blocks with
+// buffered deposits up to and including maxBlock, in ascending order.
+func (kv *KVStore[DepositT]) PendingDepositBlocks(
+	maxBlock uint64,
+) ([]uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.pendingBlocks.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndInclusive(maxBlock),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
+
+// GetPendingDeposits returns the hash of the execution block of the given
+// number and the deposits buffered for it.
+func (kv *KVStore[DepositT]) GetPendingDeposits(
+	blockNum uint64,
+) ([]byte, []DepositT, error) {
+	var ctx = context.TODO()
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blockHash, err := kv.pendingBlocks.Get(ctx, blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+	iter, err := kv.pendingDeposits.Iterate(
+		ctx, sdkcollections.NewPrefixedPairRange[uint64, uint64](blockNum),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	deposits, err := iter.Values()
+	if err != nil {
+		return nil, nil, err
+	}
+	return blockHash, deposits, nil
+}
+
+// RemovePendingDeposits drops the execution block of the given number and its
+// deposits from the buffer.
+func (kv *KVStore[DepositT]) RemovePendingDeposits(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.removePendingDeposits(context.TODO(), blockNum)
+}
+
+// removePendingDeposits drops the execution block of the given number and its
+// deposits from the buffer.
+func (kv *KVStore[DepositT]) removePendingDeposits(
+	ctx context.Context,
+	blockNum uint64,
+) error {
+	iter, err := kv.pendingDeposits.Iterate(
+		ctx, sdkcollections.NewPrefixedPairRange[uint64, uint64](blockNum),
+	)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = kv.pendingDeposits.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return kv.pendingBlocks.Remove(ctx, blockNum)
 }
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("deposit-tests")
+
+type testKVStoreService struct {
+	ctx sdk.Context
+}
+
+func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	//nolint:contextcheck // fine with tests
+	return components.NewKVStore(
+		sdk.UnwrapSDKContext(kvs.ctx).KVStore(testStoreKey),
+	)
+}
+
+func TestPruneDeposits(t *testing.T) {
+	kvs := newTestKVStoreService(t)
+	kv := deposit.NewStore[*types.Deposit](kvs)
+	ctx := context.Background()
+
+	deposits := make([]*types.Deposit, 10)
+	for i := range deposits {
+		deposits[i] = &types.Deposit{Index: uint64(i)}
+	}
+	require.NoError(t, kv.EnqueueDeposits(deposits))
+	require.NoError(t, kv.Prune(ctx, 0, 4))
+
+	// Pruning resumes from the pruned index, and never goes back.
+	require.NoError(t, kv.Prune(ctx, 0, 6))
+	require.NoError(t, kv.Prune(ctx, 0, 2))
+
+	// Deposits before the pruned index are removed, and not enqueued again,
+	// even once the store is reopened.
+	kv = deposit.NewStore[*types.Deposit](kvs)
+	require.NoError(t, kv.EnqueueDeposits(deposits))
+	pruned, err := kv.GetDepositsByIndex(0, 6)
+	require.NoError(t, err)
+	require.Empty(t, pruned)
+
+	// Deposits at and after the pruned index are retained.
+	retained, err := kv.GetDepositsByIndex(6, 10)
+	require.NoError(t, err)
+	require.Equal(t, deposits[6:], retained)
+}
+
+func TestPendingDeposits(t *testing.T) {
+	kv := initTestStore(t)
+
+	require.NoError(t, kv.SetPendingDeposits(
+		12, []byte{0x12}, []*types.Deposit{{Index: 2}},
+	))
+	require.NoError(t, kv.SetPendingDeposits(
+		10, []byte{0x10}, []*types.Deposit{{Index: 0}, {Index: 1}},
+	))
+	require.NoError(t, kv.SetPendingDeposits(11, []byte{0x11}, nil))
+
+	// Blocks are returned in ascending order up to the given block.
+	blocks, err := kv.PendingDepositBlocks(11)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 11}, blocks)
+
+	hash, deposits, err := kv.GetPendingDeposits(10)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x10}, hash)
+	require.Equal(t, []*types.Deposit{{Index: 0}, {Index: 1}}, deposits)
+
+	// Buffering a block again replaces its deposits.
+	require.NoError(t, kv.SetPendingDeposits(
+		10, []byte{0x0a}, []*types.Deposit{{Index: 0}},
+	))
+	hash, deposits, err = kv.GetPendingDeposits(10)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a}, hash)
+	require.Equal(t, []*types.Deposit{{Index: 0}}, deposits)
+
+	require.NoError(t, kv.RemovePendingDeposits(10))
+	_, _, err = kv.GetPendingDeposits(10)
+	require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+	blocks, err = kv.PendingDepositBlocks(12)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11, 12}, blocks)
+}
+
+func initTestStore(t *testing.T) *deposit.KVStore[*types.Deposit] {
+	t.Helper()
+	return deposit.NewStore[*types.Deposit](newTestKVStoreService(t))
+}
+
+func newTestKVStoreService(t *testing.T) *testKVStoreService {
+	t.Helper()
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+
+	nopLog := log.NewNopLogger()
+	cms := store.NewCommitMultiStore(memDB, nopLog, metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return &testKVStoreService{ctx: sdk.NewContext(cms, true, nopLog)}
+}
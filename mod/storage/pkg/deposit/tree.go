@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// TreeDepth is the depth of the deposit tree of the deposit contract.
+const TreeDepth = 32
+
+// snapshotSize is the size of a snapshot of the deposit tree, which holds the
+// deposit count followed by the branch.
+const snapshotSize = 8 + TreeDepth*32
+
+var (
+	// ErrDepositTreeFull is returned when inserting into a full deposit tree.
+	ErrDepositTreeFull = errors.New("deposit tree is full")
+	// ErrInvalidTreeSnapshot is returned when a snapshot of the deposit tree
+	// cannot be decoded.
+	ErrInvalidTreeSnapshot = errors.New("invalid deposit tree snapshot")
+	// ErrDepositCountMismatch is returned when a snapshot of the deposit tree
+	// was taken at a different deposit count than expected.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+	// ErrDepositRootMismatch is returned when the root of a restored deposit
+	// tree differs from the expected deposit root.
+	ErrDepositRootMismatch = errors.New("deposit root mismatch")
+)
+
+// Tree is the incremental Merkle tree of the deposit contract. It only keeps
+// the branch of the last inserted deposit, which suffices to compute the
+// deposit root and to insert further deposits.
+type Tree struct {
+	branch [TreeDepth]common.Root
+	count  uint64
+	// expectedRoot and expectedCount are the eth1 deposit root and count a
+	// snapshot must restore.
+	expectedRoot  common.Root
+	expectedCount uint64
+	mu            sync.RWMutex
+}
+
+// NewTree creates a new, empty deposit tree.
+func NewTree() *Tree {
+	t := &Tree{}
+	t.expectedRoot = t.root()
+	return t
+}
+
+// NewTreeFromEth1Data creates an empty deposit tree to be restored from a
+// snapshot whose root and deposit count match the given eth1 deposit root
+// and count.
+func NewTreeFromEth1Data(
+	depositRoot common.Root,
+	depositCount uint64,
+) *Tree {
+	return &Tree{
+		expectedRoot:  depositRoot,
+		expectedCount: depositCount,
+	}
+}
+
+// Insert appends the hash tree root of a deposit to the tree.
+func (t *Tree) Insert(leaf common.Root) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.count >= 1<<TreeDepth-1 {
+		return ErrDepositTreeFull
+	}
+
+	t.count++
+	var (
+		hasher = merkle.NewHasher[common.Root](sha256.Hash)
+		node   = leaf
+		size   = t.count
+	)
+	for height := range TreeDepth {
+		if size&1 == 1 {
+			t.branch[height] = node
+			return nil
+		}
+		node = hasher.Combi(t.branch[height], node)
+		size >>= 1
+	}
+	return nil
+}
+
+// DepositCount returns the number of deposits in the tree.
+func (t *Tree) DepositCount() uint64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.count
+}
+
+// Root returns the deposit root of the tree, with the deposit count mixed
+// in, as returned by the deposit contract.
+func (t *Tree) Root() common.Root {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.root()
+}
+
+// root computes the deposit root of the tree.
+func (t *Tree) root() common.Root {
+	var (
+		hasher = merkle.NewHasher[common.Root](sha256.Hash)
+		node   common.Root
+		size   = t.count
+	)
+	for height := range TreeDepth {
+		if size&1 == 1 {
+			node = hasher.Combi(t.branch[height], node)
+		} else {
+			node = hasher.Combi(node, zero.Hashes[height])
+		}
+		size >>= 1
+	}
+	return hasher.MixIn(node, t.count)
+}
+
+// Snapshot encodes the tree such that it can be restored with
+// RestoreFromSnapshot, without re-inserting every deposit.
+func (t *Tree) Snapshot() []byte {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	bz := make([]byte, 8, snapshotSize)
+	binary.LittleEndian.PutUint64(bz, t.count)
+	for _, node := range t.branch {
+		bz = append(bz, node[:]...)
+	}
+	return bz
+}
+
+// RestoreFromSnapshot restores the tree from a snapshot created by Snapshot.
+// It rejects snapshots taken at a deposit count other than the expected one
+// and snapshots whose root is not the expected deposit root, leaving the
+// tree untouched.
+func (t *Tree) RestoreFromSnapshot(bz []byte) error {
+	if len(bz) != snapshotSize {
+		return fmt.Errorf(
+			"%w: expected %d bytes, got %d",
+			ErrInvalidTreeSnapshot, snapshotSize, len(bz),
+		)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	restored := Tree{count: binary.LittleEndian.Uint64(bz)}
+	if restored.count != t.expectedCount {
+		return fmt.Errorf(
+			"%w: expected %d, snapshot has %d",
+			ErrDepositCountMismatch, t.expectedCount, restored.count,
+		)
+	}
+	for i := range restored.branch {
+		copy(restored.branch[i][:], bz[8+i*32:])
+	}
+	if root := restored.root(); root != t.expectedRoot {
+		return fmt.Errorf(
+			"%w: expected %s, snapshot has %s",
+			ErrDepositRootMismatch, t.expectedRoot, root,
+		)
+	}
+
+	t.branch, t.count = restored.branch, restored.count
+	return nil
+}
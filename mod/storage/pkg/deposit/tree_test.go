@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTree returns a deposit tree holding n deposits, along with their
+// leaves.
+func newTestTree(t *testing.T, n int) (*deposit.Tree, []common.Root) {
+	t.Helper()
+	tree := deposit.NewTree()
+	leaves := make([]common.Root, n)
+	for i := range leaves {
+		leaves[i] = sha256.Hash([]byte{byte(i)})
+		require.NoError(t, tree.Insert(leaves[i]))
+	}
+	return tree, leaves
+}
+
+func TestTreeRoot(t *testing.T) {
+	// The deposit root of the deposit contract before any deposit.
+	emptyRoot, err := common.NewRootFromHex(
+		"0xd70a234731285c6804c2a4f56711ddb8c82c99740f207854891028af34e27e5e",
+	)
+	require.NoError(t, err)
+	require.Equal(t, emptyRoot, deposit.NewTree().Root())
+
+	// The incremental root matches the root of the full tree.
+	for n := 1; n <= 9; n++ {
+		tree, leaves := newTestTree(t, n)
+		full, err := merkle.NewTreeFromLeavesWithDepth(
+			leaves, deposit.TreeDepth,
+		)
+		require.NoError(t, err)
+		require.Equal(t, full.HashTreeRoot(), tree.Root())
+		require.Equal(t, uint64(n), tree.DepositCount())
+	}
+}
+
+func TestTreeSnapshotRoundTrip(t *testing.T) {
+	tree, _ := newTestTree(t, 5)
+
+	restored := deposit.NewTreeFromEth1Data(tree.Root(), 5)
+	require.NoError(t, restored.RestoreFromSnapshot(tree.Snapshot()))
+	require.Equal(t, tree.Root(), restored.Root())
+	require.Equal(t, tree.DepositCount(), restored.DepositCount())
+
+	// Deposits inserted after the restore yield the same root.
+	leaf := sha256.Hash([]byte("next deposit"))
+	require.NoError(t, tree.Insert(leaf))
+	require.NoError(t, restored.Insert(leaf))
+	require.Equal(t, tree.Root(), restored.Root())
+}
+
+func TestTreeSnapshotRejected(t *testing.T) {
+	tree, _ := newTestTree(t, 5)
+	snapshot := tree.Snapshot()
+
+	// A snapshot taken at a different deposit count is rejected.
+	restored := deposit.NewTreeFromEth1Data(tree.Root(), 6)
+	require.ErrorIs(t,
+		restored.RestoreFromSnapshot(snapshot),
+		deposit.ErrDepositCountMismatch,
+	)
+	require.Zero(t, restored.DepositCount())
+
+	// So is a snapshot whose root is not the eth1 deposit root.
+	restored = deposit.NewTreeFromEth1Data(common.Root{0x01}, 5)
+	require.ErrorIs(t,
+		restored.RestoreFromSnapshot(snapshot),
+		deposit.ErrDepositRootMismatch,
+	)
+	require.Zero(t, restored.DepositCount())
+
+	// And a truncated snapshot.
+	restored = deposit.NewTreeFromEth1Data(tree.Root(), 5)
+	require.ErrorIs(t,
+		restored.RestoreFromSnapshot(snapshot[:len(snapshot)-1]),
+		deposit.ErrInvalidTreeSnapshot,
+	)
+}
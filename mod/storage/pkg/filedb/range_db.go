@@ -22,6 +22,7 @@ package filedb
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 
@@ -103,7 +104,7 @@ func (db *RangeDB) DeleteRange(from, to uint64) error {
 }
 
 // Prune removes all values in the given range [start, end) from the db.
-func (db *RangeDB) Prune(start, end uint64) error {
+func (db *RangeDB) Prune(_ context.Context, start, end uint64) error {
 	start = max(start, db.firstNonNilIndex)
 	if err := db.DeleteRange(start, end); err != nil {
 		// Resets last pruned index in case Delete somehow populates indices on
@@ -21,6 +21,7 @@
 package filedb_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -261,7 +262,7 @@ func TestRangeDB_Prune(t *testing.T) {
 					)
 				}
 			}
-			err := rdb.Prune(tt.start, tt.end)
+			err := rdb.Prune(context.Background(), tt.start, tt.end)
 			if (err != nil) != tt.expectedError {
 				t.Fatalf(
 					"Prune() error = %v, expectedError %v",
@@ -316,7 +317,7 @@ func TestRangeDB_Invariants(t *testing.T) {
 			},
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
-				_ = rdb.Prune(0, 3)
+				_ = rdb.Prune(context.Background(), 0, 3)
 				requireNotExist(t, rdb, 0, lastConsequetiveNilIndex(rdb))
 			},
 		},
@@ -338,7 +339,7 @@ func TestRangeDB_Invariants(t *testing.T) {
 			},
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
-				if err := rdb.Prune(0, 25); err != nil {
+				if err := rdb.Prune(context.Background(), 0, 25); err != nil {
 					t.Fatalf("Prune() error = %v", err)
 				}
 				_ = populateTestDB(rdb, 5, 10)
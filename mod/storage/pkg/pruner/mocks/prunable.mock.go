@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Prunable is an autogenerated mock type for the Prunable type
 type Prunable struct {
@@ -17,17 +21,17 @@ func (_m *Prunable) EXPECT() *Prunable_Expecter {
 	return &Prunable_Expecter{mock: &_m.Mock}
 }
 
-// Prune provides a mock function with given fields: start, end
-func (_m *Prunable) Prune(start uint64, end uint64) error {
-	ret := _m.Called(start, end)
+// Prune provides a mock function with given fields: ctx, start, end
+func (_m *Prunable) Prune(ctx context.Context, start uint64, end uint64) error {
+	ret := _m.Called(ctx, start, end)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Prune")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
-		r0 = rf(start, end)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, start, end)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -41,15 +45,16 @@ type Prunable_Prune_Call struct {
 }
 
 // Prune is a helper method to define mock.On call
+//   - ctx context.Context
 //   - start uint64
 //   - end uint64
-func (_e *Prunable_Expecter) Prune(start interface{}, end interface{}) *Prunable_Prune_Call {
-	return &Prunable_Prune_Call{Call: _e.mock.On("Prune", start, end)}
+func (_e *Prunable_Expecter) Prune(ctx interface{}, start interface{}, end interface{}) *Prunable_Prune_Call {
+	return &Prunable_Prune_Call{Call: _e.mock.On("Prune", ctx, start, end)}
 }
 
-func (_c *Prunable_Prune_Call) Run(run func(start uint64, end uint64)) *Prunable_Prune_Call {
+func (_c *Prunable_Prune_Call) Run(run func(ctx context.Context, start uint64, end uint64)) *Prunable_Prune_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint64), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
 	})
 	return _c
 }
@@ -59,7 +64,7 @@ func (_c *Prunable_Prune_Call) Return(_a0 error) *Prunable_Prune_Call {
 	return _c
 }
 
-func (_c *Prunable_Prune_Call) RunAndReturn(run func(uint64, uint64) error) *Prunable_Prune_Call {
+func (_c *Prunable_Prune_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *Prunable_Prune_Call {
 	_c.Call.Return(run)
 	return _c
 }
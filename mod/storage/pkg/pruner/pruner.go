@@ -82,7 +82,7 @@ func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case event := <-p.subBeaconBlockFinalized:
-			p.onFinalizeBlock(event)
+			p.onFinalizeBlock(ctx, event)
 		}
 	}
 }
@@ -90,10 +90,11 @@ func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
 // onFinalizeBlock will prune the prunable store based on the received
 // finalized block event.
 func (p *pruner[BeaconBlockT, PrunableT]) onFinalizeBlock(
+	ctx context.Context,
 	event async.Event[BeaconBlockT],
 ) {
 	start, end := p.pruneRangeFn(event)
-	if err := p.prunable.Prune(start, end); err != nil {
+	if err := p.prunable.Prune(ctx, start, end); err != nil {
 		p.logger.Error("‼️ error pruning index ‼️", "error", err)
 	}
 }
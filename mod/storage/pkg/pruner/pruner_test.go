@@ -73,8 +73,9 @@ func TestPruner(t *testing.T) {
 			logger := log.NewNopLogger()
 			ch := make(chan async.Event[pruner.BeaconBlock])
 			mockPrunable := new(mocks.Prunable)
-			mockPrunable.On("Prune", mock.Anything, mock.Anything).
-				Return(nil)
+			mockPrunable.On(
+				"Prune", mock.Anything, mock.Anything, mock.Anything,
+			).Return(nil)
 
 			// create Pruner with a Noop logger
 			testPruner := pruner.NewPruner[
@@ -115,6 +116,7 @@ func TestPruner(t *testing.T) {
 				mockPrunable.AssertCalled(
 					t,
 					"Prune",
+					mock.Anything,
 					index,
 					mock.Anything,
 				)
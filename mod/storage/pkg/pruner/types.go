@@ -48,7 +48,7 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 // Prunable is an interface representing a store that can be pruned.
 type Prunable interface {
 	// Prune prunes the store from [start, end).
-	Prune(start, end uint64) error
+	Prune(ctx context.Context, start, end uint64) error
 }
 
 // Pruner is an interface for pruning a prunable type.